@@ -0,0 +1,19 @@
+package eventhandler
+
+import "github.com/looplab/eventhorizon"
+
+// Named gives a handler, such as one returned by Logger, the type it is added to an
+// outbox under, as outboxes keep track of handlers by type.
+func Named(handler eventhorizon.EventHandler, handlerType eventhorizon.EventHandlerType,
+) eventhorizon.EventHandler {
+	return named{handler, handlerType}
+}
+
+type named struct {
+	eventhorizon.EventHandler
+	handlerType eventhorizon.EventHandlerType
+}
+
+func (n named) HandlerType() eventhorizon.EventHandlerType {
+	return n.handlerType
+}
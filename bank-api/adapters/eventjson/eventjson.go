@@ -1,6 +1,8 @@
 package eventjson
 
 import (
+	"bytes"
+	"codepix/bank-api/lib/eventschema"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -11,6 +13,7 @@ import (
 
 type Event struct {
 	Type          eventhorizon.EventType     `json:"type"`
+	SchemaVersion int                        `json:"schema_version"`
 	Data          json.RawMessage            `json:"data"`
 	Timestamp     time.Time                  `json:"timestamp"`
 	AggregateType eventhorizon.AggregateType `json:"aggregate_type"`
@@ -22,6 +25,7 @@ type Event struct {
 func Marshal(event eventhorizon.Event) ([]byte, error) {
 	evt := Event{
 		Type:          event.EventType(),
+		SchemaVersion: eventschema.Current(event.EventType()),
 		Timestamp:     event.Timestamp(),
 		AggregateType: event.AggregateType(),
 		AggregateID:   event.AggregateID(),
@@ -51,6 +55,12 @@ func Unmarshal(bytes []byte) (eventhorizon.Event, error) {
 	if err != nil {
 		return nil, fmt.Errorf("create event data: %w", err)
 	}
+	if evt.SchemaVersion < eventschema.Current(evt.Type) {
+		evt.Data, err = upcast(evt)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if err := json.Unmarshal(evt.Data, data); err != nil {
 		return nil, fmt.Errorf("unmarshal event data: %w", err)
 	}
@@ -67,3 +77,20 @@ func Unmarshal(bytes []byte) (eventhorizon.Event, error) {
 	)
 	return event, nil
 }
+
+func upcast(evt Event) (json.RawMessage, error) {
+	payload := map[string]any{}
+	decoder := json.NewDecoder(bytes.NewReader(evt.Data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&payload); err != nil {
+		return nil, fmt.Errorf("unmarshal event data: %w", err)
+	}
+	if err := eventschema.Upcast(evt.Type, evt.SchemaVersion, payload); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal upcast event data: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,87 @@
+package eventjson_test
+
+import (
+	"codepix/bank-api/adapters/eventjson"
+	"codepix/bank-api/transaction"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshal(t *testing.T) {
+	type testCase struct {
+		description string
+		fixture     string
+		data        eventhorizon.EventData
+	}
+
+	ID := uuid.New()
+	senderBank := uuid.New()
+	receiverBank := uuid.New()
+
+	// fixture renders an event as it was written before the envelope had a schema version
+	fixture := func(eventType eventhorizon.EventType, data string) string {
+		return fmt.Sprintf(`{"type":%q,"data":%s,"timestamp":"2022-10-01T12:00:00Z",`+
+			`"aggregate_type":"transaction","aggregate_id":%q,"version":2,"metadata":{}}`,
+			eventType, data, ID)
+	}
+
+	testCases := []testCase{
+		{
+			"started",
+			fixture(transaction.StartedEvent, fmt.Sprintf(
+				`{"sender":%q,"sender_bank":%q,"receiver":%q,"receiver_bank":%q,`+
+					`"amount":18446744073709551615,"description":"test"}`,
+				ID, senderBank, ID, receiverBank)),
+			&transaction.TransactionStarted{ID, senderBank, ID, receiverBank,
+				18446744073709551615, "test", uuid.Nil, uuid.Nil, false},
+		},
+		{
+			"failed without a code",
+			fixture(transaction.FailedEvent, fmt.Sprintf(
+				`{"sender_bank":%q,"receiver_bank":%q,"reason":"not enough balance"}`,
+				senderBank, receiverBank)),
+			&transaction.TransactionFailed{senderBank, receiverBank,
+				transaction.FailureUnspecified, "not enough balance", uuid.Nil},
+		},
+		{
+			"failed with a code",
+			fixture(transaction.FailedEvent, fmt.Sprintf(
+				`{"sender_bank":%q,"receiver_bank":%q,"code":5,"reason":"sender account reported as compromised"}`,
+				senderBank, receiverBank)),
+			&transaction.TransactionFailed{senderBank, receiverBank,
+				transaction.FailureSuspectedFraud, "sender account reported as compromised", uuid.Nil},
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			event, err := eventjson.Unmarshal([]byte(tc.fixture))
+			require.NoError(t, err)
+			assert.Equal(t, tc.data, event.Data())
+			assert.Equal(t, ID, event.AggregateID())
+			assert.Equal(t, 2, event.Version())
+		})
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	data := &transaction.TransactionFailed{uuid.New(), uuid.New(),
+		transaction.FailureAccountClosed, "receiver account closed", uuid.Nil}
+	event := eventhorizon.NewEvent(transaction.FailedEvent, data,
+		time.Now().UTC().Truncate(time.Millisecond),
+		eventhorizon.ForAggregate(transaction.AggregateType, uuid.New(), 1))
+
+	bytes, err := eventjson.Marshal(event)
+	require.NoError(t, err)
+	assert.Contains(t, string(bytes), `"schema_version":1`)
+
+	unmarshaled, err := eventjson.Unmarshal(bytes)
+	require.NoError(t, err)
+	assert.Equal(t, data, unmarshaled.Data())
+	assert.Equal(t, event.Timestamp(), unmarshaled.Timestamp())
+}
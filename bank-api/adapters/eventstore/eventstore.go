@@ -9,10 +9,12 @@ import (
 	"strings"
 
 	"github.com/go-logr/logr"
+	"github.com/google/uuid"
 	"github.com/looplab/eventhorizon"
 	mongostore "github.com/looplab/eventhorizon/eventstore/mongodb"
 	mongooutbox "github.com/looplab/eventhorizon/outbox/mongodb"
 	"github.com/phayes/freeport"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
@@ -21,10 +23,12 @@ import (
 )
 
 type EventStore struct {
-	Store   eventhorizon.EventStore
-	Outbox  eventhorizon.Outbox
-	logger  logr.Logger
-	onClose func() error
+	Store     eventhorizon.EventStore
+	Outbox    eventhorizon.Outbox
+	store     upcastingStore
+	snapshots *mongo.Collection
+	logger    logr.Logger
+	onClose   func() error
 }
 
 func Open(ctx context.Context, config config.Config, logger logr.Logger) (*EventStore, error) {
@@ -100,11 +104,18 @@ func Open(ctx context.Context, config config.Config, logger logr.Logger) (*Event
 	}
 	logger.Info("event store opened")
 
+	database := outbox.Client().Database(cfg.Name)
+	upcasting := upcastingStore{
+		EventStore: store,
+		events:     database.Collection("events"),
+	}
 	eventStore := &EventStore{
-		Store:   store,
-		Outbox:  outbox,
-		logger:  logger,
-		onClose: onClose,
+		Store:     upcasting,
+		Outbox:    outbox,
+		store:     upcasting,
+		snapshots: database.Collection("snapshots"),
+		logger:    logger,
+		onClose:   onClose,
 	}
 	return eventStore, nil
 }
@@ -130,6 +141,40 @@ func (s *EventStore) Start() error {
 	return nil
 }
 
+// Load loads the events of an aggregate, upcast to their current schema.
+func (s *EventStore) Load(ctx context.Context, ID uuid.UUID) ([]eventhorizon.Event, error) {
+	return s.store.Load(ctx, ID)
+}
+
+// Versions calls fn with the ID and version of every aggregate of the given type.
+func (s *EventStore) Versions(ctx context.Context, aggregateType eventhorizon.AggregateType,
+	fn func(ID uuid.UUID, version int) error,
+) error {
+	cursor, err := s.store.events.Find(ctx,
+		bson.M{"events.0.aggregate_type": aggregateType},
+		options.Find().SetProjection(bson.M{"version": 1}),
+	)
+	if err != nil {
+		return fmt.Errorf("list aggregates: %w", err)
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var aggregate struct {
+			ID      uuid.UUID `bson:"_id"`
+			Version int       `bson:"version"`
+		}
+		err := cursor.Decode(&aggregate)
+		if err != nil {
+			return fmt.Errorf("list aggregates: %w", err)
+		}
+		err = fn(aggregate.ID, aggregate.Version)
+		if err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
 type wrappedHandler struct {
 	handler eventhorizon.EventHandler
 }
@@ -0,0 +1,190 @@
+package eventstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/looplab/eventhorizon/aggregatestore/events"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Snapshotable aggregates can save their state and restore it, so loading them only
+// replays the events after the snapshot. SnapshotVersion must change whenever the shape
+// of the state changes.
+type Snapshotable interface {
+	events.VersionedAggregate
+	SnapshotVersion() int
+	Snapshot() any
+	RestoreSnapshot(decode func(state any) error) error
+}
+
+// AggregateStore snapshots aggregates every Interval events and loads them from their
+// latest snapshot plus the events after it. Aggregates are replayed from all their events
+// when they have no snapshot or when it was taken with a different snapshot version.
+type AggregateStore struct {
+	*events.AggregateStore
+	store     upcastingStore
+	snapshots *mongo.Collection
+	interval  int
+	logger    logr.Logger
+}
+
+var _ eventhorizon.AggregateStore = &AggregateStore{}
+
+// NewAggregateStore returns an aggregate store that snapshots every interval events.
+// An interval of 0 disables snapshots.
+func (s *EventStore) NewAggregateStore(interval int) (*AggregateStore, error) {
+	aggregateStore, err := events.NewAggregateStore(s.Store)
+	if err != nil {
+		return nil, err
+	}
+	return &AggregateStore{
+		AggregateStore: aggregateStore,
+		store:          s.store,
+		snapshots:      s.snapshots,
+		interval:       interval,
+		logger:         s.logger.WithName("snapshots"),
+	}, nil
+}
+
+type snapshot struct {
+	AggregateID   uuid.UUID                  `bson:"_id"`
+	AggregateType eventhorizon.AggregateType `bson:"aggregate_type"`
+	Version       int                        `bson:"version"`
+	SchemaVersion int                        `bson:"schema_version"`
+	State         bson.Raw                   `bson:"state"`
+	Timestamp     time.Time                  `bson:"timestamp"`
+}
+
+func (s *AggregateStore) Load(ctx context.Context, aggregateType eventhorizon.AggregateType,
+	ID uuid.UUID,
+) (eventhorizon.Aggregate, error) {
+	if s.interval == 0 {
+		return s.AggregateStore.Load(ctx, aggregateType, ID)
+	}
+	aggregate, err := eventhorizon.CreateAggregate(aggregateType, ID)
+	if err != nil {
+		return s.AggregateStore.Load(ctx, aggregateType, ID)
+	}
+	snapshotable, ok := aggregate.(Snapshotable)
+	if !ok {
+		return s.AggregateStore.Load(ctx, aggregateType, ID)
+	}
+	version, err := s.restore(ctx, snapshotable)
+	if err != nil {
+		s.logger.Error(err, "fail: restore snapshot", "id", ID)
+		return s.AggregateStore.Load(ctx, aggregateType, ID)
+	}
+	if version == 0 {
+		return s.AggregateStore.Load(ctx, aggregateType, ID)
+	}
+
+	tail, err := s.store.LoadFrom(ctx, ID, version)
+	if err != nil {
+		return nil, &eventhorizon.AggregateStoreError{
+			Err:           err,
+			Op:            eventhorizon.AggregateStoreOpLoad,
+			AggregateType: aggregateType,
+			AggregateID:   ID,
+		}
+	}
+	for _, event := range tail {
+		if event.AggregateType() != aggregateType {
+			return nil, &eventhorizon.AggregateStoreError{
+				Err:           events.ErrMismatchedEventType,
+				Op:            eventhorizon.AggregateStoreOpLoad,
+				AggregateType: aggregateType,
+				AggregateID:   ID,
+			}
+		}
+		err := snapshotable.ApplyEvent(ctx, event)
+		if err != nil {
+			return nil, &eventhorizon.AggregateStoreError{
+				Err:           fmt.Errorf("could not apply event %s: %w", event, err),
+				Op:            eventhorizon.AggregateStoreOpLoad,
+				AggregateType: aggregateType,
+				AggregateID:   ID,
+			}
+		}
+		snapshotable.SetAggregateVersion(event.Version())
+	}
+	return snapshotable, nil
+}
+
+// restore applies the latest snapshot and returns its version, or 0 if there is no
+// compatible snapshot.
+func (s *AggregateStore) restore(ctx context.Context, aggregate Snapshotable) (int, error) {
+	var found snapshot
+	err := s.snapshots.FindOne(ctx, bson.M{"_id": aggregate.EntityID()}).Decode(&found)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if found.AggregateType != aggregate.AggregateType() ||
+		found.SchemaVersion != aggregate.SnapshotVersion() {
+		return 0, nil
+	}
+	err = aggregate.RestoreSnapshot(func(state any) error {
+		return bson.Unmarshal(found.State, state)
+	})
+	if err != nil {
+		return 0, err
+	}
+	aggregate.SetAggregateVersion(found.Version)
+	return found.Version, nil
+}
+
+func (s *AggregateStore) Save(ctx context.Context, aggregate eventhorizon.Aggregate) error {
+	snapshotable, ok := aggregate.(Snapshotable)
+	before := 0
+	if ok {
+		before = snapshotable.AggregateVersion()
+	}
+	err := s.AggregateStore.Save(ctx, aggregate)
+	if err != nil {
+		return err
+	}
+	if !ok || s.interval == 0 || snapshotable.AggregateVersion()/s.interval == before/s.interval {
+		return nil
+	}
+	// the events are already saved, so a missing snapshot only makes loading slower
+	err = s.save(ctx, snapshotable)
+	if err != nil {
+		s.logger.Error(err, "fail: save snapshot", "id", aggregate.EntityID())
+	}
+	return nil
+}
+
+func (s *AggregateStore) save(ctx context.Context, aggregate Snapshotable) error {
+	state, err := bson.Marshal(aggregate.Snapshot())
+	if err != nil {
+		return err
+	}
+	saved := snapshot{
+		AggregateID:   aggregate.EntityID(),
+		AggregateType: aggregate.AggregateType(),
+		Version:       aggregate.AggregateVersion(),
+		SchemaVersion: aggregate.SnapshotVersion(),
+		State:         state,
+		Timestamp:     time.Now(),
+	}
+	// only replace older snapshots, a concurrent save may have stored a newer one
+	_, err = s.snapshots.ReplaceOne(ctx,
+		bson.M{"_id": saved.AggregateID, "version": bson.M{"$lt": saved.Version}},
+		saved,
+		options.Replace().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil
+	}
+	return err
+}
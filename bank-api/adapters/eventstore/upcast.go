@@ -0,0 +1,173 @@
+package eventstore
+
+import (
+	"codepix/bank-api/lib/eventschema"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const schemaVersionKey = "schema_version"
+
+// upcastingStore records the schema version of saved events in their metadata and
+// migrates older payloads to the current schema on load. Loading reads the raw documents
+// of the underlying mongo store, since it decodes payloads without knowing their version.
+type upcastingStore struct {
+	eventhorizon.EventStore
+	events *mongo.Collection
+}
+
+func (s upcastingStore) Save(ctx context.Context, events []eventhorizon.Event,
+	originalVersion int,
+) error {
+	versioned := make([]eventhorizon.Event, len(events))
+	for i, event := range events {
+		metadata := map[string]any{}
+		for key, value := range event.Metadata() {
+			metadata[key] = value
+		}
+		metadata[schemaVersionKey] = eventschema.Current(event.EventType())
+
+		versioned[i] = eventhorizon.NewEvent(
+			event.EventType(),
+			event.Data(),
+			event.Timestamp(),
+			eventhorizon.ForAggregate(
+				event.AggregateType(),
+				event.AggregateID(),
+				event.Version(),
+			),
+			eventhorizon.WithMetadata(metadata),
+		)
+	}
+	return s.EventStore.Save(ctx, versioned, originalVersion)
+}
+
+type storedAggregate struct {
+	Events []storedEvent `bson:"events"`
+}
+
+type storedEvent struct {
+	EventType     eventhorizon.EventType     `bson:"event_type"`
+	RawData       bson.Raw                   `bson:"data,omitempty"`
+	Timestamp     time.Time                  `bson:"timestamp"`
+	AggregateType eventhorizon.AggregateType `bson:"aggregate_type"`
+	AggregateID   uuid.UUID                  `bson:"_id"`
+	Version       int                        `bson:"version"`
+	Metadata      map[string]any             `bson:"metadata"`
+}
+
+func (s upcastingStore) Load(ctx context.Context, ID uuid.UUID) ([]eventhorizon.Event, error) {
+	return s.load(ctx, ID)
+}
+
+// LoadFrom loads only the events after the given aggregate version.
+func (s upcastingStore) LoadFrom(ctx context.Context, ID uuid.UUID, version int,
+) ([]eventhorizon.Event, error) {
+	return s.load(ctx, ID, options.FindOne().SetProjection(bson.M{
+		"events": bson.M{"$slice": bson.A{version, math.MaxInt32}},
+	}))
+}
+
+func (s upcastingStore) load(ctx context.Context, ID uuid.UUID, opts ...*options.FindOneOptions,
+) ([]eventhorizon.Event, error) {
+	var aggregate storedAggregate
+	err := s.events.FindOne(ctx, bson.M{"_id": ID}, opts...).Decode(&aggregate)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		err = eventhorizon.ErrAggregateNotFound
+	}
+	if err != nil {
+		return nil, &eventhorizon.EventStoreError{
+			Err:         err,
+			Op:          eventhorizon.EventStoreOpLoad,
+			AggregateID: ID,
+		}
+	}
+
+	events := make([]eventhorizon.Event, len(aggregate.Events))
+	for i, stored := range aggregate.Events {
+		data, err := unmarshalData(stored.EventType, schemaVersion(stored.Metadata), stored.RawData)
+		if err != nil {
+			return nil, &eventhorizon.EventStoreError{
+				Err:              err,
+				Op:               eventhorizon.EventStoreOpLoad,
+				AggregateType:    stored.AggregateType,
+				AggregateID:      ID,
+				AggregateVersion: stored.Version,
+			}
+		}
+		if stored.Metadata == nil {
+			stored.Metadata = map[string]any{}
+		}
+		stored.Metadata[schemaVersionKey] = eventschema.Current(stored.EventType)
+
+		events[i] = eventhorizon.NewEvent(
+			stored.EventType,
+			data,
+			stored.Timestamp,
+			eventhorizon.ForAggregate(
+				stored.AggregateType,
+				stored.AggregateID,
+				stored.Version,
+			),
+			eventhorizon.WithMetadata(stored.Metadata),
+		)
+	}
+	return events, nil
+}
+
+func unmarshalData(eventType eventhorizon.EventType, version int, raw bson.Raw,
+) (eventhorizon.EventData, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	data, err := eventhorizon.CreateEventData(eventType)
+	if err != nil {
+		return nil, fmt.Errorf("create event data: %w", err)
+	}
+	if version < eventschema.Current(eventType) {
+		payload := map[string]any{}
+		err := bson.Unmarshal(raw, &payload)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal event data: %w", err)
+		}
+		err = eventschema.Upcast(eventType, version, payload)
+		if err != nil {
+			return nil, err
+		}
+		raw, err = bson.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("marshal upcast event data: %w", err)
+		}
+	}
+	err = bson.Unmarshal(raw, data)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal event data: %w", err)
+	}
+	return data, nil
+}
+
+// schemaVersion reads the version from the metadata, where it decodes as whatever
+// integer type the driver chose. Events saved before versioning have none.
+func schemaVersion(metadata map[string]any) int {
+	switch version := metadata[schemaVersionKey].(type) {
+	case int:
+		return version
+	case int32:
+		return int(version)
+	case int64:
+		return int(version)
+	case float64:
+		return int(version)
+	default:
+		return 1
+	}
+}
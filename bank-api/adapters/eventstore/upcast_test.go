@@ -0,0 +1,86 @@
+package eventstore
+
+import (
+	"codepix/bank-api/lib/eventschema"
+	"codepix/bank-api/transaction"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// renamed is an event whose "name" field was renamed to "title" in version 2.
+type renamed struct {
+	Title string `bson:"title"`
+}
+
+const renamedEvent = eventhorizon.EventType("test_renamed")
+
+func init() {
+	eventhorizon.RegisterEventData(renamedEvent, func() eventhorizon.EventData { return &renamed{} })
+	eventschema.Register(renamedEvent, func(data map[string]any) error {
+		data["title"] = data["name"]
+		delete(data, "name")
+		return nil
+	})
+}
+
+func TestUnmarshalData(t *testing.T) {
+	type testCase struct {
+		description string
+		eventType   eventhorizon.EventType
+		metadata    map[string]any
+		fixture     bson.M
+		data        eventhorizon.EventData
+	}
+
+	senderBank := uuid.New()
+	receiverBank := uuid.New()
+
+	testCases := []testCase{
+		{
+			"renamed before versioning",
+			renamedEvent,
+			map[string]any{},
+			bson.M{"name": "a"},
+			&renamed{"a"},
+		},
+		{
+			"renamed at version 1",
+			renamedEvent,
+			map[string]any{schemaVersionKey: int32(1)},
+			bson.M{"name": "a"},
+			&renamed{"a"},
+		},
+		{
+			"renamed at the current version",
+			renamedEvent,
+			map[string]any{schemaVersionKey: int64(2)},
+			bson.M{"title": "a"},
+			&renamed{"a"},
+		},
+		{
+			"failed before failure codes existed",
+			transaction.FailedEvent,
+			nil,
+			bson.M{"sender_bank": senderBank, "receiver_bank": receiverBank,
+				"reason": "not enough balance"},
+			&transaction.TransactionFailed{senderBank, receiverBank,
+				transaction.FailureUnspecified, "not enough balance", uuid.Nil},
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			raw, err := bson.Marshal(tc.fixture)
+			require.NoError(t, err)
+
+			data, err := unmarshalData(tc.eventType, schemaVersion(tc.metadata), raw)
+			require.NoError(t, err)
+			assert.Equal(t, tc.data, data)
+		})
+	}
+}
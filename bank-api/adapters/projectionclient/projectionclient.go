@@ -134,6 +134,9 @@ func (sp *StoreProjection) Setup(
 		entityProjector,
 		repo,
 		projector.WithRetryOnce(),
+		// projectors check versions themselves, so rows projected before versioning
+		// can still be updated
+		projector.WithIrregularVersioning(),
 	)
 	projectorHandler.SetEntityFactory(entity)
 
@@ -0,0 +1,204 @@
+package projectionclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/looplab/eventhorizon/eventhandler/projector"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	maxCatchUpPasses = 10
+	maxSyncAttempts  = 5
+)
+
+var ErrConcurrentUpdate = errors.New("entity kept changing concurrently")
+
+// EventSource provides the events a projection is rebuilt from.
+type EventSource interface {
+	Load(ctx context.Context, ID uuid.UUID) ([]eventhorizon.Event, error)
+	Versions(ctx context.Context, aggregateType eventhorizon.AggregateType,
+		fn func(ID uuid.UUID, version int) error) error
+}
+
+// Rebuilder projects events straight from the event store, to fix rows projected by a
+// faulty projector. Entities must be versionable, so rows can be brought up to date while
+// the outbox handler keeps projecting new events.
+type Rebuilder struct {
+	projection     *StoreProjection
+	source         EventSource
+	projectionType projector.Type
+	entity         func() eventhorizon.Entity
+	projector      projector.Projector
+	aggregate      eventhorizon.AggregateType
+}
+
+func (sp *StoreProjection) NewRebuilder(
+	source EventSource,
+	projectionType projector.Type,
+	entity func() eventhorizon.Entity,
+	entityProjector projector.Projector,
+	aggregate eventhorizon.AggregateType,
+) Rebuilder {
+	return Rebuilder{sp, source, projectionType, entity, entityProjector, aggregate}
+}
+
+// Rebuild projects every aggregate into a shadow collection, catching up with events
+// saved in the meantime until a pass finds nothing to do. Then it checks the shadow has
+// one row per aggregate and renames it over the live collection, which is atomic. Events
+// projected into the old collection right before the swap are caught up afterwards.
+func (r Rebuilder) Rebuild(ctx context.Context) error {
+	database := r.projection.client.Database(r.projection.projectionName)
+	live := database.Collection(string(r.projectionType))
+	shadow := database.Collection(string(r.projectionType) + "_rebuild")
+	logger := r.projection.logger.WithValues("projection", r.projectionType)
+
+	err := shadow.Drop(ctx)
+	if err != nil {
+		return fmt.Errorf("rebuild: drop shadow collection: %w", err)
+	}
+	aggregates := 0
+	for pass := 1; ; pass++ {
+		if pass > maxCatchUpPasses {
+			return fmt.Errorf("rebuild: still behind after %d passes", maxCatchUpPasses)
+		}
+		var synced int
+		aggregates, synced, err = r.catchUp(ctx, shadow)
+		if err != nil {
+			return fmt.Errorf("rebuild: %w", err)
+		}
+		logger.Info("rebuild pass done", "pass", pass, "aggregates", aggregates, "synced", synced)
+		if synced == 0 {
+			break
+		}
+	}
+
+	rows, err := shadow.CountDocuments(ctx, bson.M{})
+	if err != nil {
+		return fmt.Errorf("rebuild: count shadow collection: %w", err)
+	}
+	if rows != int64(aggregates) {
+		return fmt.Errorf("rebuild: shadow collection has %d rows for %d aggregates",
+			rows, aggregates)
+	}
+
+	err = r.projection.client.Database("admin").RunCommand(ctx, bson.D{
+		{"renameCollection", database.Name() + "." + shadow.Name()},
+		{"to", database.Name() + "." + live.Name()},
+		{"dropTarget", true},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("rebuild: swap collections: %w", err)
+	}
+	logger.Info("rebuild swapped in", "rows", rows)
+
+	_, synced, err := r.catchUp(ctx, live)
+	if err != nil {
+		return fmt.Errorf("rebuild: catch up after swap: %w", err)
+	}
+	logger.Info("rebuild done", "synced after swap", synced)
+	return nil
+}
+
+// Replay projects all events of a single aggregate again, replacing its row.
+func (r Rebuilder) Replay(ctx context.Context, ID uuid.UUID) error {
+	live := r.projection.client.Database(r.projection.projectionName).
+		Collection(string(r.projectionType))
+	_, err := r.sync(ctx, live, ID, true)
+	if err != nil {
+		return fmt.Errorf("replay %s: %w", ID, err)
+	}
+	return nil
+}
+
+func (r Rebuilder) catchUp(ctx context.Context, collection *mongo.Collection,
+) (aggregates int, synced int, err error) {
+	err = r.source.Versions(ctx, r.aggregate, func(ID uuid.UUID, version int) error {
+		aggregates++
+		_, current, err := r.find(ctx, collection, ID)
+		if err != nil {
+			return err
+		}
+		if current >= version {
+			return nil
+		}
+		changed, err := r.sync(ctx, collection, ID, false)
+		if changed {
+			synced++
+		}
+		return err
+	})
+	return aggregates, synced, err
+}
+
+// sync projects the events of an aggregate that its row is missing. The row is only
+// replaced if its version did not change in the meantime, so events projected
+// concurrently by the outbox handler are never overwritten by an older state.
+func (r Rebuilder) sync(ctx context.Context, collection *mongo.Collection, ID uuid.UUID,
+	fromScratch bool,
+) (bool, error) {
+	for attempt := 0; attempt < maxSyncAttempts; attempt++ {
+		entity, current, err := r.find(ctx, collection, ID)
+		if err != nil {
+			return false, err
+		}
+		projected, version := entity, current
+		if fromScratch {
+			projected, version = r.entity(), 0
+		}
+		events, err := r.source.Load(ctx, ID)
+		if err != nil {
+			return false, fmt.Errorf("load events of %s: %w", ID, err)
+		}
+		for _, event := range events {
+			if event.Version() <= version {
+				continue
+			}
+			projected, err = r.projector.Project(ctx, event, projected)
+			if err != nil {
+				return false, fmt.Errorf("project %s: %w", event, err)
+			}
+			version = event.Version()
+		}
+		if version == current && !fromScratch {
+			return false, nil
+		}
+
+		filter := bson.M{"_id": ID, "version": current}
+		if current == 0 {
+			filter["version"] = bson.M{"$in": bson.A{0, nil}}
+		}
+		_, err = collection.ReplaceOne(ctx, filter, projected, options.Replace().SetUpsert(true))
+		if mongo.IsDuplicateKeyError(err) {
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("save %s: %w", ID, err)
+		}
+		return true, nil
+	}
+	return false, fmt.Errorf("save %s: %w", ID, ErrConcurrentUpdate)
+}
+
+func (r Rebuilder) find(ctx context.Context, collection *mongo.Collection, ID uuid.UUID,
+) (eventhorizon.Entity, int, error) {
+	entity := r.entity()
+	err := collection.FindOne(ctx, bson.M{"_id": ID}).Decode(entity)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return r.entity(), 0, nil
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("find %s: %w", ID, err)
+	}
+	versionable, ok := entity.(eventhorizon.Versionable)
+	if !ok {
+		return nil, 0, fmt.Errorf("entity %T is not versionable", entity)
+	}
+	return entity, versionable.AggregateVersion(), nil
+}
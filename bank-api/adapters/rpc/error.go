@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"sort"
+	"strconv"
 
 	"github.com/looplab/eventhorizon"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -40,6 +41,9 @@ func MapError(ctx context.Context, err error, errorMappings ...Mapping) error {
 	case *repositories.AlreadyExistsError:
 		return status.Error(codes.AlreadyExists, err.Error())
 
+	case *repositories.ConflictError:
+		return status.Error(codes.AlreadyExists, err.Error())
+
 	case *repositories.InternalError:
 		return status.Error(codes.Internal, err.Error())
 
@@ -52,6 +56,12 @@ func MapError(ctx context.Context, err error, errorMappings ...Mapping) error {
 		case *aggregates.PermissionError:
 			return status.Error(codes.PermissionDenied, err.Error())
 
+		case *aggregates.AmountError:
+			return status.Error(codes.FailedPrecondition, err.Error())
+
+		case *aggregates.LimitError:
+			return limitError(err)
+
 		default:
 			return MapError(ctx, err, errorMappings...)
 		}
@@ -64,6 +74,25 @@ func MapError(ctx context.Context, err error, errorMappings ...Mapping) error {
 	}
 }
 
+// limitError details which limit was exceeded, so clients can explain the rejection.
+func limitError(err *aggregates.LimitError) error {
+	status := status.New(codes.ResourceExhausted, err.Error())
+	statusWithDetails, detailsErr := status.WithDetails(&errdetails.ErrorInfo{
+		Reason: "LIMIT_EXCEEDED",
+		Domain: "codepix",
+		Metadata: map[string]string{
+			"limit":   err.Limit,
+			"maximum": strconv.FormatUint(err.Maximum, 10),
+			"used":    strconv.FormatUint(err.Used, 10),
+			"amount":  strconv.FormatUint(err.Amount, 10),
+		},
+	})
+	if detailsErr == nil {
+		return statusWithDetails.Err()
+	}
+	return status.Err()
+}
+
 func ValidationError(validator *validation.Validator,
 	ctx context.Context, validationError *validation.Error,
 ) *status.Status {
@@ -9,13 +9,54 @@ import (
 	"codepix/bank-api/adapters/rpc"
 	"codepix/bank-api/adapters/validator"
 	"codepix/bank-api/bank/auth"
+	brcodeservice "codepix/bank-api/brcode/service"
+	"codepix/bank-api/charge/payment"
+	chargeprojection "codepix/bank-api/charge/read/repository/projection"
+	chargereadservice "codepix/bank-api/charge/read/service"
+	chargereadstream "codepix/bank-api/charge/read/stream"
+	chargecommandhandler "codepix/bank-api/charge/write/commandhandler"
+	chargeaggregatestore "codepix/bank-api/charge/write/repository/aggregatestore"
+	chargewriteservice "codepix/bank-api/charge/write/service"
+	claimprojection "codepix/bank-api/claim/read/repository/projection"
+	claimreadservice "codepix/bank-api/claim/read/service"
+	claimreadstream "codepix/bank-api/claim/read/stream"
+	claimtimeout "codepix/bank-api/claim/timeout"
+	claimtransfer "codepix/bank-api/claim/transfer"
+	claimcommandhandler "codepix/bank-api/claim/write/commandhandler"
+	claimwriteservice "codepix/bank-api/claim/write/service"
 	"codepix/bank-api/config"
+	infractionprojection "codepix/bank-api/infraction/read/repository/projection"
+	infractionreadservice "codepix/bank-api/infraction/read/service"
+	infractionreadstream "codepix/bank-api/infraction/read/stream"
+	infractionrefund "codepix/bank-api/infraction/refund"
+	infractioncommandhandler "codepix/bank-api/infraction/write/commandhandler"
+	infractionwriteservice "codepix/bank-api/infraction/write/service"
+	"codepix/bank-api/limit"
+	limitdatabase "codepix/bank-api/limit/repository/database"
+	limitservice "codepix/bank-api/limit/service"
+	pixkeypublisher "codepix/bank-api/pixkey/publisher"
+	"codepix/bank-api/pixkey/ratelimit"
 	pixkeydatabase "codepix/bank-api/pixkey/repository/database"
 	pixkeyservice "codepix/bank-api/pixkey/service"
+	pixkeystream "codepix/bank-api/pixkey/stream"
+	"codepix/bank-api/pixkey/verification"
+	"codepix/bank-api/reserve"
+	reservedatabase "codepix/bank-api/reserve/repository/database"
+	reserveservice "codepix/bank-api/reserve/service"
+	"codepix/bank-api/risk"
+	"codepix/bank-api/settlement"
+	settlementdatabase "codepix/bank-api/settlement/repository/database"
+	settlementservice "codepix/bank-api/settlement/service"
+	txeventlog "codepix/bank-api/transaction/read/repository/eventlog"
 	txprojection "codepix/bank-api/transaction/read/repository/projection"
 	txreadservice "codepix/bank-api/transaction/read/service"
 	txreadstream "codepix/bank-api/transaction/read/stream"
+	txtimeout "codepix/bank-api/transaction/timeout"
+	txtimeoutdatabase "codepix/bank-api/transaction/timeout/repository/database"
 	txcommandhandler "codepix/bank-api/transaction/write/commandhandler"
+	txidempotency "codepix/bank-api/transaction/write/idempotency"
+	txidempotencyrepository "codepix/bank-api/transaction/write/idempotency/repository"
+	txidempotencydatabase "codepix/bank-api/transaction/write/idempotency/repository/database"
 	txwriteservice "codepix/bank-api/transaction/write/service"
 	txwritestream "codepix/bank-api/transaction/write/stream"
 	"context"
@@ -33,14 +74,19 @@ import (
 )
 
 type BankAPI struct {
-	logger     logr.Logger
-	config     config.Config
-	database   *databaseclient.Database
-	eventStore *eventstore.EventStore
-	projection *projectionclient.StoreProjection
-	eventBus   *eventbus.EventBus
-	commandBus eventhorizon.CommandHandler
-	server     *grpc.Server
+	logger       logr.Logger
+	config       config.Config
+	database     *databaseclient.Database
+	idempotency  txidempotencyrepository.Repository
+	eventStore   *eventstore.EventStore
+	projection   *projectionclient.StoreProjection
+	eventBus     *eventbus.EventBus
+	commandBus   eventhorizon.CommandHandler
+	timeout      *txtimeout.Manager
+	claimTimeout *claimtimeout.Expirer
+	pixKeys      *pixkeypublisher.Publisher
+	settlement   *settlement.Closer
+	server       *grpc.Server
 }
 
 func New(ctx context.Context, loggerImpl *zap.Logger, config config.Config) (*BankAPI, error) {
@@ -79,28 +125,109 @@ func New(ctx context.Context, loggerImpl *zap.Logger, config config.Config) (*Ba
 	if err != nil {
 		return nil, err
 	}
+	keyLimiter := ratelimit.New(config)
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			rpc.UnaryPanicHandler(panicLogger),
 			rpc.UnaryLogger(logger),
 			auth.UnaryTokenValidator(config),
 			rpc.UnaryValidator(validator),
+			keyLimiter.UnaryInterceptor(),
 		),
 		grpc.ChainStreamInterceptor(
 			rpc.StreamPanicHandler(panicLogger),
 			rpc.StreamLogger(logger),
 			auth.StreamTokenValidator(config),
 			rpc.StreamValidator(validator),
+			keyLimiter.StreamInterceptor(),
 		),
 	)
 
 	pixKeyRepository := &pixkeydatabase.Database{Database: database}
-	err = pixkeyservice.Register(server, validator, pixKeyRepository)
+	idempotencyRepository := &txidempotencydatabase.Database{Database: database}
+	deadlineRepository := &txtimeoutdatabase.Database{Database: database}
+	settlementRepository := &settlementdatabase.Database{Database: database}
+	reserveRepository := &reservedatabase.Database{Database: database}
+	limitRepository := &limitdatabase.Database{Database: database}
+	notifier, err := verification.NewNotifier(config, logger.WithName("verification"))
+	if err != nil {
+		return nil, err
+	}
+	err = pixkeyservice.Register(server, config, validator, pixKeyRepository, notifier)
+	if err != nil {
+		return nil, err
+	}
+	pixKeyPublisher := pixkeypublisher.New(logger, config, pixKeyRepository, eventStore.Outbox)
+	err = pixkeystream.Register(server, config, logger, eventBus)
+	if err != nil {
+		return nil, err
+	}
+	err = pixkeystream.SetupWriters(eventBus)
 	if err != nil {
 		return nil, err
 	}
 
-	err = txcommandhandler.Setup(eventStore, commandBusHandler)
+	chargeStore, err := chargecommandhandler.Setup(eventStore, commandBusHandler)
+	if err != nil {
+		return nil, err
+	}
+	chargeRepository := chargeaggregatestore.AggregateStore{Store: chargeStore}
+	err = payment.Setup(logger, eventStore.Outbox, commandBus)
+	if err != nil {
+		return nil, err
+	}
+	chargeReadRepository, err := chargeprojection.New(projection)
+	if err != nil {
+		return nil, err
+	}
+	err = chargereadservice.Register(server, chargeReadRepository)
+	if err != nil {
+		return nil, err
+	}
+	err = chargereadstream.Register(server, config, logger, eventBus)
+	if err != nil {
+		return nil, err
+	}
+	err = chargereadstream.SetupWriters(eventBus)
+	if err != nil {
+		return nil, err
+	}
+	err = chargewriteservice.Register(server, validator, commandBus, pixKeyRepository)
+	if err != nil {
+		return nil, err
+	}
+	err = brcodeservice.Register(server, config, validator, pixKeyRepository, chargeRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	limiter, err := limit.Setup(logger, config, eventStore.Outbox, limitRepository)
+	if err != nil {
+		return nil, err
+	}
+	err = limitservice.Register(server, validator, limitRepository)
+	if err != nil {
+		return nil, err
+	}
+	// refunds are held by the banks of their transactions, which the holder loads
+	txAggregateStore, err := eventStore.NewAggregateStore(config.Transaction.SnapshotInterval)
+	if err != nil {
+		return nil, err
+	}
+	err = txcommandhandler.Setup(config, eventStore, commandBusHandler,
+		limiter, reserve.Holder(logger.WithName("reserve"), reserveRepository, txAggregateStore))
+	if err != nil {
+		return nil, err
+	}
+	err = reserve.Setup(logger, eventStore.Outbox, reserveRepository)
+	if err != nil {
+		return nil, err
+	}
+	err = reserveservice.Register(server, config, validator, reserveRepository)
+	if err != nil {
+		return nil, err
+	}
+	txTimeout, err := txtimeout.Setup(logger, config, eventStore.Outbox, commandBus, deadlineRepository)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +235,8 @@ func New(ctx context.Context, loggerImpl *zap.Logger, config config.Config) (*Ba
 	if err != nil {
 		return nil, err
 	}
-	err = txreadservice.Register(server, txReadRepository)
+	txEventLog := txeventlog.EventLog{eventStore}
+	err = txreadservice.Register(server, txReadRepository, txEventLog)
 	if err != nil {
 		return nil, err
 	}
@@ -120,11 +248,93 @@ func New(ctx context.Context, loggerImpl *zap.Logger, config config.Config) (*Ba
 	if err != nil {
 		return nil, err
 	}
-	err = txwritestream.Register(logger, server, validator, commandBus, pixKeyRepository)
+	riskRules := risk.Default(config, txReadRepository)
+	err = txwritestream.Register(logger, server, config, validator,
+		commandBus, pixKeyRepository, idempotencyRepository, riskRules)
 	if err != nil {
 		return nil, err
 	}
-	err = txwriteservice.Register(server, validator, commandBus, pixKeyRepository)
+	err = txwriteservice.Register(logger, server, config, validator,
+		commandBus, pixKeyRepository, chargeRepository, idempotencyRepository, riskRules)
+	if err != nil {
+		return nil, err
+	}
+
+	err = infractioncommandhandler.Setup(eventStore, commandBusHandler)
+	if err != nil {
+		return nil, err
+	}
+	err = infractionrefund.Setup(logger, eventStore.Outbox, commandBus)
+	if err != nil {
+		return nil, err
+	}
+	infractionReadRepository, err := infractionprojection.New(projection)
+	if err != nil {
+		return nil, err
+	}
+	err = infractionreadservice.Register(server, infractionReadRepository)
+	if err != nil {
+		return nil, err
+	}
+	err = infractionreadstream.Register(server, config, logger, eventBus)
+	if err != nil {
+		return nil, err
+	}
+	err = infractionreadstream.SetupWriters(eventBus)
+	if err != nil {
+		return nil, err
+	}
+	err = infractionwriteservice.Register(server, validator, commandBus,
+		infractionReadRepository, txReadRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	err = claimcommandhandler.Setup(eventStore, commandBusHandler)
+	if err != nil {
+		return nil, err
+	}
+	err = claimtransfer.Setup(logger, config, validator, eventStore.Outbox,
+		pixKeyRepository)
+	if err != nil {
+		return nil, err
+	}
+	err = ratelimit.Setup(logger, eventStore.Outbox, keyLimiter)
+	if err != nil {
+		return nil, err
+	}
+	claimReadRepository, err := claimprojection.New(projection)
+	if err != nil {
+		return nil, err
+	}
+	claimTimeout := claimtimeout.New(logger, config, commandBus, claimReadRepository)
+	err = claimreadservice.Register(server, claimReadRepository)
+	if err != nil {
+		return nil, err
+	}
+	err = claimreadstream.Register(server, config, logger, eventBus)
+	if err != nil {
+		return nil, err
+	}
+	err = claimreadstream.SetupWriters(eventBus)
+	if err != nil {
+		return nil, err
+	}
+	err = claimwriteservice.Register(server, validator, commandBus, pixKeyRepository,
+		config.Claim.ResolveTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	var settlementCloser *settlement.Closer
+	if config.Settlement.Enabled {
+		settlementCloser, err = settlement.Setup(logger, config, eventStore.Outbox,
+			settlementRepository)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = settlementservice.Register(server, settlementRepository)
 	if err != nil {
 		return nil, err
 	}
@@ -132,14 +342,19 @@ func New(ctx context.Context, loggerImpl *zap.Logger, config config.Config) (*Ba
 	reflection.Register(server)
 
 	bankAPI := &BankAPI{
-		logger:     logger,
-		config:     config,
-		database:   database,
-		eventStore: eventStore,
-		projection: projection,
-		eventBus:   eventBus,
-		commandBus: commandBus,
-		server:     server,
+		logger:       logger,
+		config:       config,
+		database:     database,
+		idempotency:  idempotencyRepository,
+		eventStore:   eventStore,
+		projection:   projection,
+		eventBus:     eventBus,
+		commandBus:   commandBus,
+		timeout:      txTimeout,
+		claimTimeout: claimTimeout,
+		pixKeys:      pixKeyPublisher,
+		settlement:   settlementCloser,
+		server:       server,
 	}
 	return bankAPI, nil
 }
@@ -149,10 +364,25 @@ func (api BankAPI) Start(ctx context.Context) error {
 
 	err := api.database.AutoMigrate(
 		&pixkeydatabase.PixKey{},
+		&pixkeydatabase.PixKeyHolder{},
+		&pixkeydatabase.PixKeyEvent{},
+		&txidempotencydatabase.IdempotencyKey{},
+		&txtimeoutdatabase.Deadline{},
+		&settlementdatabase.SettlementFlow{},
+		&settlementdatabase.SettlementWindow{},
+		&settlementdatabase.SettlementReport{},
+		&reservedatabase.ReserveAccount{},
+		&reservedatabase.ReserveHold{},
+		&limitdatabase.LimitSetting{},
+		&limitdatabase.LimitUsage{},
 	)
 	if err != nil {
 		return err
 	}
+	err = api.dropOldPixKeyIndex()
+	if err != nil {
+		return err
+	}
 	err = api.eventStore.Start()
 	if err != nil {
 		return err
@@ -162,6 +392,15 @@ func (api BankAPI) Start(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	go txidempotency.PurgeExpired(ctx, api.logger.WithName("idempotency"),
+		api.idempotency, api.config.Transaction.IdempotencyPurgeInterval)
+	go api.timeout.Run(ctx)
+	go api.claimTimeout.Run(ctx)
+	go api.pixKeys.Run(ctx)
+	if api.settlement != nil {
+		go api.settlement.Run(ctx)
+	}
+
 	grpcLogger := api.logger.WithName("grpc")
 	grpcLogger.Info("grpc server listening on port " + api.config.RPC.Port)
 	go func() {
@@ -180,6 +419,16 @@ func (api BankAPI) Start(ctx context.Context) error {
 	return nil
 }
 
+// dropOldPixKeyIndex lets deleted keys be registered again in databases created before
+// keys could be deleted, along with the other migrations.
+func (api BankAPI) dropOldPixKeyIndex() error {
+	if !api.config.Database.AutoMigrate {
+		return nil
+	}
+	repository := pixkeydatabase.Database{Database: api.database}
+	return repository.DropOldKeyIndex()
+}
+
 func (api BankAPI) Stop() error {
 	api.logger.Info("stopping bank API")
 
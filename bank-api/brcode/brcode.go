@@ -0,0 +1,308 @@
+// Package brcode encodes and decodes BR Codes, the EMV-MPM payloads of Pix QR codes that
+// users scan or paste ("copia e cola").
+package brcode
+
+import (
+	"codepix/bank-api/pixkey"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Amount is in cents, as transaction amounts are, while payloads have decimal amounts.
+type Amount = uint64
+
+// Payload is a static payload when it has a key, paid as many times as wanted, or a
+// dynamic payload when it has the location of a charge.
+type Payload struct {
+	Key          pixkey.Key
+	Location     string
+	Amount       Amount
+	Description  string
+	MerchantName string
+	MerchantCity string
+	TxID         string
+}
+
+// FormatError means a payload cannot be encoded or decoded.
+type FormatError struct {
+	Reason string
+}
+
+func (e FormatError) Error() string {
+	return "invalid BR Code: " + e.Reason
+}
+
+const (
+	idPayloadFormat   = "00"
+	idInitiation      = "01"
+	idAccount         = "26"
+	idCategory        = "52"
+	idCurrency        = "53"
+	idAmount          = "54"
+	idCountry         = "58"
+	idMerchantName    = "59"
+	idMerchantCity    = "60"
+	idAdditionalData  = "62"
+	idCRC             = "63"
+	idAccountGUI      = "00"
+	idAccountKey      = "01"
+	idAccountInfo     = "02"
+	idAccountLocation = "25"
+	idAdditionalTxID  = "05"
+
+	payloadFormat     = "01"
+	dynamicInitiation = "12"
+	pixGUI            = "br.gov.bcb.pix"
+	category          = "0000"
+	currencyBRL       = "986"
+	countryBR         = "BR"
+	noTxID            = "***"
+
+	maxValueLength   = 99
+	maxPayloadLength = 512
+	crcLength        = 4
+)
+
+// Static returns the payload to pay a key, with a fixed amount if it is not 0.
+func Static(pixKey pixkey.PixKey, amount Amount, description, merchantName,
+	merchantCity string,
+) Payload {
+	return Payload{
+		Key:          pixKey.Key,
+		Amount:       amount,
+		Description:  description,
+		MerchantName: merchantName,
+		MerchantCity: merchantCity,
+	}
+}
+
+// Dynamic returns the payload to pay the charge at the location, without its scheme.
+func Dynamic(location string, amount Amount, merchantName, merchantCity string) Payload {
+	return Payload{
+		Location:     location,
+		Amount:       amount,
+		MerchantName: merchantName,
+		MerchantCity: merchantCity,
+	}
+}
+
+// ChargeLocation returns the location of a charge, under where charges are located.
+func ChargeLocation(chargeLocation string, chargeID uuid.UUID) string {
+	return chargeLocation + chargeID.String()
+}
+
+// ChargeID returns the ID of the charge at the location, if it is located under where
+// charges are located.
+func ChargeID(chargeLocation, location string) (uuid.UUID, bool) {
+	if chargeLocation == "" || !strings.HasPrefix(location, chargeLocation) {
+		return uuid.Nil, false
+	}
+	ID, err := uuid.Parse(strings.TrimPrefix(location, chargeLocation))
+	return ID, err == nil
+}
+
+func (p Payload) Dynamic() bool {
+	return p.Location != ""
+}
+
+// Encode returns the payload as text, ending with its CRC.
+func (p Payload) Encode() (string, error) {
+	if (p.Key == "") == (p.Location == "") {
+		return "", &FormatError{"either a key or a location is required"}
+	}
+	if p.MerchantName == "" || p.MerchantCity == "" {
+		return "", &FormatError{"merchant name and city are required"}
+	}
+	var account, payload strings.Builder
+	fields := []struct{ ID, value string }{
+		{idAccountGUI, pixGUI},
+		{idAccountKey, p.Key},
+		{idAccountInfo, p.Description},
+		{idAccountLocation, p.Location},
+	}
+	for _, field := range fields {
+		err := writeField(&account, field.ID, field.value)
+		if err != nil {
+			return "", err
+		}
+	}
+	txID := p.TxID
+	if txID == "" {
+		txID = noTxID
+	}
+	var additionalData strings.Builder
+	err := writeField(&additionalData, idAdditionalTxID, txID)
+	if err != nil {
+		return "", err
+	}
+	initiation := ""
+	if p.Dynamic() {
+		initiation = dynamicInitiation
+	}
+	amount := ""
+	if p.Amount > 0 {
+		amount = fmt.Sprintf("%d.%02d", p.Amount/100, p.Amount%100)
+	}
+	fields = []struct{ ID, value string }{
+		{idPayloadFormat, payloadFormat},
+		{idInitiation, initiation},
+		{idAccount, account.String()},
+		{idCategory, category},
+		{idCurrency, currencyBRL},
+		{idAmount, amount},
+		{idCountry, countryBR},
+		{idMerchantName, p.MerchantName},
+		{idMerchantCity, p.MerchantCity},
+		{idAdditionalData, additionalData.String()},
+	}
+	for _, field := range fields {
+		err := writeField(&payload, field.ID, field.value)
+		if err != nil {
+			return "", err
+		}
+	}
+	payload.WriteString(idCRC + fmt.Sprintf("%02d", crcLength))
+	payload.WriteString(fmt.Sprintf("%04X", crc16([]byte(payload.String()))))
+
+	if payload.Len() > maxPayloadLength {
+		return "", &FormatError{fmt.Sprintf("longer than %d characters", maxPayloadLength)}
+	}
+	return payload.String(), nil
+}
+
+// writeField skips empty values, as every optional field is left out when not set.
+func writeField(b *strings.Builder, ID, value string) error {
+	if value == "" {
+		return nil
+	}
+	length := utf8.RuneCountInString(value)
+	if length > maxValueLength {
+		return &FormatError{fmt.Sprintf("field %s longer than %d characters", ID, maxValueLength)}
+	}
+	b.WriteString(ID)
+	b.WriteString(fmt.Sprintf("%02d", length))
+	b.WriteString(value)
+	return nil
+}
+
+// Decode parses a payload, after checking its CRC.
+func Decode(text string) (*Payload, error) {
+	text = strings.TrimSpace(text)
+	if len(text) > maxPayloadLength {
+		return nil, &FormatError{fmt.Sprintf("longer than %d characters", maxPayloadLength)}
+	}
+	crcStart := len(text) - crcLength
+	if crcStart < 4 || text[crcStart-4:crcStart] != idCRC+fmt.Sprintf("%02d", crcLength) {
+		return nil, &FormatError{"missing CRC"}
+	}
+	crc, err := strconv.ParseUint(text[crcStart:], 16, 16)
+	if err != nil || uint16(crc) != crc16([]byte(text[:crcStart])) {
+		return nil, &FormatError{"CRC mismatch"}
+	}
+
+	fields, err := parseFields(text[:crcStart-4])
+	if err != nil {
+		return nil, err
+	}
+	if fields[idPayloadFormat] != payloadFormat {
+		return nil, &FormatError{"unknown payload format"}
+	}
+	if fields[idCurrency] != currencyBRL {
+		return nil, &FormatError{"currency is not BRL"}
+	}
+	account, err := parseFields(fields[idAccount])
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(account[idAccountGUI], pixGUI) {
+		return nil, &FormatError{"not a Pix payload"}
+	}
+	additionalData, err := parseFields(fields[idAdditionalData])
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseAmount(fields[idAmount])
+	if err != nil {
+		return nil, err
+	}
+	p := &Payload{
+		Key:          account[idAccountKey],
+		Location:     account[idAccountLocation],
+		Amount:       amount,
+		Description:  account[idAccountInfo],
+		MerchantName: fields[idMerchantName],
+		MerchantCity: fields[idMerchantCity],
+		TxID:         additionalData[idAdditionalTxID],
+	}
+	if p.TxID == noTxID {
+		p.TxID = ""
+	}
+	if (p.Key == "") == (p.Location == "") {
+		return nil, &FormatError{"either a key or a location is required"}
+	}
+	return p, nil
+}
+
+func parseFields(text string) (map[string]string, error) {
+	fields := map[string]string{}
+	runes := []rune(text)
+	for i := 0; i < len(runes); {
+		if i+4 > len(runes) {
+			return nil, &FormatError{"truncated field"}
+		}
+		ID := string(runes[i : i+2])
+		// lengths are exactly two digits, as Atoi would take signs too
+		tens, units := runes[i+2], runes[i+3]
+		if !isDigit(tens) || !isDigit(units) {
+			return nil, &FormatError{"invalid length of field " + ID}
+		}
+		length := int(tens-'0')*10 + int(units-'0')
+		i += 4
+		if i+length > len(runes) {
+			return nil, &FormatError{"truncated field " + ID}
+		}
+		fields[ID] = string(runes[i : i+length])
+		i += length
+	}
+	return fields, nil
+}
+
+func isDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
+
+func parseAmount(text string) (Amount, error) {
+	if text == "" {
+		return 0, nil
+	}
+	units, cents, _ := strings.Cut(text, ".")
+	if len(cents) > 2 {
+		return 0, &FormatError{"amount with more than 2 decimal places"}
+	}
+	cents += strings.Repeat("0", 2-len(cents))
+	amount, err := strconv.ParseUint(units+cents, 10, 64)
+	if err != nil || units == "" {
+		return 0, &FormatError{"invalid amount"}
+	}
+	return amount, nil
+}
+
+// crc16 is the CRC-16/CCITT-FALSE the payloads end with.
+func crc16(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,125 @@
+package brcode_test
+
+import (
+	"codepix/bank-api/brcode"
+	"codepix/bank-api/pixkey"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staticExample is the static payload of the BR Code manual by the Central Bank.
+const staticExample = "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-426655440000" +
+	"5204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D"
+
+func TestEncode(t *testing.T) {
+	pixKey := pixkey.PixKey{Type: pixkey.EmailKey, Key: "123e4567-e12b-12d1-a456-426655440000"}
+	payload := brcode.Static(pixKey, 0, "", "Fulano de Tal", "BRASILIA")
+
+	text, err := payload.Encode()
+	require.NoError(t, err)
+	assert.Equal(t, staticExample, text)
+}
+
+func TestRoundTrip(t *testing.T) {
+	testCases := map[string]brcode.Payload{
+		"static": brcode.Static(pixkey.PixKey{Type: pixkey.EmailKey, Key: "user@example.com"},
+			12345, "Lunch", "Maria Souza", "SAO PAULO"),
+		"static with txid": {
+			Key:          "user@example.com",
+			Amount:       5,
+			MerchantName: "Maria Souza",
+			MerchantCity: "SAO PAULO",
+			TxID:         "ORDER42",
+		},
+		"dynamic": brcode.Dynamic("pix.example.com/qr/v2/0b7e2c9a3c4f4d8e9d3f1a2b3c4d5e6f",
+			100000, "Loja Exemplo", "RIO DE JANEIRO"),
+		"accented": brcode.Static(pixkey.PixKey{Type: pixkey.EmailKey, Key: "user@example.com"},
+			0, "Pão de queijo", "João", "SÃO PAULO"),
+	}
+	for description, payload := range testCases {
+		t.Run(description, func(t *testing.T) {
+			text, err := payload.Encode()
+			require.NoError(t, err)
+
+			decoded, err := brcode.Decode(text)
+			require.NoError(t, err)
+			assert.Equal(t, payload, *decoded)
+			assert.Equal(t, payload.Location != "", decoded.Dynamic())
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tampered := []byte(staticExample)
+	tampered[len(tampered)-20] = 'X'
+
+	testCases := []struct {
+		description string
+		text        string
+		amount      brcode.Amount
+		err         bool
+	}{
+		{"manual example", staticExample, 0, false},
+		{"surrounding spaces", " " + staticExample + "\n", 0, false},
+		{"tampered", string(tampered), 0, true},
+		{"missing CRC", staticExample[:len(staticExample)-8], 0, true},
+		{"empty", "", 0, true},
+		{"one decimal place", withAmount("10.5"), 1050, false},
+		{"no decimal places", withAmount("10"), 1000, false},
+		{"too many decimal places", withAmount("10.505"), 0, true},
+		{"negative amount", withAmount("-10.00"), 0, true},
+		{"negative length", withCRC("00020154-1"), 0, true},
+		{"signed length", withCRC("00020154+510.00"), 0, true},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			payload, err := brcode.Decode(tc.text)
+			if tc.err {
+				require.ErrorAs(t, err, new(*brcode.FormatError))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "123e4567-e12b-12d1-a456-426655440000", payload.Key)
+			assert.Equal(t, tc.amount, payload.Amount)
+			assert.Equal(t, "Fulano de Tal", payload.MerchantName)
+			assert.Empty(t, payload.TxID)
+		})
+	}
+}
+
+func TestEncodeInvalid(t *testing.T) {
+	key := pixkey.PixKey{Type: pixkey.EmailKey, Key: "user@example.com"}
+	testCases := map[string]brcode.Payload{
+		"no key nor location": {MerchantName: "Maria", MerchantCity: "RIO"},
+		"key and location": {Key: "user@example.com", Location: "pix.example.com/1",
+			MerchantName: "Maria", MerchantCity: "RIO"},
+		"no merchant":           brcode.Static(key, 0, "", "", ""),
+		"account info too long": brcode.Static(key, 0, string(make([]byte, 80)), "Maria", "RIO"),
+	}
+	for description, payload := range testCases {
+		t.Run(description, func(t *testing.T) {
+			_, err := payload.Encode()
+			require.ErrorAs(t, err, new(*brcode.FormatError))
+		})
+	}
+}
+
+// withAmount returns the manual example with an amount, which is encoded again so its
+// CRC still matches.
+func withAmount(amount string) string {
+	const beforeCountry = "5303986"
+	i := len("00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-426655440000" +
+		"52040000" + beforeCountry)
+	text := staticExample[:i] + fmt.Sprintf("54%02d%s", len(amount), amount) +
+		staticExample[i:len(staticExample)-4]
+	return text + fmt.Sprintf("%04X", brcode.CRC16([]byte(text)))
+}
+
+// withCRC ends fields with a matching CRC.
+func withCRC(fields string) string {
+	text := fields + "6304"
+	return text + fmt.Sprintf("%04X", brcode.CRC16([]byte(text)))
+}
@@ -0,0 +1,3 @@
+package brcode
+
+var CRC16 = crc16
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"bytes"
+	"codepix/bank-api/adapters/validator"
+	chargerepository "codepix/bank-api/charge/write/repository"
+	"codepix/bank-api/config"
+	"codepix/bank-api/lib/validation"
+	pixkeyrepository "codepix/bank-api/pixkey/repository"
+	proto "codepix/bank-api/proto/codepix/brcode"
+	_ "embed"
+
+	"google.golang.org/grpc"
+)
+
+//go:embed translations.json
+var translations []byte
+
+func Register(server *grpc.Server, config config.Config, val *validation.Validator,
+	pixKeyRepository pixkeyrepository.Repository, chargeRepository chargerepository.Repository,
+) error {
+	err := validator.LoadTranslationFile(val, bytes.NewReader(translations),
+		proto.EncodeStaticRequest{},
+		proto.EncodeChargeRequest{},
+		proto.DecodeRequest{},
+	)
+	if err != nil {
+		return err
+	}
+	service := &Service{
+		PixKeyRepository: pixKeyRepository,
+		ChargeRepository: chargeRepository,
+		ChargeLocation:   config.BRCode.ChargeLocation,
+	}
+	proto.RegisterServiceServer(server, service)
+	return nil
+}
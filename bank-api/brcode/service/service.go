@@ -0,0 +1,100 @@
+package service
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/brcode"
+	chargerepository "codepix/bank-api/charge/write/repository"
+	pixkeyrepository "codepix/bank-api/pixkey/repository"
+	proto "codepix/bank-api/proto/codepix/brcode"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service struct {
+	PixKeyRepository pixkeyrepository.Repository
+	ChargeRepository chargerepository.Repository
+	ChargeLocation   string
+	proto.UnimplementedServiceServer
+}
+
+var _ proto.ServiceServer = Service{}
+
+// EncodeStatic is allowed to the bank of the key, as payers pay whoever owns the key.
+func (s Service) EncodeStatic(ctx context.Context, req *proto.EncodeStaticRequest,
+) (*proto.Encoded, error) {
+	bankID := auth.GetBankID(ctx)
+
+	pixKey, IDs, err := s.PixKeyRepository.FindByKey(req.Key)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	if IDs.BankID != bankID {
+		return nil, status.Error(codes.PermissionDenied, "")
+	}
+	payload := brcode.Static(*pixKey, req.Amount, req.Description,
+		req.MerchantName, req.MerchantCity)
+	payload.TxID = req.Txid
+	return encodedReply(ctx, payload)
+}
+
+// EncodeCharge is allowed to the receiver bank of the charge.
+func (s Service) EncodeCharge(ctx context.Context, req *proto.EncodeChargeRequest,
+) (*proto.Encoded, error) {
+	bankID := auth.GetBankID(ctx)
+	chargeID, _ := uuid.FromBytes(req.ChargeId)
+
+	charge, err := s.ChargeRepository.Find(ctx, chargeID)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	if charge.ReceiverBank != bankID {
+		return nil, status.Error(codes.PermissionDenied, "")
+	}
+	location := brcode.ChargeLocation(s.ChargeLocation, chargeID)
+	payload := brcode.Dynamic(location, charge.Amount, req.MerchantName, req.MerchantCity)
+	return encodedReply(ctx, payload)
+}
+
+func encodedReply(ctx context.Context, payload brcode.Payload) (*proto.Encoded, error) {
+	text, err := payload.Encode()
+	if err != nil {
+		return nil, mapError(ctx, err)
+	}
+	return &proto.Encoded{
+		Payload: text,
+	}, nil
+}
+
+func (s Service) Decode(ctx context.Context, req *proto.DecodeRequest,
+) (*proto.DecodeReply, error) {
+	payload, err := brcode.Decode(req.Payload)
+	if err != nil {
+		return nil, mapError(ctx, err)
+	}
+	reply := &proto.DecodeReply{
+		Key:          payload.Key,
+		Location:     payload.Location,
+		Amount:       payload.Amount,
+		Description:  payload.Description,
+		MerchantName: payload.MerchantName,
+		MerchantCity: payload.MerchantCity,
+		Txid:         payload.TxID,
+	}
+	if chargeID, ok := brcode.ChargeID(s.ChargeLocation, payload.Location); ok {
+		reply.ChargeId = chargeID[:]
+	}
+	return reply, nil
+}
+
+func mapError(ctx context.Context, err error) error {
+	formatError := &brcode.FormatError{}
+	if errors.As(err, &formatError) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return rpc.MapError(ctx, err)
+}
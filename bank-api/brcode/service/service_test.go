@@ -0,0 +1,168 @@
+package service_test
+
+import (
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/brcode"
+	"codepix/bank-api/brcode/service"
+	"codepix/bank-api/charge"
+	"codepix/bank-api/charge/chargetest"
+	"codepix/bank-api/lib/repositories"
+	"codepix/bank-api/pixkey/pixkeytest"
+	pixkeyrepository "codepix/bank-api/pixkey/repository"
+	proto "codepix/bank-api/proto/codepix/brcode"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const chargeLocation = "pix.example.com/charges/"
+
+func serviceWithMocks() (proto.ServiceClient, *pixkeytest.MockRepo, *chargetest.MockRepo) {
+	validator, err := validator.New()
+	if err != nil {
+		panic(err)
+	}
+	server, client, serve := bankapitest.Server(validator)
+	pixKeyRepo := new(pixkeytest.MockRepo)
+	chargeRepo := new(chargetest.MockRepo)
+
+	config := bankapitest.Config
+	config.BRCode.ChargeLocation = chargeLocation
+	err = service.Register(server, config, validator, pixKeyRepo, chargeRepo)
+	if err != nil {
+		panic(err)
+	}
+	serve()
+	return proto.NewServiceClient(client), pixKeyRepo, chargeRepo
+}
+
+func TestEncodeStatic(t *testing.T) {
+	client, pixKeyRepo, _ := serviceWithMocks()
+
+	pixKey := pixkeytest.ValidPixKey()
+	IDs := &pixkeyrepository.IDs{
+		PixKeyID:  uuid.New(),
+		AccountID: uuid.New(),
+		BankID:    uuid.New(),
+	}
+	request := &proto.EncodeStaticRequest{
+		Key:          pixKey.Key,
+		Amount:       1050,
+		Description:  "Lunch",
+		MerchantName: "Maria Souza",
+		MerchantCity: "SAO PAULO",
+	}
+
+	testCases := []struct {
+		description string
+		bankID      uuid.UUID
+		findErr     error
+		code        codes.Code
+	}{
+		{"valid", IDs.BankID, nil, codes.OK},
+		{"key of another bank", uuid.New(), nil, codes.PermissionDenied},
+		{"key not found", IDs.BankID, &repositories.NotFoundError{}, codes.NotFound},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			if tc.findErr != nil {
+				pixKeyRepo.On("FindByKey", pixKey.Key).Return(nil, nil, tc.findErr).Once()
+			} else {
+				pixKeyRepo.On("FindByKey", pixKey.Key).Return(&pixKey, IDs, nil).Once()
+			}
+			ctx := bankapitest.AuthenticatedContext(context.Background(), tc.bankID)
+
+			encoded, err := client.EncodeStatic(ctx, request)
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.code.String(), status.Code().String())
+			if tc.code != codes.OK {
+				return
+			}
+			decoded, err := client.Decode(ctx, &proto.DecodeRequest{Payload: encoded.Payload})
+			require.NoError(t, err)
+			assert.Equal(t, pixKey.Key, decoded.Key)
+			assert.Equal(t, request.Amount, decoded.Amount)
+			assert.Equal(t, request.MerchantName, decoded.MerchantName)
+			assert.Equal(t, request.Description, decoded.Description)
+			assert.Empty(t, decoded.ChargeId)
+		})
+	}
+}
+
+func TestEncodeCharge(t *testing.T) {
+	client, _, chargeRepo := serviceWithMocks()
+
+	chargeID := uuid.New()
+	active := &charge.Charge{
+		Receiver:     uuid.New(),
+		ReceiverBank: uuid.New(),
+		Amount:       2500,
+		Status:       charge.Active,
+	}
+	request := &proto.EncodeChargeRequest{
+		ChargeId:     chargeID[:],
+		MerchantName: "Loja Exemplo",
+		MerchantCity: "RIO DE JANEIRO",
+	}
+
+	testCases := []struct {
+		description string
+		bankID      uuid.UUID
+		findErr     error
+		code        codes.Code
+	}{
+		{"valid", active.ReceiverBank, nil, codes.OK},
+		{"charge of another bank", uuid.New(), nil, codes.PermissionDenied},
+		{"charge not found", active.ReceiverBank, &repositories.NotFoundError{}, codes.NotFound},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			if tc.findErr != nil {
+				chargeRepo.On("Find", mock.Anything, chargeID).Return(nil, tc.findErr).Once()
+			} else {
+				chargeRepo.On("Find", mock.Anything, chargeID).Return(active, nil).Once()
+			}
+			ctx := bankapitest.AuthenticatedContext(context.Background(), tc.bankID)
+
+			encoded, err := client.EncodeCharge(ctx, request)
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.code.String(), status.Code().String())
+			if tc.code != codes.OK {
+				return
+			}
+			decoded, err := client.Decode(ctx, &proto.DecodeRequest{Payload: encoded.Payload})
+			require.NoError(t, err)
+			assert.Equal(t, chargeLocation+chargeID.String(), decoded.Location)
+			assert.Equal(t, chargeID[:], decoded.ChargeId)
+			assert.Equal(t, active.Amount, decoded.Amount)
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	client, _, _ := serviceWithMocks()
+	ctx := bankapitest.AuthenticatedContext(context.Background(), uuid.New())
+
+	// a charge located elsewhere can be read, but not paid
+	location := "pix.elsewhere.com/qr/9d36b84fc70b478fb95c12729b90ca25"
+	elsewhere, err := brcode.Dynamic(location, 0, "Fulano de Tal", "BRASILIA").Encode()
+	require.NoError(t, err)
+	reply, err := client.Decode(ctx, &proto.DecodeRequest{Payload: elsewhere})
+	require.NoError(t, err)
+	assert.Equal(t, location, reply.Location)
+	assert.Empty(t, reply.ChargeId)
+
+	_, err = client.Decode(ctx, &proto.DecodeRequest{Payload: elsewhere[:len(elsewhere)-4] + "0000"})
+	status, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument.String(), status.Code().String())
+}
@@ -0,0 +1,45 @@
+package charge
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Status uint8
+type Amount = uint64
+
+const (
+	Active Status = iota + 1
+	Reserved
+	Paid
+)
+
+// Charge is a payment requested by a receiver. Payers pay it by starting a transaction
+// that references it, which reserves the charge until the transaction completes or fails.
+// Payer and PayerBank restrict who may pay it when set.
+type Charge struct {
+	Receiver     uuid.UUID
+	ReceiverBank uuid.UUID
+	Payer        uuid.UUID
+	PayerBank    uuid.UUID
+	Amount       Amount
+	ExpiresAt    time.Time
+	TxID         string
+	Description  string
+	Status       Status
+	Transaction  uuid.UUID
+	PayingBank   uuid.UUID
+}
+
+// Expired tells whether the charge can no longer be reserved. Reserved charges are
+// still paid after they expire, as the payment started in time.
+func (c Charge) Expired(now time.Time) bool {
+	return c.Status == Active && !now.Before(c.ExpiresAt)
+}
+
+// ID returns the charge ID of a txid. Each receiver bank chooses its txids, so the same
+// txid always refers to the same charge of that bank, and creating it again fails.
+func ID(receiverBank uuid.UUID, txID string) uuid.UUID {
+	return uuid.NewSHA1(receiverBank, []byte(txID))
+}
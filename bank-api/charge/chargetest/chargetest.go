@@ -0,0 +1,45 @@
+package chargetest
+
+import (
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/bankapitest"
+	readservice "codepix/bank-api/charge/read/service"
+	writeservice "codepix/bank-api/charge/write/service"
+	"codepix/bank-api/pixkey/pixkeytest"
+	readproto "codepix/bank-api/proto/codepix/charge/read"
+	writeproto "codepix/bank-api/proto/codepix/charge/write"
+)
+
+func WriteServiceWithMocks() (writeproto.ServiceClient, *MockCommandHandler,
+	*pixkeytest.MockRepo) {
+	validator, err := validator.New()
+	if err != nil {
+		panic(err)
+	}
+	server, client, serve := bankapitest.Server(validator)
+	commandHandler := new(MockCommandHandler)
+	pixKeyRepo := new(pixkeytest.MockRepo)
+
+	err = writeservice.Register(server, validator, commandHandler, pixKeyRepo)
+	if err != nil {
+		panic(err)
+	}
+	serve()
+	return writeproto.NewServiceClient(client), commandHandler, pixKeyRepo
+}
+
+func ReadServiceWithMocks() (readproto.ServiceClient, *MockReadRepo) {
+	validator, err := validator.New()
+	if err != nil {
+		panic(err)
+	}
+	server, client, serve := bankapitest.Server(validator)
+	readRepo := new(MockReadRepo)
+
+	err = readservice.Register(server, readRepo)
+	if err != nil {
+		panic(err)
+	}
+	serve()
+	return readproto.NewServiceClient(client), readRepo
+}
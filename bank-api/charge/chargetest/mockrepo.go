@@ -0,0 +1,21 @@
+package chargetest
+
+import (
+	"codepix/bank-api/charge"
+	"codepix/bank-api/charge/write/repository"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepo struct {
+	mock.Mock
+}
+
+var _ repository.Repository = MockRepo{}
+
+func (m MockRepo) Find(ctx context.Context, ID uuid.UUID) (*charge.Charge, error) {
+	args := m.Called(ctx, ID)
+	return get[*charge.Charge](args, 0), get[error](args, 1)
+}
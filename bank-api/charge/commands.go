@@ -0,0 +1,193 @@
+package charge
+
+import (
+	"codepix/bank-api/lib/aggregates"
+	"time"
+
+	eh "github.com/looplab/eventhorizon"
+	"github.com/looplab/eventhorizon/uuid"
+)
+
+var (
+	ErrAlreadyCreated = &aggregates.StatusMismatchError{
+		"charge already created",
+	}
+	ErrCannotCreateIfExpired = &aggregates.StatusMismatchError{
+		"cannot create charge if already expired",
+	}
+	ErrCannotReserveIfNotActive = &aggregates.StatusMismatchError{
+		"cannot reserve charge if not active",
+	}
+	ErrCannotReserveIfExpired = &aggregates.StatusMismatchError{
+		"cannot reserve charge if expired",
+	}
+	ErrCannotReleaseIfNotReserved = &aggregates.StatusMismatchError{
+		"cannot release charge if not reserved by the transaction",
+	}
+	ErrCannotPayIfNotReserved = &aggregates.StatusMismatchError{
+		"cannot pay charge if not reserved by the transaction",
+	}
+
+	ErrCannotCreateIfNotTheReceiver = &aggregates.PermissionError{
+		"cannot create charge if not the receiver",
+	}
+	ErrCannotReserveIfNotThePayer = &aggregates.PermissionError{
+		"cannot reserve charge if not the payer",
+	}
+	ErrCannotReleaseIfNotThePayer = &aggregates.PermissionError{
+		"cannot release charge if not the payer",
+	}
+	ErrCannotPayIfNotThePayer = &aggregates.PermissionError{
+		"cannot pay charge if not the payer",
+	}
+
+	ErrAmountMismatch = &aggregates.AmountError{
+		"amount does not match the charge",
+	}
+)
+
+type Command interface {
+	ToEvent(ag Aggregate) (Event, error)
+}
+
+type Create struct {
+	ID           uuid.UUID
+	BankID       uuid.UUID
+	Receiver     uuid.UUID
+	ReceiverBank uuid.UUID
+	Payer        uuid.UUID `eh:"optional"`
+	PayerBank    uuid.UUID `eh:"optional"`
+	Amount       Amount
+	ExpiresAt    time.Time
+	TxID         string
+	Description  string `eh:"optional"`
+}
+
+func (c Create) ToEvent(ag Aggregate) (Event, error) {
+	if ag.Charge.Status != 0 {
+		return nil, ErrAlreadyCreated
+	}
+	if c.BankID != c.ReceiverBank {
+		return nil, ErrCannotCreateIfNotTheReceiver
+	}
+	if !time.Now().Before(c.ExpiresAt) {
+		return nil, ErrCannotCreateIfExpired
+	}
+	return ChargeCreated{
+		Receiver:     c.Receiver,
+		ReceiverBank: c.ReceiverBank,
+		Payer:        c.Payer,
+		PayerBank:    c.PayerBank,
+		Amount:       c.Amount,
+		ExpiresAt:    c.ExpiresAt,
+		TxID:         c.TxID,
+		Description:  c.Description,
+	}, nil
+}
+
+// Reserve holds an active charge for the transaction that pays it, so it cannot be paid
+// twice. The payer must match the restrictions of the charge.
+type Reserve struct {
+	ID            uuid.UUID
+	BankID        uuid.UUID
+	TransactionID uuid.UUID
+	Payer         uuid.UUID
+	Amount        Amount
+}
+
+func (c Reserve) ToEvent(ag Aggregate) (Event, error) {
+	charge := ag.Charge
+	if charge.Status != Active {
+		return nil, ErrCannotReserveIfNotActive
+	}
+	if charge.Expired(time.Now()) {
+		return nil, ErrCannotReserveIfExpired
+	}
+	if (charge.Payer != uuid.Nil && c.Payer != charge.Payer) ||
+		(charge.PayerBank != uuid.Nil && c.BankID != charge.PayerBank) {
+		return nil, ErrCannotReserveIfNotThePayer
+	}
+	if c.Amount != charge.Amount {
+		return nil, ErrAmountMismatch
+	}
+	return ChargeReserved{
+		TransactionID: c.TransactionID,
+		Payer:         c.Payer,
+		PayerBank:     c.BankID,
+		ReceiverBank:  charge.ReceiverBank,
+	}, nil
+}
+
+// Release makes a reserved charge active again, once its transaction failed.
+type Release struct {
+	ID            uuid.UUID
+	BankID        uuid.UUID
+	TransactionID uuid.UUID
+}
+
+func (c Release) ToEvent(ag Aggregate) (Event, error) {
+	charge := ag.Charge
+	if charge.Status != Reserved || charge.Transaction != c.TransactionID {
+		return nil, ErrCannotReleaseIfNotReserved
+	}
+	if c.BankID != charge.PayingBank {
+		return nil, ErrCannotReleaseIfNotThePayer
+	}
+	return ChargeReleased{
+		TransactionID: c.TransactionID,
+		PayerBank:     charge.PayingBank,
+		ReceiverBank:  charge.ReceiverBank,
+	}, nil
+}
+
+// Pay settles a reserved charge, once its transaction completed.
+type Pay struct {
+	ID            uuid.UUID
+	BankID        uuid.UUID
+	TransactionID uuid.UUID
+}
+
+func (c Pay) ToEvent(ag Aggregate) (Event, error) {
+	charge := ag.Charge
+	if charge.Status != Reserved || charge.Transaction != c.TransactionID {
+		return nil, ErrCannotPayIfNotReserved
+	}
+	if c.BankID != charge.PayingBank {
+		return nil, ErrCannotPayIfNotThePayer
+	}
+	return ChargePaid{
+		TransactionID: c.TransactionID,
+		PayerBank:     charge.PayingBank,
+		ReceiverBank:  charge.ReceiverBank,
+	}, nil
+}
+
+const (
+	CreateCommand  = eh.CommandType(AggregateType + "_create")
+	ReserveCommand = eh.CommandType(AggregateType + "_reserve")
+	ReleaseCommand = eh.CommandType(AggregateType + "_release")
+	PayCommand     = eh.CommandType(AggregateType + "_pay")
+)
+
+func init() {
+	eh.RegisterCommand(func() eh.Command { return Create{} })
+	eh.RegisterCommand(func() eh.Command { return Reserve{} })
+	eh.RegisterCommand(func() eh.Command { return Release{} })
+	eh.RegisterCommand(func() eh.Command { return Pay{} })
+}
+
+func (c Create) AggregateID() uuid.UUID          { return c.ID }
+func (c Create) AggregateType() eh.AggregateType { return AggregateType }
+func (c Create) CommandType() eh.CommandType     { return CreateCommand }
+
+func (c Reserve) AggregateID() uuid.UUID          { return c.ID }
+func (c Reserve) AggregateType() eh.AggregateType { return AggregateType }
+func (c Reserve) CommandType() eh.CommandType     { return ReserveCommand }
+
+func (c Release) AggregateID() uuid.UUID          { return c.ID }
+func (c Release) AggregateType() eh.AggregateType { return AggregateType }
+func (c Release) CommandType() eh.CommandType     { return ReleaseCommand }
+
+func (c Pay) AggregateID() uuid.UUID          { return c.ID }
+func (c Pay) AggregateType() eh.AggregateType { return AggregateType }
+func (c Pay) CommandType() eh.CommandType     { return PayCommand }
@@ -0,0 +1,236 @@
+package charge_test
+
+import (
+	"codepix/bank-api/charge"
+	"codepix/bank-api/lib/aggregates"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var receiverBank = uuid.New()
+var payerBank = uuid.New()
+var payer = uuid.New()
+var transactionID = uuid.New()
+
+func activeCharge(mutate ...func(*charge.Charge)) *charge.Charge {
+	c := &charge.Charge{
+		Receiver:     uuid.New(),
+		ReceiverBank: receiverBank,
+		Amount:       100,
+		ExpiresAt:    time.Now().Add(time.Hour),
+		TxID:         "abcdefghijklmnopqrstuvwxyz",
+		Status:       charge.Active,
+	}
+	for _, m := range mutate {
+		m(c)
+	}
+	return c
+}
+func expiredCharge() *charge.Charge {
+	return activeCharge(func(c *charge.Charge) { c.ExpiresAt = time.Now().Add(-time.Second) })
+}
+func reservedCharge() *charge.Charge {
+	return activeCharge(func(c *charge.Charge) {
+		c.Status = charge.Reserved
+		c.Transaction = transactionID
+		c.PayingBank = payerBank
+	})
+}
+func paidCharge() *charge.Charge {
+	return activeCharge(func(c *charge.Charge) {
+		c.Status = charge.Paid
+		c.Transaction = transactionID
+		c.PayingBank = payerBank
+	})
+}
+
+// handle runs the command on a copy of the initial state, and returns the state after
+// its event is applied.
+func handle(t *testing.T, initialState *charge.Charge, cmd eventhorizon.Command, err error,
+) *charge.Charge {
+	ctx := context.Background()
+	ag := charge.New(uuid.New())
+	state := *initialState
+	ag.Charge = &state
+
+	actual := ag.HandleCommand(ctx, cmd)
+	if err != nil {
+		require.IsType(t, &aggregates.InvariantViolation{}, actual)
+		require.ErrorAs(t, actual, &err)
+		assert.Equal(t, initialState, ag.Charge)
+		return ag.Charge
+	}
+	require.NoError(t, actual)
+	lastEvent := ag.UncommittedEvents()[len(ag.UncommittedEvents())-1]
+	require.NoError(t, ag.ApplyEvent(ctx, lastEvent))
+	return ag.Charge
+}
+
+func TestCreateCharge(t *testing.T) {
+	valid := charge.Create{
+		ID:           uuid.New(),
+		BankID:       receiverBank,
+		Receiver:     uuid.New(),
+		ReceiverBank: receiverBank,
+		PayerBank:    payerBank,
+		Amount:       100,
+		ExpiresAt:    time.Now().Add(time.Hour),
+		TxID:         "abcdefghijklmnopqrstuvwxyz",
+	}
+	notTheReceiver := valid
+	notTheReceiver.BankID = payerBank
+	expired := valid
+	expired.ExpiresAt = time.Now().Add(-time.Second)
+
+	testCases := []struct {
+		initialState *charge.Charge
+		cmd          charge.Create
+		err          error
+	}{
+		{&charge.Charge{}, valid, nil},
+		{activeCharge(), valid, charge.ErrAlreadyCreated},
+		{paidCharge(), valid, charge.ErrAlreadyCreated},
+
+		{&charge.Charge{}, notTheReceiver, charge.ErrCannotCreateIfNotTheReceiver},
+		{&charge.Charge{}, expired, charge.ErrCannotCreateIfExpired},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			state := handle(t, tc.initialState, tc.cmd, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, charge.Active, state.Status)
+				assert.Equal(t, tc.cmd.PayerBank, state.PayerBank)
+				assert.Equal(t, tc.cmd.Amount, state.Amount)
+			}
+		})
+	}
+}
+
+func TestReserveCharge(t *testing.T) {
+	valid := charge.Reserve{
+		ID:            uuid.New(),
+		BankID:        payerBank,
+		TransactionID: transactionID,
+		Payer:         payer,
+		Amount:        100,
+	}
+	wrongAmount := valid
+	wrongAmount.Amount = 99
+
+	restrictedToPayer := activeCharge(func(c *charge.Charge) { c.Payer = uuid.New() })
+	restrictedToBank := activeCharge(func(c *charge.Charge) { c.PayerBank = uuid.New() })
+	restrictedToCaller := activeCharge(func(c *charge.Charge) {
+		c.Payer = payer
+		c.PayerBank = payerBank
+	})
+
+	testCases := []struct {
+		initialState *charge.Charge
+		cmd          charge.Reserve
+		err          error
+	}{
+		{activeCharge(), valid, nil},
+		{restrictedToCaller, valid, nil},
+		{&charge.Charge{}, valid, charge.ErrCannotReserveIfNotActive},
+		{reservedCharge(), valid, charge.ErrCannotReserveIfNotActive},
+		{paidCharge(), valid, charge.ErrCannotReserveIfNotActive},
+		{expiredCharge(), valid, charge.ErrCannotReserveIfExpired},
+
+		{restrictedToPayer, valid, charge.ErrCannotReserveIfNotThePayer},
+		{restrictedToBank, valid, charge.ErrCannotReserveIfNotThePayer},
+		{activeCharge(), wrongAmount, charge.ErrAmountMismatch},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			state := handle(t, tc.initialState, tc.cmd, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, charge.Reserved, state.Status)
+				assert.Equal(t, transactionID, state.Transaction)
+				assert.Equal(t, payerBank, state.PayingBank)
+				// the restrictions of the charge are kept
+				assert.Equal(t, tc.initialState.PayerBank, state.PayerBank)
+			}
+		})
+	}
+}
+
+func TestReleaseCharge(t *testing.T) {
+	valid := charge.Release{
+		ID:            uuid.New(),
+		BankID:        payerBank,
+		TransactionID: transactionID,
+	}
+	otherTransaction := valid
+	otherTransaction.TransactionID = uuid.New()
+	notThePayer := valid
+	notThePayer.BankID = receiverBank
+
+	testCases := []struct {
+		initialState *charge.Charge
+		cmd          charge.Release
+		err          error
+	}{
+		{reservedCharge(), valid, nil},
+		{activeCharge(), valid, charge.ErrCannotReleaseIfNotReserved},
+		{paidCharge(), valid, charge.ErrCannotReleaseIfNotReserved},
+		{reservedCharge(), otherTransaction, charge.ErrCannotReleaseIfNotReserved},
+
+		{reservedCharge(), notThePayer, charge.ErrCannotReleaseIfNotThePayer},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			state := handle(t, tc.initialState, tc.cmd, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, activeCharge(func(c *charge.Charge) {
+					c.Receiver = state.Receiver
+					c.ExpiresAt = state.ExpiresAt
+				}), state)
+			}
+		})
+	}
+}
+
+func TestPayCharge(t *testing.T) {
+	valid := charge.Pay{
+		ID:            uuid.New(),
+		BankID:        payerBank,
+		TransactionID: transactionID,
+	}
+	otherTransaction := valid
+	otherTransaction.TransactionID = uuid.New()
+	notThePayer := valid
+	notThePayer.BankID = receiverBank
+
+	expiredWhileReserved := reservedCharge()
+	expiredWhileReserved.ExpiresAt = time.Now().Add(-time.Second)
+
+	testCases := []struct {
+		initialState *charge.Charge
+		cmd          charge.Pay
+		err          error
+	}{
+		{reservedCharge(), valid, nil},
+		{expiredWhileReserved, valid, nil},
+		{activeCharge(), valid, charge.ErrCannotPayIfNotReserved},
+		{paidCharge(), valid, charge.ErrCannotPayIfNotReserved},
+		{reservedCharge(), otherTransaction, charge.ErrCannotPayIfNotReserved},
+
+		{reservedCharge(), notThePayer, charge.ErrCannotPayIfNotThePayer},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			state := handle(t, tc.initialState, tc.cmd, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, charge.Paid, state.Status)
+				assert.False(t, state.Expired(time.Now()))
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package charge
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	eh "github.com/looplab/eventhorizon"
+)
+
+type Event interface {
+	Apply(charge *Charge)
+	Type() eh.EventType
+}
+
+type ChargeCreated struct {
+	Receiver     uuid.UUID `json:"receiver" bson:"receiver"`
+	ReceiverBank uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
+	Payer        uuid.UUID `json:"payer" bson:"payer"`
+	PayerBank    uuid.UUID `json:"payer_bank" bson:"payer_bank"`
+	Amount       Amount    `json:"amount" bson:"amount"`
+	ExpiresAt    time.Time `json:"expires_at" bson:"expires_at"`
+	TxID         string    `json:"txid" bson:"txid"`
+	Description  string    `json:"description" bson:"description"`
+}
+
+func (e ChargeCreated) Apply(charge *Charge) {
+	charge.Receiver = e.Receiver
+	charge.ReceiverBank = e.ReceiverBank
+	charge.Payer = e.Payer
+	charge.PayerBank = e.PayerBank
+	charge.Amount = e.Amount
+	charge.ExpiresAt = e.ExpiresAt
+	charge.TxID = e.TxID
+	charge.Description = e.Description
+	charge.Status = Active
+}
+
+type ChargeReserved struct {
+	TransactionID uuid.UUID `json:"transaction_id" bson:"transaction_id"`
+	Payer         uuid.UUID `json:"payer" bson:"payer"`
+	PayerBank     uuid.UUID `json:"payer_bank" bson:"payer_bank"`
+	ReceiverBank  uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
+}
+
+func (e ChargeReserved) Apply(charge *Charge) {
+	charge.Status = Reserved
+	charge.Transaction = e.TransactionID
+	charge.PayingBank = e.PayerBank
+}
+
+type ChargeReleased struct {
+	TransactionID uuid.UUID `json:"transaction_id" bson:"transaction_id"`
+	PayerBank     uuid.UUID `json:"payer_bank" bson:"payer_bank"`
+	ReceiverBank  uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
+}
+
+func (e ChargeReleased) Apply(charge *Charge) {
+	charge.Status = Active
+	charge.Transaction = uuid.Nil
+	charge.PayingBank = uuid.Nil
+}
+
+type ChargePaid struct {
+	TransactionID uuid.UUID `json:"transaction_id" bson:"transaction_id"`
+	PayerBank     uuid.UUID `json:"payer_bank" bson:"payer_bank"`
+	ReceiverBank  uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
+}
+
+func (e ChargePaid) Apply(charge *Charge) {
+	charge.Status = Paid
+}
+
+const (
+	CreatedEvent  = eh.EventType(AggregateType + "_created")
+	ReservedEvent = eh.EventType(AggregateType + "_reserved")
+	ReleasedEvent = eh.EventType(AggregateType + "_released")
+	PaidEvent     = eh.EventType(AggregateType + "_paid")
+)
+
+func init() {
+	eh.RegisterEventData(CreatedEvent, func() eh.EventData { return &ChargeCreated{} })
+	eh.RegisterEventData(ReservedEvent, func() eh.EventData { return &ChargeReserved{} })
+	eh.RegisterEventData(ReleasedEvent, func() eh.EventData { return &ChargeReleased{} })
+	eh.RegisterEventData(PaidEvent, func() eh.EventData { return &ChargePaid{} })
+}
+
+func (ChargeCreated) Type() eh.EventType  { return CreatedEvent }
+func (ChargeReserved) Type() eh.EventType { return ReservedEvent }
+func (ChargeReleased) Type() eh.EventType { return ReleasedEvent }
+func (ChargePaid) Type() eh.EventType     { return PaidEvent }
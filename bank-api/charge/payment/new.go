@@ -0,0 +1,28 @@
+package payment
+
+import (
+	"codepix/bank-api/adapters/eventhandler"
+	"codepix/bank-api/transaction"
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+)
+
+func Setup(logger logr.Logger, outbox eventhorizon.Outbox,
+	commandHandler eventhorizon.CommandHandler,
+) error {
+	settler := Settler{CommandHandler: commandHandler}
+	err := outbox.AddHandler(context.Background(),
+		eventhorizon.MatchEvents{transaction.CompletedEvent, transaction.FailedEvent},
+		eventhandler.Named(
+			eventhandler.Logger(logger.WithName("payment"), settler),
+			settler.HandlerType(),
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("setup charge payment: %w", err)
+	}
+	return nil
+}
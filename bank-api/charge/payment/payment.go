@@ -0,0 +1,57 @@
+package payment
+
+import (
+	"codepix/bank-api/charge"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/transaction"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+// Settler pays a reserved charge once the transaction paying it completes, and releases
+// it once the transaction fails, so it can be paid again.
+type Settler struct {
+	CommandHandler eventhorizon.CommandHandler
+}
+
+var _ eventhorizon.EventHandler = Settler{}
+
+func (s Settler) HandlerType() eventhorizon.EventHandlerType {
+	return eventhorizon.EventHandlerType(charge.AggregateType + "_payment")
+}
+
+func (s Settler) HandleEvent(ctx context.Context, event eventhorizon.Event) error {
+	var cmd eventhorizon.Command
+	switch e := event.Data().(type) {
+	case *transaction.TransactionCompleted:
+		if e.ChargeID == uuid.Nil {
+			return nil
+		}
+		cmd = charge.Pay{
+			ID:            e.ChargeID,
+			BankID:        e.SenderBank,
+			TransactionID: event.AggregateID(),
+		}
+	case *transaction.TransactionFailed:
+		if e.ChargeID == uuid.Nil {
+			return nil
+		}
+		cmd = charge.Release{
+			ID:            e.ChargeID,
+			BankID:        e.SenderBank,
+			TransactionID: event.AggregateID(),
+		}
+	default:
+		return nil
+	}
+	err := s.CommandHandler.HandleCommand(ctx, cmd)
+	// an invariant violation means the event was already handled
+	invariantViolation := &aggregates.InvariantViolation{}
+	if errors.As(err, &invariantViolation) {
+		return nil
+	}
+	return err
+}
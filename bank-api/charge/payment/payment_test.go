@@ -0,0 +1,75 @@
+package payment_test
+
+import (
+	"codepix/bank-api/charge"
+	"codepix/bank-api/charge/chargetest"
+	"codepix/bank-api/charge/payment"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/transaction"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSettler(t *testing.T) {
+	ID := uuid.New()
+	chargeID := uuid.New()
+	senderBank := uuid.New()
+	receiverBank := uuid.New()
+
+	event := func(eventType eventhorizon.EventType, data eventhorizon.EventData,
+	) eventhorizon.Event {
+		return eventhorizon.NewEvent(eventType, data, time.Now(),
+			eventhorizon.ForAggregate(transaction.AggregateType, ID, 3))
+	}
+	completed := event(transaction.CompletedEvent, &transaction.TransactionCompleted{
+		SenderBank: senderBank, ReceiverBank: receiverBank, ChargeID: chargeID,
+	})
+	failed := event(transaction.FailedEvent, &transaction.TransactionFailed{
+		SenderBank: senderBank, ReceiverBank: receiverBank, ChargeID: chargeID,
+	})
+	withoutCharge := event(transaction.CompletedEvent, &transaction.TransactionCompleted{
+		SenderBank: senderBank, ReceiverBank: receiverBank,
+	})
+
+	testCases := []struct {
+		description string
+		event       eventhorizon.Event
+		cmd         eventhorizon.Command
+		cmdErr      error
+		err         bool
+	}{
+		{"completed pays the charge", completed,
+			charge.Pay{chargeID, senderBank, ID}, nil, false},
+		{"failed releases the charge", failed,
+			charge.Release{chargeID, senderBank, ID}, nil, false},
+		{"transaction without a charge", withoutCharge,
+			nil, nil, false},
+		{"already handled", completed,
+			charge.Pay{chargeID, senderBank, ID},
+			&aggregates.InvariantViolation{charge.ErrCannotPayIfNotReserved}, false},
+		{"command error is retried", failed,
+			charge.Release{chargeID, senderBank, ID}, errors.New("some error"), true},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			commandHandler := new(chargetest.MockCommandHandler)
+			if tc.cmd != nil {
+				commandHandler.On("HandleCommand", mock.Anything, tc.cmd).
+					Return(tc.cmdErr).Once()
+			}
+			settler := payment.Settler{CommandHandler: commandHandler}
+
+			err := settler.HandleEvent(context.Background(), tc.event)
+			assert.Equal(t, tc.err, err != nil)
+			commandHandler.AssertExpectations(t)
+		})
+	}
+}
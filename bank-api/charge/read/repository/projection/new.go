@@ -0,0 +1,27 @@
+package projection
+
+import (
+	"codepix/bank-api/adapters/projectionclient"
+	"codepix/bank-api/charge"
+	"codepix/bank-api/charge/read/repository"
+	"fmt"
+
+	"github.com/looplab/eventhorizon"
+)
+
+func New(client *projectionclient.StoreProjection) (*Projection, error) {
+	projector := &Projector{}
+	entityType := func() eventhorizon.Entity {
+		return &repository.Charge{}
+	}
+	projection, err := client.Setup(
+		projector.ProjectorType(),
+		entityType,
+		projector,
+		charge.AggregateType,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("new Projection: %w", err)
+	}
+	return &Projection{projection}, nil
+}
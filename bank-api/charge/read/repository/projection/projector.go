@@ -0,0 +1,64 @@
+package projection
+
+import (
+	"codepix/bank-api/charge"
+	"codepix/bank-api/charge/read/repository"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/looplab/eventhorizon/eventhandler/projector"
+)
+
+type Projector struct{}
+
+var _ projector.Projector = Projector{}
+
+func (p Projector) ProjectorType() projector.Type {
+	return projector.Type(repository.RepositoryType)
+}
+
+func (p Projector) Project(ctx context.Context, event eventhorizon.Event, entity eventhorizon.Entity,
+) (eventhorizon.Entity, error) {
+	c, ok := entity.(*repository.Charge)
+	if !ok {
+		return nil, fmt.Errorf("unknown entity type %T", entity)
+	}
+	if event.Version() != c.Version+1 {
+		return nil, fmt.Errorf("%w: expected version %d, got %d",
+			eventhorizon.ErrIncorrectEntityVersion, c.Version+1, event.Version())
+	}
+	switch e := event.Data().(type) {
+	case *charge.ChargeCreated:
+		c.ID = event.AggregateID()
+		c.Receiver = e.Receiver
+		c.ReceiverBank = e.ReceiverBank
+		c.Payer = e.Payer
+		c.PayerBank = e.PayerBank
+
+		c.CreatedAt = event.Timestamp()
+		c.ExpiresAt = e.ExpiresAt
+		c.Amount = e.Amount
+		c.TxID = e.TxID
+		c.Description = e.Description
+		c.Status = charge.Active
+
+	case *charge.ChargeReserved:
+		c.Status = charge.Reserved
+		c.TransactionID = e.TransactionID
+
+	case *charge.ChargeReleased:
+		c.Status = charge.Active
+		c.TransactionID = uuid.Nil
+
+	case *charge.ChargePaid:
+		c.Status = charge.Paid
+
+	default:
+		return nil, fmt.Errorf("unknown event type %s/%T", event.EventType(), event.Data())
+	}
+	c.UpdatedAt = event.Timestamp()
+	c.Version = event.Version()
+	return c, nil
+}
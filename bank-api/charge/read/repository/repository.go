@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"codepix/bank-api/charge"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+var EntityType = "charge"
+var RepositoryType = "charges"
+
+type Repository interface {
+	Find(ctx context.Context, ID uuid.UUID) (*Charge, error)
+	List(ctx context.Context, options ListOptions) ([]ListItem, error)
+}
+
+type Charge struct {
+	ID           uuid.UUID `bson:"_id"`
+	Receiver     uuid.UUID `bson:"receiver"`
+	ReceiverBank uuid.UUID `bson:"receiver_bank"`
+	Payer        uuid.UUID `bson:"payer"`
+	PayerBank    uuid.UUID `bson:"payer_bank"`
+
+	CreatedAt     time.Time     `bson:"created_at"`
+	UpdatedAt     time.Time     `bson:"updated_at"`
+	ExpiresAt     time.Time     `bson:"expires_at"`
+	Amount        charge.Amount `bson:"amount"`
+	TxID          string        `bson:"txid"`
+	Description   string        `bson:"description"`
+	Status        charge.Status `bson:"status"`
+	TransactionID uuid.UUID     `bson:"transaction_id"`
+
+	Version int `bson:"version"`
+}
+
+var _ eventhorizon.Entity = Charge{}
+
+func (c Charge) EntityID() uuid.UUID {
+	return c.ID
+}
+
+var _ eventhorizon.Versionable = Charge{}
+
+func (c Charge) AggregateVersion() int {
+	return c.Version
+}
+
+type ListItem = Charge
+
+type ListOptions struct {
+	CreatedAfter time.Time
+	ReceiverBank uuid.UUID
+	Limit        uint64
+	Skip         uint64
+}
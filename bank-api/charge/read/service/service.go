@@ -0,0 +1,126 @@
+package service
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/charge"
+	"codepix/bank-api/charge/read/repository"
+	proto "codepix/bank-api/proto/codepix/charge/read"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Service struct {
+	Repository repository.Repository
+	proto.UnimplementedServiceServer
+}
+
+var _ proto.ServiceServer = Service{}
+
+// Find is allowed to the receiver bank, and to the banks which may pay the charge, so
+// payers can look it up before paying it.
+func (s Service) Find(ctx context.Context, req *proto.FindRequest) (*proto.FindReply, error) {
+	bankID := auth.GetBankID(ctx)
+	ID, _ := uuid.FromBytes(req.Id)
+
+	charge, err := s.Repository.Find(ctx, ID)
+	if err == nil {
+		allowed := charge.ReceiverBank == bankID ||
+			charge.PayerBank == uuid.Nil || charge.PayerBank == bankID
+		if !allowed {
+			return nil, status.Error(codes.PermissionDenied, "")
+		}
+	}
+	return findReply(charge, time.Now()), rpc.MapError(ctx, err)
+}
+
+func findReply(charge *repository.Charge, now time.Time) *proto.FindReply {
+	if charge == nil {
+		return nil
+	}
+	return &proto.FindReply{
+		Id:           charge.ID[:],
+		Receiver:     charge.Receiver[:],
+		ReceiverBank: charge.ReceiverBank[:],
+		Payer:        optionalID(charge.Payer),
+		PayerBank:    optionalID(charge.PayerBank),
+
+		CreatedAt:     timestamppb.New(charge.CreatedAt),
+		UpdatedAt:     timestamppb.New(charge.UpdatedAt),
+		ExpiresAt:     timestamppb.New(charge.ExpiresAt),
+		Amount:        charge.Amount,
+		Txid:          charge.TxID,
+		Description:   charge.Description,
+		Status:        statusReply(*charge, now),
+		TransactionId: optionalID(charge.TransactionID),
+	}
+}
+
+// List only returns the charges created by the calling bank.
+func (s Service) List(ctx context.Context, req *proto.ListRequest) (*proto.ListReply, error) {
+	bankID := auth.GetBankID(ctx)
+
+	options := repository.ListOptions{
+		CreatedAfter: req.CreatedAfter.AsTime(),
+		ReceiverBank: bankID,
+		Limit:        req.Limit,
+		Skip:         req.Skip,
+	}
+	charges, err := s.Repository.List(ctx, options)
+	return listReply(charges, time.Now()), rpc.MapError(ctx, err)
+}
+
+func listReply(charges []repository.ListItem, now time.Time) *proto.ListReply {
+	if charges == nil {
+		return nil
+	}
+	items := []*proto.ListItem{}
+	for _, charge := range charges {
+		items = append(items, listItemReply(charge, now))
+	}
+	return &proto.ListReply{
+		Items: items,
+	}
+}
+
+func listItemReply(charge repository.ListItem, now time.Time) *proto.ListItem {
+	return &proto.ListItem{
+		Id:           charge.ID[:],
+		Receiver:     charge.Receiver[:],
+		ReceiverBank: charge.ReceiverBank[:],
+		Payer:        optionalID(charge.Payer),
+		PayerBank:    optionalID(charge.PayerBank),
+
+		CreatedAt:     timestamppb.New(charge.CreatedAt),
+		UpdatedAt:     timestamppb.New(charge.UpdatedAt),
+		ExpiresAt:     timestamppb.New(charge.ExpiresAt),
+		Amount:        charge.Amount,
+		Txid:          charge.TxID,
+		Description:   charge.Description,
+		Status:        statusReply(charge, now),
+		TransactionId: optionalID(charge.TransactionID),
+	}
+}
+
+// statusReply reports active charges past their expiry as expired. No event marks them,
+// they just can no longer be reserved.
+func statusReply(c repository.Charge, now time.Time) proto.Status {
+	expired := charge.Charge{Status: c.Status, ExpiresAt: c.ExpiresAt}.Expired(now)
+	if expired {
+		return proto.Status_Expired
+	}
+	return proto.Status(c.Status)
+}
+
+// optionalID leaves IDs that are not set empty, instead of sending nil UUIDs.
+func optionalID(ID uuid.UUID) []byte {
+	if ID == uuid.Nil {
+		return nil
+	}
+	return ID[:]
+}
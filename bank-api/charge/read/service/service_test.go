@@ -0,0 +1,112 @@
+package service_test
+
+import (
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/charge"
+	"codepix/bank-api/charge/chargetest"
+	"codepix/bank-api/charge/read/repository"
+	"codepix/bank-api/lib/repositories"
+	proto "codepix/bank-api/proto/codepix/charge/read"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func validCharge() *repository.Charge {
+	return &repository.Charge{
+		ID:           uuid.New(),
+		Receiver:     uuid.New(),
+		ReceiverBank: uuid.New(),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+		ExpiresAt:    time.Now().Add(time.Hour),
+		Amount:       100,
+		TxID:         "abcdefghijklmnopqrstuvwxyz",
+		Status:       charge.Active,
+		Version:      1,
+	}
+}
+
+func TestFind(t *testing.T) {
+	client, readRepo := chargetest.ReadServiceWithMocks()
+
+	active := validCharge()
+	restricted := validCharge()
+	restricted.PayerBank = uuid.New()
+	expired := validCharge()
+	expired.ExpiresAt = time.Now().Add(-time.Second)
+	reserved := validCharge()
+	reserved.ExpiresAt = time.Now().Add(-time.Second)
+	reserved.Status = charge.Reserved
+	reserved.TransactionID = uuid.New()
+
+	testCases := []struct {
+		description string
+		bankID      uuid.UUID
+		charge      *repository.Charge
+		err         error
+		code        codes.Code
+		status      proto.Status
+	}{
+		{"receiver bank", active.ReceiverBank, active, nil, codes.OK, proto.Status_Active},
+		{"any payer", uuid.New(), active, nil, codes.OK, proto.Status_Active},
+		{"allowed payer", restricted.PayerBank, restricted, nil, codes.OK, proto.Status_Active},
+		{"other payer", uuid.New(), restricted, nil, codes.PermissionDenied, 0},
+		{"expired", expired.ReceiverBank, expired, nil, codes.OK, proto.Status_Expired},
+		{"reserved before expiring", reserved.ReceiverBank, reserved, nil,
+			codes.OK, proto.Status_Reserved},
+		{"not found", uuid.New(), nil, &repositories.NotFoundError{}, codes.NotFound, 0},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			ID := uuid.New()
+			if tc.charge != nil {
+				ID = tc.charge.ID
+			}
+			readRepo.On("Find", mock.Anything, ID).Return(tc.charge, tc.err).Once()
+			ctx := bankapitest.AuthenticatedContext(context.Background(), tc.bankID)
+
+			reply, err := client.Find(ctx, &proto.FindRequest{Id: ID[:]})
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.code.String(), status.Code().String())
+			if tc.code == codes.OK {
+				require.NotNil(t, reply)
+				assert.Equal(t, tc.status, reply.Status)
+				assert.Equal(t, tc.charge.Amount, reply.Amount)
+				if tc.charge.TransactionID == uuid.Nil {
+					assert.Empty(t, reply.TransactionId)
+				} else {
+					assert.Equal(t, tc.charge.TransactionID[:], reply.TransactionId)
+				}
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	client, readRepo := chargetest.ReadServiceWithMocks()
+
+	bankID := uuid.New()
+	ctx := bankapitest.AuthenticatedContext(context.Background(), bankID)
+	charges := []repository.ListItem{*validCharge(), *validCharge()}
+
+	// only the charges of the calling bank are listed
+	readRepo.On("List", mock.Anything, mock.MatchedBy(func(options repository.ListOptions) bool {
+		return options.ReceiverBank == bankID && options.Limit == 10
+	})).Return(charges, nil).Once()
+
+	reply, err := client.List(ctx, &proto.ListRequest{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, reply.Items, 2)
+	assert.Equal(t, charges[0].ID[:], reply.Items[0].Id)
+	assert.Equal(t, charges[1].ID[:], reply.Items[1].Id)
+}
@@ -0,0 +1,62 @@
+package stream
+
+import (
+	"codepix/bank-api/adapters/eventbus"
+	"codepix/bank-api/charge"
+	"codepix/bank-api/config"
+	proto "codepix/bank-api/proto/codepix/charge/read"
+	txstream "codepix/bank-api/transaction/read/stream"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+	"google.golang.org/grpc"
+)
+
+func Register(server *grpc.Server, config config.Config, logger logr.Logger,
+	eventBus *eventbus.EventBus) error {
+	cfg := config.Transaction
+
+	busReader, err := eventBus.CreateReader(cfg.BusBlockDuration, cfg.BusMaxPendingAge)
+	if err != nil {
+		return err
+	}
+	stream := &Stream{
+		Consumer: txstream.Stream{
+			Logger:    logger.WithName("chargestream"),
+			BusReader: busReader,
+		},
+	}
+	proto.RegisterStreamServer(server, stream)
+	return nil
+}
+
+func SetupWriters(eventBus *eventbus.EventBus) error {
+	err := eventBus.SetupWriter(charge.ReservedEvent, func(event eventhorizon.Event) []string {
+		reserved := event.Data().(*charge.ChargeReserved)
+		return []string{
+			charge.ReservedStream(reserved.ReceiverBank),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = eventBus.SetupWriter(charge.ReleasedEvent, func(event eventhorizon.Event) []string {
+		released := event.Data().(*charge.ChargeReleased)
+		return []string{
+			charge.ReleasedStream(released.ReceiverBank),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = eventBus.SetupWriter(charge.PaidEvent, func(event eventhorizon.Event) []string {
+		paid := event.Data().(*charge.ChargePaid)
+		return []string{
+			charge.PaidStream(paid.ReceiverBank),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package stream
+
+import (
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/charge"
+	proto "codepix/bank-api/proto/codepix/charge/read"
+	txproto "codepix/bank-api/proto/codepix/transaction/read"
+	txstream "codepix/bank-api/transaction/read/stream"
+
+	"github.com/looplab/eventhorizon"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Stream sends charge events to the receiver bank, consuming them the same way the
+// transaction stream does.
+type Stream struct {
+	Consumer txstream.Stream
+	proto.UnimplementedStreamServer
+}
+
+var _ proto.StreamServer = Stream{}
+
+type ackReceiver interface {
+	Recv() (*proto.Ack, error)
+}
+
+func receiveAck(stream ackReceiver) func() (*txproto.Ack, error) {
+	return func() (*txproto.Ack, error) {
+		ack, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return &txproto.Ack{Nacks: ack.Nacks}, nil
+	}
+}
+
+func (s Stream) Reserved(stream proto.Stream_ReservedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.ReservedCharge{}
+		for _, event := range events {
+			p := reservedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.ReservedCharges{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consumer.Consume(stream.Context(),
+		sender,
+		receiveAck(stream),
+		charge.ReservedEvent,
+		charge.ReservedStream(bankID),
+		bankID.String(),
+	)
+}
+func reservedMapper(event eventhorizon.Event) *proto.ReservedCharge {
+	ID := event.AggregateID()
+	reserved := event.Data().(*charge.ChargeReserved)
+	return &proto.ReservedCharge{
+		Id:            ID[:],
+		Timestamp:     timestamppb.New(event.Timestamp()),
+		TransactionId: reserved.TransactionID[:],
+		Payer:         reserved.Payer[:],
+		PayerBank:     reserved.PayerBank[:],
+	}
+}
+
+func (s Stream) Released(stream proto.Stream_ReleasedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.ReleasedCharge{}
+		for _, event := range events {
+			p := releasedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.ReleasedCharges{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consumer.Consume(stream.Context(),
+		sender,
+		receiveAck(stream),
+		charge.ReleasedEvent,
+		charge.ReleasedStream(bankID),
+		bankID.String(),
+	)
+}
+func releasedMapper(event eventhorizon.Event) *proto.ReleasedCharge {
+	ID := event.AggregateID()
+	released := event.Data().(*charge.ChargeReleased)
+	return &proto.ReleasedCharge{
+		Id:            ID[:],
+		Timestamp:     timestamppb.New(event.Timestamp()),
+		TransactionId: released.TransactionID[:],
+	}
+}
+
+func (s Stream) Paid(stream proto.Stream_PaidServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.PaidCharge{}
+		for _, event := range events {
+			p := paidMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.PaidCharges{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consumer.Consume(stream.Context(),
+		sender,
+		receiveAck(stream),
+		charge.PaidEvent,
+		charge.PaidStream(bankID),
+		bankID.String(),
+	)
+}
+func paidMapper(event eventhorizon.Event) *proto.PaidCharge {
+	ID := event.AggregateID()
+	paid := event.Data().(*charge.ChargePaid)
+	return &proto.PaidCharge{
+		Id:            ID[:],
+		Timestamp:     timestamppb.New(event.Timestamp()),
+		TransactionId: paid.TransactionID[:],
+	}
+}
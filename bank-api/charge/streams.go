@@ -0,0 +1,11 @@
+package charge
+
+import "github.com/google/uuid"
+
+const reservedStream = string(ReservedEvent) + "_"
+const releasedStream = string(ReleasedEvent) + "_"
+const paidStream = string(PaidEvent) + "_"
+
+func ReservedStream(bankID uuid.UUID) string { return reservedStream + bankID.String() }
+func ReleasedStream(bankID uuid.UUID) string { return releasedStream + bankID.String() }
+func PaidStream(bankID uuid.UUID) string     { return paidStream + bankID.String() }
@@ -0,0 +1,36 @@
+package commandhandler
+
+import (
+	"codepix/bank-api/adapters/eventstore"
+	"codepix/bank-api/charge"
+
+	"github.com/looplab/eventhorizon"
+	"github.com/looplab/eventhorizon/commandhandler/aggregate"
+	"github.com/looplab/eventhorizon/commandhandler/bus"
+)
+
+// Setup handles charge commands. Charges only have a few events, so they are not
+// snapshotted.
+func Setup(eventStore *eventstore.EventStore, commandBus *bus.CommandHandler,
+) (eventhorizon.AggregateStore, error) {
+	aggregateStore, err := eventStore.NewAggregateStore(0)
+	if err != nil {
+		return nil, err
+	}
+	commandHandler, err := aggregate.NewCommandHandler(charge.AggregateType, aggregateStore)
+	if err != nil {
+		return nil, err
+	}
+	commands := []eventhorizon.CommandType{
+		charge.CreateCommand,
+		charge.ReserveCommand,
+		charge.ReleaseCommand,
+		charge.PayCommand,
+	}
+	for _, cmdType := range commands {
+		if err := commandBus.SetHandler(commandHandler, cmdType); err != nil {
+			return nil, err
+		}
+	}
+	return aggregateStore, nil
+}
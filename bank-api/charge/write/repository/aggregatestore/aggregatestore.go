@@ -0,0 +1,34 @@
+package aggregatestore
+
+import (
+	"codepix/bank-api/charge"
+	"codepix/bank-api/charge/write/repository"
+	"codepix/bank-api/lib/repositories"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+type AggregateStore struct {
+	Store eventhorizon.AggregateStore
+}
+
+var _ repository.Repository = AggregateStore{}
+
+func (s AggregateStore) Find(ctx context.Context, ID uuid.UUID) (*charge.Charge, error) {
+	aggregate, err := s.Store.Load(ctx, charge.AggregateType, ID)
+	if err != nil {
+		return nil, &repositories.InternalError{"load", repository.EntityType, err.Error()}
+	}
+	ag, ok := aggregate.(*charge.Aggregate)
+	if !ok {
+		return nil, &repositories.InternalError{"load", repository.EntityType,
+			fmt.Sprintf("unknown aggregate type %T", aggregate)}
+	}
+	if ag.Charge.Status == 0 {
+		return nil, &repositories.NotFoundError{repository.EntityType}
+	}
+	return ag.Charge, nil
+}
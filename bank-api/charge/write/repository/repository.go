@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"codepix/bank-api/charge"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+var EntityType = "charge"
+
+// Repository reads charges from their events, so charges are found as soon as they are
+// created, unlike in the read projection.
+type Repository interface {
+	Find(ctx context.Context, ID uuid.UUID) (*charge.Charge, error)
+}
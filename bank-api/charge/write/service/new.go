@@ -0,0 +1,30 @@
+package service
+
+import (
+	"bytes"
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/charge/write"
+	"codepix/bank-api/lib/validation"
+	pixkeyrepository "codepix/bank-api/pixkey/repository"
+	proto "codepix/bank-api/proto/codepix/charge/write"
+
+	"github.com/looplab/eventhorizon"
+	"google.golang.org/grpc"
+)
+
+func Register(server *grpc.Server, val *validation.Validator,
+	commandHandler eventhorizon.CommandHandler, pixKeyRepository pixkeyrepository.Repository,
+) error {
+	err := validator.LoadTranslationFile(val, bytes.NewReader(write.Translations),
+		proto.CreateRequest{},
+	)
+	if err != nil {
+		return err
+	}
+	service := &Service{
+		CommandHandler:   commandHandler,
+		PixKeyRepository: pixKeyRepository,
+	}
+	proto.RegisterServiceServer(server, service)
+	return nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/charge"
+	pixkeyrepository "codepix/bank-api/pixkey/repository"
+	proto "codepix/bank-api/proto/codepix/charge/write"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+type Service struct {
+	CommandHandler   eventhorizon.CommandHandler
+	PixKeyRepository pixkeyrepository.Repository
+	proto.UnimplementedServiceServer
+}
+
+var _ proto.ServiceServer = Service{}
+
+func (s Service) Create(ctx context.Context, req *proto.CreateRequest) (*proto.Created, error) {
+	bankID := auth.GetBankID(ctx)
+
+	_, receiverIDs, err := s.PixKeyRepository.FindByKey(req.ReceiverKey)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	cmd := createCommand(req, bankID, *receiverIDs)
+	err = s.CommandHandler.HandleCommand(ctx, cmd)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	return createReply(cmd.ID), nil
+}
+
+func createCommand(req *proto.CreateRequest, bankID uuid.UUID,
+	receiverIDs pixkeyrepository.IDs) charge.Create {
+	payerID, _ := uuid.FromBytes(req.PayerId)
+	payerBank, _ := uuid.FromBytes(req.PayerBank)
+	return charge.Create{
+		ID:           charge.ID(bankID, req.Txid),
+		BankID:       bankID,
+		Receiver:     receiverIDs.AccountID,
+		ReceiverBank: receiverIDs.BankID,
+		Payer:        payerID,
+		PayerBank:    payerBank,
+		Amount:       req.Amount,
+		ExpiresAt:    req.ExpiresAt.AsTime(),
+		TxID:         req.Txid,
+		Description:  req.Description,
+	}
+}
+func createReply(ID uuid.UUID) *proto.Created {
+	return &proto.Created{
+		Id: ID[:],
+	}
+}
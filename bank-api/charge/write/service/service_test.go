@@ -0,0 +1,106 @@
+package service_test
+
+import (
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/charge"
+	"codepix/bank-api/charge/chargetest"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/lib/repositories"
+	"codepix/bank-api/pixkey/pixkeytest"
+	pixkeyrepository "codepix/bank-api/pixkey/repository"
+	proto "codepix/bank-api/proto/codepix/charge/write"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreate(t *testing.T) {
+	client, commandHandler, pixKeyRepo := chargetest.WriteServiceWithMocks()
+
+	pixKey := pixkeytest.ValidPixKey()
+	receiverIDs := &pixkeyrepository.IDs{
+		PixKeyID:  uuid.New(),
+		AccountID: uuid.New(),
+		BankID:    uuid.New(),
+	}
+	ctx := bankapitest.AuthenticatedContext(context.Background(), receiverIDs.BankID)
+	ctxWithLocale := metadata.AppendToOutgoingContext(ctx, "locale", validator.EN_US)
+
+	payerBank := uuid.New()
+	valid := &proto.CreateRequest{
+		ReceiverKey: pixKey.Key,
+		Amount:      100,
+		ExpiresAt:   timestamppb.New(time.Now().Add(time.Hour)),
+		Txid:        "abcdefghijklmnopqrstuvwxyz",
+		PayerBank:   payerBank[:],
+	}
+	invalid := &proto.CreateRequest{
+		ReceiverKey: pixKey.Key,
+		Amount:      100,
+		ExpiresAt:   timestamppb.New(time.Now().Add(time.Hour)),
+		Txid:        "too-short",
+	}
+	created := mock.MatchedBy(func(cmd charge.Create) bool {
+		return cmd.ID == charge.ID(receiverIDs.BankID, valid.Txid) &&
+			cmd.Receiver == receiverIDs.AccountID &&
+			cmd.ReceiverBank == receiverIDs.BankID &&
+			cmd.PayerBank == payerBank &&
+			cmd.Payer == uuid.Nil
+	})
+
+	testCases := []struct {
+		description string
+		ctx         context.Context
+		request     *proto.CreateRequest
+		findErr     error
+		find        bool
+		cmdErr      error
+		cmd         bool
+		code        codes.Code
+	}{
+		{"valid", ctx, valid, nil, true, nil, true, codes.OK},
+		{"invalid", ctxWithLocale, invalid, nil, false, nil, false, codes.InvalidArgument},
+		{"receiver not found", ctx, valid, &repositories.NotFoundError{}, true,
+			nil, false, codes.NotFound},
+		{"already created", ctx, valid, nil, true,
+			&aggregates.InvariantViolation{charge.ErrAlreadyCreated}, true, codes.Aborted},
+		{"unauthenticated", context.Background(), valid, nil, false,
+			nil, false, codes.Unauthenticated},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			if tc.find && tc.findErr != nil {
+				pixKeyRepo.On("FindByKey", tc.request.ReceiverKey).
+					Return(nil, nil, tc.findErr).Once()
+			} else if tc.find {
+				pixKeyRepo.On("FindByKey", tc.request.ReceiverKey).
+					Return(&pixKey, receiverIDs, nil).Once()
+			}
+			if tc.cmd {
+				commandHandler.On("HandleCommand", mock.Anything, created).
+					Return(tc.cmdErr).Once()
+			}
+
+			reply, err := client.Create(tc.ctx, tc.request)
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.code.String(), status.Code().String())
+			if tc.code == codes.OK {
+				require.NotNil(t, reply)
+				ID := charge.ID(receiverIDs.BankID, valid.Txid)
+				assert.Equal(t, ID[:], reply.Id)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package claim
+
+import (
+	"codepix/bank-api/lib/aggregates"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/looplab/eventhorizon/aggregatestore/events"
+)
+
+const AggregateType = eventhorizon.AggregateType("claim")
+
+type Aggregate struct {
+	*events.AggregateBase
+	Claim *Claim
+}
+
+func New(ID uuid.UUID) *Aggregate {
+	return &Aggregate{
+		AggregateBase: events.NewAggregateBase(AggregateType, ID),
+		Claim:         &Claim{},
+	}
+}
+func init() {
+	eventhorizon.RegisterAggregate(func(ID uuid.UUID) eventhorizon.Aggregate { return New(ID) })
+}
+
+func (ag Aggregate) HandleCommand(ctx context.Context, command eventhorizon.Command) error {
+	if cmd, ok := command.(Command); ok {
+		event, err := cmd.ToEvent(ag)
+		if err != nil {
+			return &aggregates.InvariantViolation{err}
+		}
+		ag.AppendEvent(event.Type(), event, time.Now())
+		return nil
+	}
+	return fmt.Errorf("unknown command type %s/%T", command.CommandType(), command)
+}
+
+func (ag *Aggregate) ApplyEvent(ctx context.Context, event eventhorizon.Event) error {
+	if eventData, ok := event.Data().(Event); ok {
+		eventData.Apply(ag.Claim)
+		return nil
+	}
+	return fmt.Errorf("unknown event type %s/%T", event.EventType(), event.Data())
+}
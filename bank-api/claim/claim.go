@@ -0,0 +1,36 @@
+package claim
+
+import (
+	"codepix/bank-api/pixkey"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Status uint8
+
+const (
+	Opened Status = iota + 1
+	Completed
+	Cancelled
+)
+
+// Claim moves a key from the donor bank, which owns it, to an account of the claimer
+// bank. The donor bank confirms or cancels it before ResolveBy, or it completes on its
+// own once ResolveBy passes. ClaimerAccountDetails are stored with the key once moved.
+type Claim struct {
+	PixKeyID              uuid.UUID
+	Key                   pixkey.Key
+	DonorBank             uuid.UUID
+	ClaimerBank           uuid.UUID
+	ClaimerAccount        uuid.UUID
+	ClaimerAccountDetails pixkey.Account
+	ResolveBy             time.Time
+	Status                Status
+}
+
+// ID returns the claim ID of a key while the donor bank owns it, so there is at most one
+// claim open for each key. Claims are opened again with the same ID once resolved.
+func ID(pixKeyID, donorBank uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(pixKeyID, donorBank[:])
+}
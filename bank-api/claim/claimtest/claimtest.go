@@ -0,0 +1,48 @@
+package claimtest
+
+import (
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/bankapitest"
+	readservice "codepix/bank-api/claim/read/service"
+	writeservice "codepix/bank-api/claim/write/service"
+	"codepix/bank-api/pixkey/pixkeytest"
+	readproto "codepix/bank-api/proto/codepix/claim/read"
+	writeproto "codepix/bank-api/proto/codepix/claim/write"
+	"time"
+)
+
+const ResolveTimeout = time.Hour
+
+func WriteServiceWithMocks() (writeproto.ServiceClient, *MockCommandHandler,
+	*pixkeytest.MockRepo) {
+	validator, err := validator.New()
+	if err != nil {
+		panic(err)
+	}
+	server, client, serve := bankapitest.Server(validator)
+	commandHandler := new(MockCommandHandler)
+	pixKeyRepo := new(pixkeytest.MockRepo)
+
+	err = writeservice.Register(server, validator, commandHandler, pixKeyRepo, ResolveTimeout)
+	if err != nil {
+		panic(err)
+	}
+	serve()
+	return writeproto.NewServiceClient(client), commandHandler, pixKeyRepo
+}
+
+func ReadServiceWithMocks() (readproto.ServiceClient, *MockReadRepo) {
+	validator, err := validator.New()
+	if err != nil {
+		panic(err)
+	}
+	server, client, serve := bankapitest.Server(validator)
+	readRepo := new(MockReadRepo)
+
+	err = readservice.Register(server, readRepo)
+	if err != nil {
+		panic(err)
+	}
+	serve()
+	return readproto.NewServiceClient(client), readRepo
+}
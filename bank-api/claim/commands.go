@@ -0,0 +1,178 @@
+package claim
+
+import (
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/pixkey"
+	"time"
+
+	eh "github.com/looplab/eventhorizon"
+	"github.com/looplab/eventhorizon/uuid"
+)
+
+var (
+	ErrAlreadyOpened = &aggregates.StatusMismatchError{
+		"claim already opened",
+	}
+	ErrCannotOpenIfAlreadyOwned = &aggregates.StatusMismatchError{
+		"cannot open claim if the key already belongs to the claimer bank",
+	}
+	ErrCannotOpenForRandomKey = &aggregates.StatusMismatchError{
+		"cannot open claim for a random key",
+	}
+	ErrCannotConfirmIfNotOpen = &aggregates.StatusMismatchError{
+		"cannot confirm claim if not open",
+	}
+	ErrCannotExpireIfNotOpen = &aggregates.StatusMismatchError{
+		"cannot expire claim if not open",
+	}
+	ErrCannotExpireBeforeDeadline = &aggregates.StatusMismatchError{
+		"cannot expire claim before its deadline",
+	}
+	ErrCannotCancelIfNotOpen = &aggregates.StatusMismatchError{
+		"cannot cancel claim if not open",
+	}
+
+	ErrCannotConfirmIfNotTheDonor = &aggregates.PermissionError{
+		"cannot confirm claim if not the donor",
+	}
+	ErrCannotCancelIfNotDonorOrClaimer = &aggregates.PermissionError{
+		"cannot cancel claim if neither donor nor claimer",
+	}
+)
+
+type Command interface {
+	ToEvent(ag Aggregate) (Event, error)
+}
+
+// Open claims a key for an account of the calling bank. The key fields are copied from
+// the key being claimed. Random keys are generated for an account, so they cannot be
+// claimed.
+type Open struct {
+	ID                    uuid.UUID
+	BankID                uuid.UUID
+	PixKeyID              uuid.UUID
+	KeyType               pixkey.Type
+	Key                   pixkey.Key
+	DonorBank             uuid.UUID
+	ClaimerAccount        uuid.UUID
+	ClaimerAccountDetails pixkey.Account
+	ResolveBy             time.Time
+}
+
+func (c Open) ToEvent(ag Aggregate) (Event, error) {
+	if ag.Claim.Status == Opened {
+		return nil, ErrAlreadyOpened
+	}
+	if c.BankID == c.DonorBank {
+		return nil, ErrCannotOpenIfAlreadyOwned
+	}
+	if c.KeyType == pixkey.RandomKey {
+		return nil, ErrCannotOpenForRandomKey
+	}
+	return ClaimOpened{
+		PixKeyID:              c.PixKeyID,
+		Key:                   c.Key,
+		DonorBank:             c.DonorBank,
+		ClaimerBank:           c.BankID,
+		ClaimerAccount:        c.ClaimerAccount,
+		ClaimerAccountDetails: c.ClaimerAccountDetails,
+		ResolveBy:             c.ResolveBy,
+	}, nil
+}
+
+// Confirm completes a claim, as the donor bank agrees to give the key away.
+type Confirm struct {
+	ID     uuid.UUID
+	BankID uuid.UUID
+}
+
+func (c Confirm) ToEvent(ag Aggregate) (Event, error) {
+	claim := ag.Claim
+	if claim.Status != Opened {
+		return nil, ErrCannotConfirmIfNotOpen
+	}
+	if c.BankID != claim.DonorBank {
+		return nil, ErrCannotConfirmIfNotTheDonor
+	}
+	return completed(*claim, false), nil
+}
+
+// Expire completes a claim the donor bank did not resolve in time.
+type Expire struct {
+	ID uuid.UUID
+}
+
+func (c Expire) ToEvent(ag Aggregate) (Event, error) {
+	claim := ag.Claim
+	if claim.Status != Opened {
+		return nil, ErrCannotExpireIfNotOpen
+	}
+	if time.Now().Before(claim.ResolveBy) {
+		return nil, ErrCannotExpireBeforeDeadline
+	}
+	return completed(*claim, true), nil
+}
+
+func completed(claim Claim, expired bool) ClaimCompleted {
+	return ClaimCompleted{
+		PixKeyID:              claim.PixKeyID,
+		DonorBank:             claim.DonorBank,
+		ClaimerBank:           claim.ClaimerBank,
+		ClaimerAccount:        claim.ClaimerAccount,
+		ClaimerAccountDetails: claim.ClaimerAccountDetails,
+		Expired:               expired,
+	}
+}
+
+// Cancel keeps the key with the donor bank. Either bank may cancel an open claim.
+type Cancel struct {
+	ID     uuid.UUID
+	BankID uuid.UUID
+	Reason string `eh:"optional"`
+}
+
+func (c Cancel) ToEvent(ag Aggregate) (Event, error) {
+	claim := ag.Claim
+	if claim.Status != Opened {
+		return nil, ErrCannotCancelIfNotOpen
+	}
+	if c.BankID != claim.DonorBank && c.BankID != claim.ClaimerBank {
+		return nil, ErrCannotCancelIfNotDonorOrClaimer
+	}
+	return ClaimCancelled{
+		DonorBank:   claim.DonorBank,
+		ClaimerBank: claim.ClaimerBank,
+		CancelledBy: c.BankID,
+		Reason:      c.Reason,
+	}, nil
+}
+
+const (
+	OpenCommand    = eh.CommandType(AggregateType + "_open")
+	ConfirmCommand = eh.CommandType(AggregateType + "_confirm")
+	ExpireCommand  = eh.CommandType(AggregateType + "_expire")
+	CancelCommand  = eh.CommandType(AggregateType + "_cancel")
+)
+
+func init() {
+	eh.RegisterCommand(func() eh.Command { return Open{} })
+	eh.RegisterCommand(func() eh.Command { return Confirm{} })
+	eh.RegisterCommand(func() eh.Command { return Expire{} })
+	eh.RegisterCommand(func() eh.Command { return Cancel{} })
+}
+
+func (c Open) AggregateID() uuid.UUID          { return c.ID }
+func (c Open) AggregateType() eh.AggregateType { return AggregateType }
+func (c Open) CommandType() eh.CommandType     { return OpenCommand }
+
+func (c Confirm) AggregateID() uuid.UUID          { return c.ID }
+func (c Confirm) AggregateType() eh.AggregateType { return AggregateType }
+func (c Confirm) CommandType() eh.CommandType     { return ConfirmCommand }
+
+func (c Expire) AggregateID() uuid.UUID          { return c.ID }
+func (c Expire) AggregateType() eh.AggregateType { return AggregateType }
+func (c Expire) CommandType() eh.CommandType     { return ExpireCommand }
+
+func (c Cancel) AggregateID() uuid.UUID          { return c.ID }
+func (c Cancel) AggregateType() eh.AggregateType { return AggregateType }
+func (c Cancel) CommandType() eh.CommandType     { return CancelCommand }
@@ -0,0 +1,213 @@
+package claim_test
+
+import (
+	"codepix/bank-api/claim"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/pixkey"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var pixKeyID = uuid.New()
+var donorBank = uuid.New()
+var claimerBank = uuid.New()
+var claimerAccount = uuid.New()
+var claimerAccountDetails = pixkey.Account{Branch: "0001", Number: "12345678", Type: pixkey.Checking}
+var resolveBy = time.Now().Add(time.Hour).Truncate(time.Second)
+
+func openClaim(mutate ...func(*claim.Claim)) *claim.Claim {
+	c := &claim.Claim{
+		PixKeyID:              pixKeyID,
+		Key:                   "name@domain.com",
+		DonorBank:             donorBank,
+		ClaimerBank:           claimerBank,
+		ClaimerAccount:        claimerAccount,
+		ClaimerAccountDetails: claimerAccountDetails,
+		ResolveBy:             resolveBy,
+		Status:                claim.Opened,
+	}
+	for _, m := range mutate {
+		m(c)
+	}
+	return c
+}
+func expiredClaim() *claim.Claim {
+	return openClaim(func(c *claim.Claim) { c.ResolveBy = time.Now().Add(-time.Second) })
+}
+func completedClaim() *claim.Claim {
+	return openClaim(func(c *claim.Claim) { c.Status = claim.Completed })
+}
+func cancelledClaim() *claim.Claim {
+	return openClaim(func(c *claim.Claim) { c.Status = claim.Cancelled })
+}
+
+// handle runs the command on a copy of the initial state, and returns the event and the
+// state after it is applied.
+func handle(t *testing.T, initialState *claim.Claim, cmd eventhorizon.Command, err error,
+) (eventhorizon.EventData, *claim.Claim) {
+	ctx := context.Background()
+	ag := claim.New(uuid.New())
+	state := *initialState
+	ag.Claim = &state
+
+	actual := ag.HandleCommand(ctx, cmd)
+	if err != nil {
+		require.IsType(t, &aggregates.InvariantViolation{}, actual)
+		require.ErrorAs(t, actual, &err)
+		assert.Equal(t, initialState, ag.Claim)
+		return nil, ag.Claim
+	}
+	require.NoError(t, actual)
+	lastEvent := ag.UncommittedEvents()[len(ag.UncommittedEvents())-1]
+	require.NoError(t, ag.ApplyEvent(ctx, lastEvent))
+	return lastEvent.Data(), ag.Claim
+}
+
+func TestOpenClaim(t *testing.T) {
+	valid := claim.Open{
+		ID:                    claim.ID(pixKeyID, donorBank),
+		BankID:                claimerBank,
+		PixKeyID:              pixKeyID,
+		KeyType:               pixkey.EmailKey,
+		Key:                   "name@domain.com",
+		DonorBank:             donorBank,
+		ClaimerAccount:        claimerAccount,
+		ClaimerAccountDetails: claimerAccountDetails,
+		ResolveBy:             resolveBy,
+	}
+	alreadyOwned := valid
+	alreadyOwned.BankID = donorBank
+	randomKey := valid
+	randomKey.KeyType, randomKey.Key = pixkey.RandomKey, uuid.NewString()
+
+	testCases := []struct {
+		initialState *claim.Claim
+		cmd          claim.Open
+		err          error
+	}{
+		{&claim.Claim{}, valid, nil},
+		{completedClaim(), valid, nil},
+		{cancelledClaim(), valid, nil},
+		{openClaim(), valid, claim.ErrAlreadyOpened},
+
+		{&claim.Claim{}, alreadyOwned, claim.ErrCannotOpenIfAlreadyOwned},
+		{&claim.Claim{}, randomKey, claim.ErrCannotOpenForRandomKey},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			_, state := handle(t, tc.initialState, tc.cmd, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, openClaim(), state)
+			}
+		})
+	}
+}
+
+func TestConfirmClaim(t *testing.T) {
+	valid := claim.Confirm{
+		ID:     claim.ID(pixKeyID, donorBank),
+		BankID: donorBank,
+	}
+	notTheDonor := valid
+	notTheDonor.BankID = claimerBank
+
+	testCases := []struct {
+		initialState *claim.Claim
+		cmd          claim.Confirm
+		err          error
+	}{
+		{openClaim(), valid, nil},
+		{expiredClaim(), valid, nil},
+		{&claim.Claim{}, valid, claim.ErrCannotConfirmIfNotOpen},
+		{completedClaim(), valid, claim.ErrCannotConfirmIfNotOpen},
+		{cancelledClaim(), valid, claim.ErrCannotConfirmIfNotOpen},
+
+		{openClaim(), notTheDonor, claim.ErrCannotConfirmIfNotTheDonor},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			event, state := handle(t, tc.initialState, tc.cmd, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, claim.Completed, state.Status)
+				assert.Equal(t, claim.ClaimCompleted{
+					PixKeyID:              pixKeyID,
+					DonorBank:             donorBank,
+					ClaimerBank:           claimerBank,
+					ClaimerAccount:        claimerAccount,
+					ClaimerAccountDetails: claimerAccountDetails,
+					Expired:               false,
+				}, event)
+			}
+		})
+	}
+}
+
+func TestExpireClaim(t *testing.T) {
+	valid := claim.Expire{
+		ID: claim.ID(pixKeyID, donorBank),
+	}
+
+	testCases := []struct {
+		initialState *claim.Claim
+		err          error
+	}{
+		{expiredClaim(), nil},
+		{openClaim(), claim.ErrCannotExpireBeforeDeadline},
+		{&claim.Claim{}, claim.ErrCannotExpireIfNotOpen},
+		{completedClaim(), claim.ErrCannotExpireIfNotOpen},
+		{cancelledClaim(), claim.ErrCannotExpireIfNotOpen},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			event, state := handle(t, tc.initialState, valid, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, claim.Completed, state.Status)
+				assert.True(t, event.(claim.ClaimCompleted).Expired)
+			}
+		})
+	}
+}
+
+func TestCancelClaim(t *testing.T) {
+	byDonor := claim.Cancel{
+		ID:     claim.ID(pixKeyID, donorBank),
+		BankID: donorBank,
+		Reason: "fraud suspected",
+	}
+	byClaimer := byDonor
+	byClaimer.BankID = claimerBank
+	byOtherBank := byDonor
+	byOtherBank.BankID = uuid.New()
+
+	testCases := []struct {
+		initialState *claim.Claim
+		cmd          claim.Cancel
+		err          error
+	}{
+		{openClaim(), byDonor, nil},
+		{openClaim(), byClaimer, nil},
+		{&claim.Claim{}, byDonor, claim.ErrCannotCancelIfNotOpen},
+		{completedClaim(), byClaimer, claim.ErrCannotCancelIfNotOpen},
+		{cancelledClaim(), byDonor, claim.ErrCannotCancelIfNotOpen},
+
+		{openClaim(), byOtherBank, claim.ErrCannotCancelIfNotDonorOrClaimer},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			event, state := handle(t, tc.initialState, tc.cmd, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, claim.Cancelled, state.Status)
+				cancelled := event.(claim.ClaimCancelled)
+				assert.Equal(t, tc.cmd.BankID, cancelled.CancelledBy)
+				assert.Equal(t, tc.cmd.Reason, cancelled.Reason)
+			}
+		})
+	}
+}
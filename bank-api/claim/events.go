@@ -0,0 +1,77 @@
+package claim
+
+import (
+	"codepix/bank-api/pixkey"
+	"time"
+
+	"github.com/google/uuid"
+	eh "github.com/looplab/eventhorizon"
+)
+
+type Event interface {
+	Apply(claim *Claim)
+	Type() eh.EventType
+}
+
+type ClaimOpened struct {
+	PixKeyID              uuid.UUID      `json:"pix_key_id" bson:"pix_key_id"`
+	Key                   pixkey.Key     `json:"key" bson:"key"`
+	DonorBank             uuid.UUID      `json:"donor_bank" bson:"donor_bank"`
+	ClaimerBank           uuid.UUID      `json:"claimer_bank" bson:"claimer_bank"`
+	ClaimerAccount        uuid.UUID      `json:"claimer_account" bson:"claimer_account"`
+	ClaimerAccountDetails pixkey.Account `json:"claimer_account_details" bson:"claimer_account_details"`
+	ResolveBy             time.Time      `json:"resolve_by" bson:"resolve_by"`
+}
+
+func (e ClaimOpened) Apply(claim *Claim) {
+	claim.PixKeyID = e.PixKeyID
+	claim.Key = e.Key
+	claim.DonorBank = e.DonorBank
+	claim.ClaimerBank = e.ClaimerBank
+	claim.ClaimerAccount = e.ClaimerAccount
+	claim.ClaimerAccountDetails = e.ClaimerAccountDetails
+	claim.ResolveBy = e.ResolveBy
+	claim.Status = Opened
+}
+
+// ClaimCompleted moves the key to the claimer account. Expired tells whether the donor
+// bank did not confirm the claim in time.
+type ClaimCompleted struct {
+	PixKeyID              uuid.UUID      `json:"pix_key_id" bson:"pix_key_id"`
+	DonorBank             uuid.UUID      `json:"donor_bank" bson:"donor_bank"`
+	ClaimerBank           uuid.UUID      `json:"claimer_bank" bson:"claimer_bank"`
+	ClaimerAccount        uuid.UUID      `json:"claimer_account" bson:"claimer_account"`
+	ClaimerAccountDetails pixkey.Account `json:"claimer_account_details" bson:"claimer_account_details"`
+	Expired               bool           `json:"expired" bson:"expired"`
+}
+
+func (e ClaimCompleted) Apply(claim *Claim) {
+	claim.Status = Completed
+}
+
+type ClaimCancelled struct {
+	DonorBank   uuid.UUID `json:"donor_bank" bson:"donor_bank"`
+	ClaimerBank uuid.UUID `json:"claimer_bank" bson:"claimer_bank"`
+	CancelledBy uuid.UUID `json:"cancelled_by" bson:"cancelled_by"`
+	Reason      string    `json:"reason" bson:"reason"`
+}
+
+func (e ClaimCancelled) Apply(claim *Claim) {
+	claim.Status = Cancelled
+}
+
+const (
+	OpenedEvent    = eh.EventType(AggregateType + "_opened")
+	CompletedEvent = eh.EventType(AggregateType + "_completed")
+	CancelledEvent = eh.EventType(AggregateType + "_cancelled")
+)
+
+func init() {
+	eh.RegisterEventData(OpenedEvent, func() eh.EventData { return &ClaimOpened{} })
+	eh.RegisterEventData(CompletedEvent, func() eh.EventData { return &ClaimCompleted{} })
+	eh.RegisterEventData(CancelledEvent, func() eh.EventData { return &ClaimCancelled{} })
+}
+
+func (ClaimOpened) Type() eh.EventType    { return OpenedEvent }
+func (ClaimCompleted) Type() eh.EventType { return CompletedEvent }
+func (ClaimCancelled) Type() eh.EventType { return CancelledEvent }
@@ -0,0 +1,70 @@
+package projection
+
+import (
+	"codepix/bank-api/adapters/projectionclient"
+	"codepix/bank-api/claim"
+	"codepix/bank-api/claim/read/repository"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon/repo/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	opts "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type Projection struct {
+	Repo *mongodb.Repo
+}
+
+var _ repository.Repository = Projection{}
+
+func (p Projection) Find(ctx context.Context, ID uuid.UUID) (*repository.Claim, error) {
+	entity, err := p.Repo.Find(ctx, ID)
+	found, _ := entity.(*repository.Claim)
+	return found, projectionclient.MapError(err, repository.EntityType)
+}
+
+func (p Projection) List(ctx context.Context, options repository.ListOptions,
+) ([]repository.ListItem, error) {
+	return p.find(ctx, func(ctx context.Context, c *mongo.Collection) (*mongo.Cursor, error) {
+		filter := bson.D{
+			{"created_at", bson.D{{"$gte", options.CreatedAfter.Truncate(time.Millisecond)}}},
+			{"$or", bson.A{
+				bson.D{{"donor_bank", options.BankID.String()}},
+				bson.D{{"claimer_bank", options.BankID.String()}},
+			}},
+		}
+		opts := opts.Find().
+			SetSort(bson.D{{"created_at", -1}}).
+			SetLimit(int64(options.Limit)).
+			SetSkip(int64(options.Skip))
+		return c.Find(ctx, filter, opts)
+	})
+}
+
+func (p Projection) ListExpired(ctx context.Context, before time.Time,
+) ([]repository.ListItem, error) {
+	return p.find(ctx, func(ctx context.Context, c *mongo.Collection) (*mongo.Cursor, error) {
+		filter := bson.D{
+			{"status", claim.Opened},
+			{"resolve_by", bson.D{{"$lte", before.Truncate(time.Millisecond)}}},
+		}
+		opts := opts.Find().SetSort(bson.D{{"resolve_by", 1}})
+		return c.Find(ctx, filter, opts)
+	})
+}
+
+func (p Projection) find(ctx context.Context,
+	query func(ctx context.Context, c *mongo.Collection) (*mongo.Cursor, error),
+) ([]repository.ListItem, error) {
+	entities, err := p.Repo.FindCustom(ctx, query)
+
+	claims := []repository.ListItem{}
+	for _, entity := range entities {
+		found, _ := entity.(*repository.Claim)
+		claims = append(claims, *found)
+	}
+	return claims, projectionclient.MapError(err, repository.EntityType)
+}
@@ -0,0 +1,62 @@
+package projection
+
+import (
+	"codepix/bank-api/claim"
+	"codepix/bank-api/claim/read/repository"
+	"context"
+	"fmt"
+
+	"github.com/looplab/eventhorizon"
+	"github.com/looplab/eventhorizon/eventhandler/projector"
+)
+
+type Projector struct{}
+
+var _ projector.Projector = Projector{}
+
+func (p Projector) ProjectorType() projector.Type {
+	return projector.Type(repository.RepositoryType)
+}
+
+// Project keeps the last time a claim was opened, as claims are opened again with the
+// same ID once resolved.
+func (p Projector) Project(ctx context.Context, event eventhorizon.Event, entity eventhorizon.Entity,
+) (eventhorizon.Entity, error) {
+	c, ok := entity.(*repository.Claim)
+	if !ok {
+		return nil, fmt.Errorf("unknown entity type %T", entity)
+	}
+	if event.Version() != c.Version+1 {
+		return nil, fmt.Errorf("%w: expected version %d, got %d",
+			eventhorizon.ErrIncorrectEntityVersion, c.Version+1, event.Version())
+	}
+	switch e := event.Data().(type) {
+	case *claim.ClaimOpened:
+		c.ID = event.AggregateID()
+		c.PixKeyID = e.PixKeyID
+		c.Key = e.Key
+		c.DonorBank = e.DonorBank
+		c.ClaimerBank = e.ClaimerBank
+		c.ClaimerAccount = e.ClaimerAccount
+
+		c.CreatedAt = event.Timestamp()
+		c.ResolveBy = e.ResolveBy
+		c.Status = claim.Opened
+		c.Expired = false
+		c.CancelReason = ""
+
+	case *claim.ClaimCompleted:
+		c.Status = claim.Completed
+		c.Expired = e.Expired
+
+	case *claim.ClaimCancelled:
+		c.Status = claim.Cancelled
+		c.CancelReason = e.Reason
+
+	default:
+		return nil, fmt.Errorf("unknown event type %s/%T", event.EventType(), event.Data())
+	}
+	c.UpdatedAt = event.Timestamp()
+	c.Version = event.Version()
+	return c, nil
+}
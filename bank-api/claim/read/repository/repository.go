@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"codepix/bank-api/claim"
+	"codepix/bank-api/pixkey"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+var EntityType = "claim"
+var RepositoryType = "claims"
+
+// Repository finds claims. ListExpired finds the open claims whose deadline passed
+// before the given time, of every bank.
+type Repository interface {
+	Find(ctx context.Context, ID uuid.UUID) (*Claim, error)
+	List(ctx context.Context, options ListOptions) ([]ListItem, error)
+	ListExpired(ctx context.Context, before time.Time) ([]ListItem, error)
+}
+
+type Claim struct {
+	ID             uuid.UUID  `bson:"_id"`
+	PixKeyID       uuid.UUID  `bson:"pix_key_id"`
+	Key            pixkey.Key `bson:"key"`
+	DonorBank      uuid.UUID  `bson:"donor_bank"`
+	ClaimerBank    uuid.UUID  `bson:"claimer_bank"`
+	ClaimerAccount uuid.UUID  `bson:"claimer_account"`
+
+	CreatedAt    time.Time    `bson:"created_at"`
+	UpdatedAt    time.Time    `bson:"updated_at"`
+	ResolveBy    time.Time    `bson:"resolve_by"`
+	Status       claim.Status `bson:"status"`
+	Expired      bool         `bson:"expired"`
+	CancelReason string       `bson:"cancel_reason"`
+
+	Version int `bson:"version"`
+}
+
+var _ eventhorizon.Entity = Claim{}
+
+func (c Claim) EntityID() uuid.UUID {
+	return c.ID
+}
+
+var _ eventhorizon.Versionable = Claim{}
+
+func (c Claim) AggregateVersion() int {
+	return c.Version
+}
+
+type ListItem = Claim
+
+// ListOptions lists the claims a bank opened or received.
+type ListOptions struct {
+	CreatedAfter time.Time
+	BankID       uuid.UUID
+	Limit        uint64
+	Skip         uint64
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"codepix/bank-api/claim/read/repository"
+	proto "codepix/bank-api/proto/codepix/claim/read"
+
+	"google.golang.org/grpc"
+)
+
+func Register(server *grpc.Server, repository repository.Repository) error {
+	service := &Service{Repository: repository}
+	proto.RegisterServiceServer(server, service)
+	return nil
+}
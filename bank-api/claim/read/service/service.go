@@ -0,0 +1,99 @@
+package service
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/claim/read/repository"
+	proto "codepix/bank-api/proto/codepix/claim/read"
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Service struct {
+	Repository repository.Repository
+	proto.UnimplementedServiceServer
+}
+
+var _ proto.ServiceServer = Service{}
+
+// Find is only allowed to the donor and claimer banks.
+func (s Service) Find(ctx context.Context, req *proto.FindRequest) (*proto.FindReply, error) {
+	bankID := auth.GetBankID(ctx)
+	ID, _ := uuid.FromBytes(req.Id)
+
+	claim, err := s.Repository.Find(ctx, ID)
+	if err == nil && claim.DonorBank != bankID && claim.ClaimerBank != bankID {
+		return nil, status.Error(codes.PermissionDenied, "")
+	}
+	return findReply(claim), rpc.MapError(ctx, err)
+}
+
+func findReply(claim *repository.Claim) *proto.FindReply {
+	if claim == nil {
+		return nil
+	}
+	return &proto.FindReply{
+		Id:             claim.ID[:],
+		PixKeyId:       claim.PixKeyID[:],
+		Key:            claim.Key,
+		DonorBank:      claim.DonorBank[:],
+		ClaimerBank:    claim.ClaimerBank[:],
+		ClaimerAccount: claim.ClaimerAccount[:],
+
+		CreatedAt:    timestamppb.New(claim.CreatedAt),
+		UpdatedAt:    timestamppb.New(claim.UpdatedAt),
+		ResolveBy:    timestamppb.New(claim.ResolveBy),
+		Status:       proto.Status(claim.Status),
+		Expired:      claim.Expired,
+		CancelReason: claim.CancelReason,
+	}
+}
+
+// List returns the claims the calling bank opened or received.
+func (s Service) List(ctx context.Context, req *proto.ListRequest) (*proto.ListReply, error) {
+	bankID := auth.GetBankID(ctx)
+
+	options := repository.ListOptions{
+		CreatedAfter: req.CreatedAfter.AsTime(),
+		BankID:       bankID,
+		Limit:        req.Limit,
+		Skip:         req.Skip,
+	}
+	claims, err := s.Repository.List(ctx, options)
+	return listReply(claims), rpc.MapError(ctx, err)
+}
+
+func listReply(claims []repository.ListItem) *proto.ListReply {
+	if claims == nil {
+		return nil
+	}
+	items := []*proto.ListItem{}
+	for _, claim := range claims {
+		items = append(items, listItemReply(claim))
+	}
+	return &proto.ListReply{
+		Items: items,
+	}
+}
+
+func listItemReply(claim repository.ListItem) *proto.ListItem {
+	return &proto.ListItem{
+		Id:             claim.ID[:],
+		PixKeyId:       claim.PixKeyID[:],
+		Key:            claim.Key,
+		DonorBank:      claim.DonorBank[:],
+		ClaimerBank:    claim.ClaimerBank[:],
+		ClaimerAccount: claim.ClaimerAccount[:],
+
+		CreatedAt:    timestamppb.New(claim.CreatedAt),
+		UpdatedAt:    timestamppb.New(claim.UpdatedAt),
+		ResolveBy:    timestamppb.New(claim.ResolveBy),
+		Status:       proto.Status(claim.Status),
+		Expired:      claim.Expired,
+		CancelReason: claim.CancelReason,
+	}
+}
@@ -0,0 +1,100 @@
+package service_test
+
+import (
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/claim"
+	"codepix/bank-api/claim/claimtest"
+	"codepix/bank-api/claim/read/repository"
+	"codepix/bank-api/lib/repositories"
+	proto "codepix/bank-api/proto/codepix/claim/read"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func validClaim() *repository.Claim {
+	return &repository.Claim{
+		ID:             uuid.New(),
+		PixKeyID:       uuid.New(),
+		Key:            "name@domain.com",
+		DonorBank:      uuid.New(),
+		ClaimerBank:    uuid.New(),
+		ClaimerAccount: uuid.New(),
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+		ResolveBy:      time.Now().Add(time.Hour),
+		Status:         claim.Opened,
+		Version:        1,
+	}
+}
+
+func TestFind(t *testing.T) {
+	client, readRepo := claimtest.ReadServiceWithMocks()
+
+	opened := validClaim()
+	expired := validClaim()
+	expired.Status = claim.Completed
+	expired.Expired = true
+
+	testCases := []struct {
+		description string
+		bankID      uuid.UUID
+		claim       *repository.Claim
+		err         error
+		code        codes.Code
+	}{
+		{"donor bank", opened.DonorBank, opened, nil, codes.OK},
+		{"claimer bank", opened.ClaimerBank, opened, nil, codes.OK},
+		{"expired", expired.ClaimerBank, expired, nil, codes.OK},
+		{"other bank", uuid.New(), opened, nil, codes.PermissionDenied},
+		{"not found", uuid.New(), nil, &repositories.NotFoundError{}, codes.NotFound},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			ID := uuid.New()
+			if tc.claim != nil {
+				ID = tc.claim.ID
+			}
+			readRepo.On("Find", mock.Anything, ID).Return(tc.claim, tc.err).Once()
+			ctx := bankapitest.AuthenticatedContext(context.Background(), tc.bankID)
+
+			reply, err := client.Find(ctx, &proto.FindRequest{Id: ID[:]})
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.code.String(), status.Code().String())
+			if tc.code == codes.OK {
+				require.NotNil(t, reply)
+				assert.Equal(t, proto.Status(tc.claim.Status), reply.Status)
+				assert.Equal(t, tc.claim.PixKeyID[:], reply.PixKeyId)
+				assert.Equal(t, tc.claim.Expired, reply.Expired)
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	client, readRepo := claimtest.ReadServiceWithMocks()
+
+	bankID := uuid.New()
+	ctx := bankapitest.AuthenticatedContext(context.Background(), bankID)
+	claims := []repository.ListItem{*validClaim(), *validClaim()}
+
+	// only the claims of the calling bank are listed
+	readRepo.On("List", mock.Anything, mock.MatchedBy(func(options repository.ListOptions) bool {
+		return options.BankID == bankID && options.Limit == 10
+	})).Return(claims, nil).Once()
+
+	reply, err := client.List(ctx, &proto.ListRequest{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, reply.Items, 2)
+	assert.Equal(t, claims[0].ID[:], reply.Items[0].Id)
+	assert.Equal(t, claims[1].ID[:], reply.Items[1].Id)
+}
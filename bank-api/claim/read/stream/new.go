@@ -0,0 +1,64 @@
+package stream
+
+import (
+	"codepix/bank-api/adapters/eventbus"
+	"codepix/bank-api/claim"
+	"codepix/bank-api/config"
+	proto "codepix/bank-api/proto/codepix/claim/read"
+	txstream "codepix/bank-api/transaction/read/stream"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+	"google.golang.org/grpc"
+)
+
+func Register(server *grpc.Server, config config.Config, logger logr.Logger,
+	eventBus *eventbus.EventBus) error {
+	cfg := config.Transaction
+
+	busReader, err := eventBus.CreateReader(cfg.BusBlockDuration, cfg.BusMaxPendingAge)
+	if err != nil {
+		return err
+	}
+	stream := &Stream{
+		Consumer: txstream.Stream{
+			Logger:    logger.WithName("claimstream"),
+			BusReader: busReader,
+		},
+	}
+	proto.RegisterStreamServer(server, stream)
+	return nil
+}
+
+func SetupWriters(eventBus *eventbus.EventBus) error {
+	err := eventBus.SetupWriter(claim.OpenedEvent, func(event eventhorizon.Event) []string {
+		opened := event.Data().(*claim.ClaimOpened)
+		return []string{
+			claim.OpenedStream(opened.DonorBank),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = eventBus.SetupWriter(claim.CompletedEvent, func(event eventhorizon.Event) []string {
+		completed := event.Data().(*claim.ClaimCompleted)
+		return []string{
+			claim.CompletedStream(completed.DonorBank),
+			claim.CompletedStream(completed.ClaimerBank),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = eventBus.SetupWriter(claim.CancelledEvent, func(event eventhorizon.Event) []string {
+		cancelled := event.Data().(*claim.ClaimCancelled)
+		return []string{
+			claim.CancelledStream(cancelled.DonorBank),
+			claim.CancelledStream(cancelled.ClaimerBank),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package stream
+
+import (
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/claim"
+	proto "codepix/bank-api/proto/codepix/claim/read"
+	txproto "codepix/bank-api/proto/codepix/transaction/read"
+	txstream "codepix/bank-api/transaction/read/stream"
+
+	"github.com/looplab/eventhorizon"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Stream sends opened claims to the donor bank, and their outcome to both banks,
+// consuming them the same way the transaction stream does.
+type Stream struct {
+	Consumer txstream.Stream
+	proto.UnimplementedStreamServer
+}
+
+var _ proto.StreamServer = Stream{}
+
+type ackReceiver interface {
+	Recv() (*proto.Ack, error)
+}
+
+func receiveAck(stream ackReceiver) func() (*txproto.Ack, error) {
+	return func() (*txproto.Ack, error) {
+		ack, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return &txproto.Ack{Nacks: ack.Nacks}, nil
+	}
+}
+
+func (s Stream) Opened(stream proto.Stream_OpenedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.OpenedClaim{}
+		for _, event := range events {
+			p := openedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.OpenedClaims{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consumer.Consume(stream.Context(),
+		sender,
+		receiveAck(stream),
+		claim.OpenedEvent,
+		claim.OpenedStream(bankID),
+		bankID.String(),
+	)
+}
+func openedMapper(event eventhorizon.Event) *proto.OpenedClaim {
+	ID := event.AggregateID()
+	opened := event.Data().(*claim.ClaimOpened)
+	return &proto.OpenedClaim{
+		Id:          ID[:],
+		Timestamp:   timestamppb.New(event.Timestamp()),
+		PixKeyId:    opened.PixKeyID[:],
+		Key:         opened.Key,
+		ClaimerBank: opened.ClaimerBank[:],
+		ResolveBy:   timestamppb.New(opened.ResolveBy),
+	}
+}
+
+func (s Stream) Completed(stream proto.Stream_CompletedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.CompletedClaim{}
+		for _, event := range events {
+			p := completedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.CompletedClaims{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consumer.Consume(stream.Context(),
+		sender,
+		receiveAck(stream),
+		claim.CompletedEvent,
+		claim.CompletedStream(bankID),
+		bankID.String(),
+	)
+}
+func completedMapper(event eventhorizon.Event) *proto.CompletedClaim {
+	ID := event.AggregateID()
+	completed := event.Data().(*claim.ClaimCompleted)
+	return &proto.CompletedClaim{
+		Id:        ID[:],
+		Timestamp: timestamppb.New(event.Timestamp()),
+		PixKeyId:  completed.PixKeyID[:],
+		Expired:   completed.Expired,
+	}
+}
+
+func (s Stream) Cancelled(stream proto.Stream_CancelledServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.CancelledClaim{}
+		for _, event := range events {
+			p := cancelledMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.CancelledClaims{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consumer.Consume(stream.Context(),
+		sender,
+		receiveAck(stream),
+		claim.CancelledEvent,
+		claim.CancelledStream(bankID),
+		bankID.String(),
+	)
+}
+func cancelledMapper(event eventhorizon.Event) *proto.CancelledClaim {
+	ID := event.AggregateID()
+	cancelled := event.Data().(*claim.ClaimCancelled)
+	return &proto.CancelledClaim{
+		Id:          ID[:],
+		Timestamp:   timestamppb.New(event.Timestamp()),
+		CancelledBy: cancelled.CancelledBy[:],
+		Reason:      cancelled.Reason,
+	}
+}
@@ -0,0 +1,11 @@
+package claim
+
+import "github.com/google/uuid"
+
+const openedStream = string(OpenedEvent) + "_"
+const completedStream = string(CompletedEvent) + "_"
+const cancelledStream = string(CancelledEvent) + "_"
+
+func OpenedStream(bankID uuid.UUID) string    { return openedStream + bankID.String() }
+func CompletedStream(bankID uuid.UUID) string { return completedStream + bankID.String() }
+func CancelledStream(bankID uuid.UUID) string { return cancelledStream + bankID.String() }
@@ -0,0 +1,20 @@
+package timeout
+
+import (
+	"codepix/bank-api/claim/read/repository"
+	"codepix/bank-api/config"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+)
+
+func New(logger logr.Logger, config config.Config, commandHandler eventhorizon.CommandHandler,
+	repository repository.Repository,
+) *Expirer {
+	return &Expirer{
+		Logger:         logger.WithName("claimtimeout"),
+		CommandHandler: commandHandler,
+		Repository:     repository,
+		Interval:       config.Claim.TimeoutInterval,
+	}
+}
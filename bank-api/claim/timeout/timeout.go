@@ -0,0 +1,61 @@
+package timeout
+
+import (
+	"codepix/bank-api/claim"
+	"codepix/bank-api/claim/read/repository"
+	"codepix/bank-api/lib/aggregates"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+)
+
+// Expirer completes the claims the donor bank did not resolve in time. Claims are looked
+// up in the projection, and those already resolved are told apart by the aggregate.
+type Expirer struct {
+	Logger         logr.Logger
+	CommandHandler eventhorizon.CommandHandler
+	Repository     repository.Repository
+	Interval       time.Duration
+}
+
+// Run expires the claims whose deadlines passed every interval until the context is done.
+func (e Expirer) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			e.Expire(ctx, now)
+		}
+	}
+}
+
+func (e Expirer) Expire(ctx context.Context, now time.Time) {
+	claims, err := e.Repository.ListExpired(ctx, now)
+	if err != nil {
+		e.Logger.Error(err, "fail: list expired claims")
+		return
+	}
+	for _, c := range claims {
+		kvs := []any{
+			"claim", c.ID,
+			"pixkey", c.PixKeyID,
+		}
+		err := e.CommandHandler.HandleCommand(ctx, claim.Expire{ID: c.ID})
+		// an invariant violation means the claim was resolved before it was projected
+		invariantViolation := &aggregates.InvariantViolation{}
+		if errors.As(err, &invariantViolation) {
+			continue
+		}
+		if err != nil {
+			e.Logger.Error(err, "fail: handle command", kvs...)
+			continue
+		}
+		e.Logger.Info("claim expired", kvs...)
+	}
+}
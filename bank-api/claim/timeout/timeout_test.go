@@ -0,0 +1,55 @@
+package timeout_test
+
+import (
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/claim"
+	"codepix/bank-api/claim/claimtest"
+	"codepix/bank-api/claim/read/repository"
+	"codepix/bank-api/claim/timeout"
+	"codepix/bank-api/lib/aggregates"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExpire(t *testing.T) {
+	repo := &claimtest.MockReadRepo{}
+	commandHandler := &claimtest.MockCommandHandler{}
+	expirer := timeout.Expirer{
+		Logger:         bankapitest.Logger,
+		CommandHandler: commandHandler,
+		Repository:     repo,
+	}
+	now := time.Now()
+
+	newClaim := func() repository.ListItem {
+		return repository.ListItem{
+			ID:        uuid.New(),
+			PixKeyID:  uuid.New(),
+			ResolveBy: now.Add(-time.Second),
+			Status:    claim.Opened,
+		}
+	}
+	expired := newClaim()
+	alreadyConfirmed := newClaim()
+	unavailable := newClaim()
+
+	repo.On("ListExpired", mock.Anything, now).
+		Return([]repository.ListItem{expired, alreadyConfirmed, unavailable}, nil).Once()
+
+	commandHandler.On("HandleCommand", mock.Anything, claim.Expire{ID: expired.ID}).
+		Return(nil).Once()
+	commandHandler.On("HandleCommand", mock.Anything, claim.Expire{ID: alreadyConfirmed.ID}).
+		Return(&aggregates.InvariantViolation{claim.ErrCannotExpireIfNotOpen}).Once()
+	commandHandler.On("HandleCommand", mock.Anything, claim.Expire{ID: unavailable.ID}).
+		Return(errors.New("some error")).Once()
+
+	expirer.Expire(context.Background(), now)
+
+	repo.AssertExpectations(t)
+	commandHandler.AssertExpectations(t)
+}
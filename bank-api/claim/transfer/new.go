@@ -0,0 +1,41 @@
+package transfer
+
+import (
+	"codepix/bank-api/adapters/eventhandler"
+	"codepix/bank-api/claim"
+	"codepix/bank-api/config"
+	"codepix/bank-api/lib/validation"
+	"codepix/bank-api/pixkey/policy"
+	pixkeyrepository "codepix/bank-api/pixkey/repository"
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+)
+
+func Setup(logger logr.Logger, config config.Config, val *validation.Validator,
+	outbox eventhorizon.Outbox, pixKeyRepository pixkeyrepository.Repository,
+) error {
+	policy, err := policy.New(config, val)
+	if err != nil {
+		return err
+	}
+	logger = logger.WithName("claimtransfer")
+	transferer := Transferer{
+		Logger:           logger,
+		PixKeyRepository: pixKeyRepository,
+		Policy:           policy,
+	}
+	err = outbox.AddHandler(context.Background(),
+		eventhorizon.MatchEvents{claim.CompletedEvent},
+		eventhandler.Named(
+			eventhandler.Logger(logger, transferer),
+			transferer.HandlerType(),
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("setup claim transfer: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package transfer
+
+import (
+	"codepix/bank-api/claim"
+	"codepix/bank-api/lib/repositories"
+	"codepix/bank-api/lib/validation"
+	"codepix/bank-api/pixkey/policy"
+	pixkeyrepository "codepix/bank-api/pixkey/repository"
+	"context"
+	"errors"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+)
+
+// Transferer moves the key to the claimer account once a claim completes. The key is
+// moved in a single update, so it never belongs to both banks. Keys are only moved to
+// accounts which may hold them by Policy, checked as keys are added. Otherwise, the key
+// stays with the donor bank, and the transfer is logged and dropped.
+type Transferer struct {
+	Logger           logr.Logger
+	PixKeyRepository pixkeyrepository.Repository
+	Policy           *policy.Policy
+}
+
+var _ eventhorizon.EventHandler = Transferer{}
+
+func (t Transferer) HandlerType() eventhorizon.EventHandlerType {
+	return eventhorizon.EventHandlerType(claim.AggregateType + "_transfer")
+}
+
+func (t Transferer) HandleEvent(ctx context.Context, event eventhorizon.Event) error {
+	completed, ok := event.Data().(*claim.ClaimCompleted)
+	if !ok {
+		return nil
+	}
+	// the key is not found when the donor bank deleted it before the claim completed, so
+	// there is nothing left to move
+	notFound := &repositories.NotFoundError{}
+	pixKey, _, err := t.PixKeyRepository.Find(completed.PixKeyID)
+	if errors.As(err, &notFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	check := t.Policy.Check(*pixKey, completed.ClaimerAccount, completed.ClaimerBank)
+	err = t.PixKeyRepository.Transfer(completed.PixKeyID, completed.DonorBank,
+		completed.ClaimerAccount, completed.ClaimerBank, completed.ClaimerAccountDetails,
+		check)
+	if errors.As(err, &notFound) {
+		return nil
+	}
+	if _, ok := err.(*validation.Error); ok {
+		t.Logger.Error(err, "fail: transfer key, dropped",
+			"claim", event.AggregateID(), "key", completed.PixKeyID)
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,97 @@
+package transfer_test
+
+import (
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/claim"
+	"codepix/bank-api/claim/transfer"
+	"codepix/bank-api/lib/repositories"
+	"codepix/bank-api/lib/validation"
+	"codepix/bank-api/pixkey"
+	"codepix/bank-api/pixkey/pixkeytest"
+	"codepix/bank-api/pixkey/policy"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferer(t *testing.T) {
+	ID := uuid.New()
+	pixKeyID := uuid.New()
+	donorBank := uuid.New()
+	claimerBank := uuid.New()
+	claimerAccount := uuid.New()
+	claimerAccountDetails := pixkey.Account{Branch: "0001", Number: "12345678", Type: pixkey.Checking}
+
+	event := func(eventType eventhorizon.EventType, data eventhorizon.EventData,
+	) eventhorizon.Event {
+		return eventhorizon.NewEvent(eventType, data, time.Now(),
+			eventhorizon.ForAggregate(claim.AggregateType, ID, 2))
+	}
+	completed := event(claim.CompletedEvent, &claim.ClaimCompleted{
+		PixKeyID: pixKeyID, DonorBank: donorBank, ClaimerBank: claimerBank,
+		ClaimerAccount: claimerAccount, ClaimerAccountDetails: claimerAccountDetails,
+	})
+	cancelled := event(claim.CancelledEvent, &claim.ClaimCancelled{
+		DonorBank: donorBank, ClaimerBank: claimerBank, CancelledBy: donorBank,
+	})
+
+	pixKey := &pixkey.PixKey{Type: pixkey.EmailKey, Key: "name@domain.com"}
+	val, err := validator.New()
+	require.NoError(t, err)
+	policy, err := policy.New(bankapitest.Config, val)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		description string
+		event       eventhorizon.Event
+		find        bool
+		findErr     error
+		transfer    bool
+		transferErr error
+		err         bool
+	}{
+		{"completed moves the key", completed, true, nil, true, nil, false},
+		{"cancelled", cancelled, false, nil, false, nil, false},
+		{"key deleted before", completed, true, &repositories.NotFoundError{},
+			false, nil, false},
+		{"key deleted in the meantime", completed, true, nil,
+			true, &repositories.NotFoundError{}, false},
+		{"claimer account cannot hold the key", completed, true, nil,
+			true, &validation.Error{}, false},
+		{"find error is retried", completed, true, errors.New("some error"),
+			false, nil, true},
+		{"repository error is retried", completed, true, nil,
+			true, errors.New("some error"), true},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			pixKeyRepo := new(pixkeytest.MockRepo)
+			if tc.find && tc.findErr != nil {
+				pixKeyRepo.On("Find", pixKeyID).Return(nil, nil, tc.findErr).Once()
+			} else if tc.find {
+				pixKeyRepo.On("Find", pixKeyID).Return(pixKey, nil, nil).Once()
+			}
+			if tc.transfer {
+				pixKeyRepo.On("Transfer", pixKeyID, donorBank, claimerAccount, claimerBank,
+					claimerAccountDetails).Return(tc.transferErr).Once()
+			}
+			transferer := transfer.Transferer{
+				Logger:           bankapitest.Logger,
+				PixKeyRepository: pixKeyRepo,
+				Policy:           policy,
+			}
+
+			err := transferer.HandleEvent(context.Background(), tc.event)
+			assert.Equal(t, tc.err, err != nil)
+			pixKeyRepo.AssertExpectations(t)
+		})
+	}
+}
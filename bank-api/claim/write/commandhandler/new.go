@@ -0,0 +1,35 @@
+package commandhandler
+
+import (
+	"codepix/bank-api/adapters/eventstore"
+	"codepix/bank-api/claim"
+
+	"github.com/looplab/eventhorizon"
+	"github.com/looplab/eventhorizon/commandhandler/aggregate"
+	"github.com/looplab/eventhorizon/commandhandler/bus"
+)
+
+// Setup handles claim commands. Claims only have a few events each time they are opened,
+// so they are not snapshotted.
+func Setup(eventStore *eventstore.EventStore, commandBus *bus.CommandHandler) error {
+	aggregateStore, err := eventStore.NewAggregateStore(0)
+	if err != nil {
+		return err
+	}
+	commandHandler, err := aggregate.NewCommandHandler(claim.AggregateType, aggregateStore)
+	if err != nil {
+		return err
+	}
+	commands := []eventhorizon.CommandType{
+		claim.OpenCommand,
+		claim.ConfirmCommand,
+		claim.ExpireCommand,
+		claim.CancelCommand,
+	}
+	for _, cmdType := range commands {
+		if err := commandBus.SetHandler(commandHandler, cmdType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
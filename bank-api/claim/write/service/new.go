@@ -0,0 +1,35 @@
+package service
+
+import (
+	"bytes"
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/claim/write"
+	"codepix/bank-api/lib/validation"
+	pixkeyrepository "codepix/bank-api/pixkey/repository"
+	proto "codepix/bank-api/proto/codepix/claim/write"
+	"time"
+
+	"github.com/looplab/eventhorizon"
+	"google.golang.org/grpc"
+)
+
+func Register(server *grpc.Server, val *validation.Validator,
+	commandHandler eventhorizon.CommandHandler, pixKeyRepository pixkeyrepository.Repository,
+	resolveTimeout time.Duration,
+) error {
+	err := validator.LoadTranslationFile(val, bytes.NewReader(write.Translations),
+		proto.OpenRequest{},
+		proto.ConfirmRequest{},
+		proto.CancelRequest{},
+	)
+	if err != nil {
+		return err
+	}
+	service := &Service{
+		CommandHandler:   commandHandler,
+		PixKeyRepository: pixKeyRepository,
+		ResolveTimeout:   resolveTimeout,
+	}
+	proto.RegisterServiceServer(server, service)
+	return nil
+}
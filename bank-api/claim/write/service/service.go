@@ -0,0 +1,85 @@
+package service
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/claim"
+	"codepix/bank-api/pixkey"
+	pixkeyrepository "codepix/bank-api/pixkey/repository"
+	proto "codepix/bank-api/proto/codepix/claim/write"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+type Service struct {
+	CommandHandler   eventhorizon.CommandHandler
+	PixKeyRepository pixkeyrepository.Repository
+	ResolveTimeout   time.Duration
+	proto.UnimplementedServiceServer
+}
+
+var _ proto.ServiceServer = Service{}
+
+// Open claims an active key for an account of the calling bank. The donor bank has
+// ResolveTimeout to confirm or cancel the claim.
+func (s Service) Open(ctx context.Context, req *proto.OpenRequest) (*proto.Opened, error) {
+	bankID := auth.GetBankID(ctx)
+	accountID, _ := uuid.FromBytes(req.AccountId)
+
+	pixKey, IDs, err := s.PixKeyRepository.FindByKey(req.Key)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	cmd := claim.Open{
+		ID:             claim.ID(IDs.PixKeyID, IDs.BankID),
+		BankID:         bankID,
+		PixKeyID:       IDs.PixKeyID,
+		KeyType:        pixKey.Type,
+		Key:            pixKey.Key,
+		DonorBank:      IDs.BankID,
+		ClaimerAccount: accountID,
+		ClaimerAccountDetails: pixkey.Account{
+			Branch: req.Branch,
+			Number: req.AccountNumber,
+			Type:   pixkey.AccountType(req.AccountType),
+		},
+		ResolveBy: time.Now().Add(s.ResolveTimeout),
+	}
+	err = s.CommandHandler.HandleCommand(ctx, cmd)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	return &proto.Opened{Id: cmd.ID[:]}, nil
+}
+
+func (s Service) Confirm(ctx context.Context, req *proto.ConfirmRequest,
+) (*proto.Updated, error) {
+	ID, _ := uuid.FromBytes(req.Id)
+	cmd := claim.Confirm{
+		ID:     ID,
+		BankID: auth.GetBankID(ctx),
+	}
+	return s.update(ctx, cmd)
+}
+
+func (s Service) Cancel(ctx context.Context, req *proto.CancelRequest) (*proto.Updated, error) {
+	ID, _ := uuid.FromBytes(req.Id)
+	cmd := claim.Cancel{
+		ID:     ID,
+		BankID: auth.GetBankID(ctx),
+		Reason: req.Reason,
+	}
+	return s.update(ctx, cmd)
+}
+
+func (s Service) update(ctx context.Context, cmd eventhorizon.Command) (*proto.Updated, error) {
+	err := s.CommandHandler.HandleCommand(ctx, cmd)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	ID := cmd.AggregateID()
+	return &proto.Updated{Id: ID[:]}, nil
+}
@@ -0,0 +1,157 @@
+package service_test
+
+import (
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/claim"
+	"codepix/bank-api/claim/claimtest"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/lib/repositories"
+	"codepix/bank-api/pixkey"
+	pixkeyrepository "codepix/bank-api/pixkey/repository"
+	proto "codepix/bank-api/proto/codepix/claim/write"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestOpen(t *testing.T) {
+	client, commandHandler, pixKeyRepo := claimtest.WriteServiceWithMocks()
+
+	pixKey := &pixkey.PixKey{Type: pixkey.EmailKey, Key: "name@domain.com", Status: pixkey.Active}
+	IDs := &pixkeyrepository.IDs{PixKeyID: uuid.New(), AccountID: uuid.New(), BankID: uuid.New()}
+	bankID, accountID := uuid.New(), uuid.New()
+	ctx := bankapitest.AuthenticatedContext(context.Background(), bankID)
+	ctxWithLocale := metadata.AppendToOutgoingContext(ctx, "locale", validator.EN_US)
+
+	account := pixkey.Account{Branch: "0001", Number: "12345678", Type: pixkey.Savings}
+	valid := &proto.OpenRequest{
+		Key:           pixKey.Key,
+		AccountId:     accountID[:],
+		Branch:        account.Branch,
+		AccountNumber: account.Number,
+		AccountType:   proto.AccountType(account.Type),
+	}
+	invalid := &proto.OpenRequest{Key: pixKey.Key}
+	opened := mock.MatchedBy(func(cmd claim.Open) bool {
+		resolveBy := time.Now().Add(claimtest.ResolveTimeout)
+		return cmd.ID == claim.ID(IDs.PixKeyID, IDs.BankID) &&
+			cmd.BankID == bankID &&
+			cmd.PixKeyID == IDs.PixKeyID &&
+			cmd.KeyType == pixKey.Type &&
+			cmd.Key == pixKey.Key &&
+			cmd.DonorBank == IDs.BankID &&
+			cmd.ClaimerAccount == accountID &&
+			cmd.ClaimerAccountDetails == account &&
+			resolveBy.Sub(cmd.ResolveBy) < time.Minute
+	})
+
+	testCases := []struct {
+		description string
+		ctx         context.Context
+		request     *proto.OpenRequest
+		findErr     error
+		find        bool
+		cmdErr      error
+		cmd         bool
+		code        codes.Code
+	}{
+		{"valid", ctx, valid, nil, true, nil, true, codes.OK},
+		{"invalid", ctxWithLocale, invalid, nil, false, nil, false, codes.InvalidArgument},
+		{"key not found", ctx, valid, &repositories.NotFoundError{}, true,
+			nil, false, codes.NotFound},
+		{"already owned", ctx, valid, nil, true,
+			&aggregates.InvariantViolation{claim.ErrCannotOpenIfAlreadyOwned}, true,
+			codes.Aborted},
+		{"already opened", ctx, valid, nil, true,
+			&aggregates.InvariantViolation{claim.ErrAlreadyOpened}, true, codes.Aborted},
+		{"unauthenticated", context.Background(), valid, nil, false,
+			nil, false, codes.Unauthenticated},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			if tc.find && tc.findErr != nil {
+				pixKeyRepo.On("FindByKey", pixKey.Key).Return(nil, nil, tc.findErr).Once()
+			} else if tc.find {
+				pixKeyRepo.On("FindByKey", pixKey.Key).Return(pixKey, IDs, nil).Once()
+			}
+			if tc.cmd {
+				commandHandler.On("HandleCommand", mock.Anything, opened).
+					Return(tc.cmdErr).Once()
+			}
+
+			reply, err := client.Open(tc.ctx, tc.request)
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.code.String(), status.Code().String())
+			if tc.code == codes.OK {
+				require.NotNil(t, reply)
+				ID := claim.ID(IDs.PixKeyID, IDs.BankID)
+				assert.Equal(t, ID[:], reply.Id)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	client, commandHandler, _ := claimtest.WriteServiceWithMocks()
+
+	ID := uuid.New()
+	bankID := uuid.New()
+	ctx := bankapitest.AuthenticatedContext(context.Background(), bankID)
+
+	testCases := []struct {
+		description string
+		call        func() (*proto.Updated, error)
+		cmd         any
+		cmdErr      error
+		code        codes.Code
+	}{
+		{"confirm", func() (*proto.Updated, error) {
+			return client.Confirm(ctx, &proto.ConfirmRequest{Id: ID[:]})
+		}, claim.Confirm{ID, bankID}, nil, codes.OK},
+		{"cancel", func() (*proto.Updated, error) {
+			return client.Cancel(ctx, &proto.CancelRequest{Id: ID[:], Reason: "fraud"})
+		}, claim.Cancel{ID, bankID, "fraud"}, nil, codes.OK},
+		{"not the donor", func() (*proto.Updated, error) {
+			return client.Confirm(ctx, &proto.ConfirmRequest{Id: ID[:]})
+		}, claim.Confirm{ID, bankID},
+			&aggregates.InvariantViolation{claim.ErrCannotConfirmIfNotTheDonor},
+			codes.PermissionDenied},
+		{"not open", func() (*proto.Updated, error) {
+			return client.Cancel(ctx, &proto.CancelRequest{Id: ID[:]})
+		}, claim.Cancel{ID, bankID, ""},
+			&aggregates.InvariantViolation{claim.ErrCannotCancelIfNotOpen},
+			codes.Aborted},
+		{"invalid", func() (*proto.Updated, error) {
+			return client.Confirm(ctx, &proto.ConfirmRequest{Id: []byte("short")})
+		}, nil, nil, codes.InvalidArgument},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			if tc.cmd != nil {
+				commandHandler.On("HandleCommand", mock.Anything, tc.cmd).
+					Return(tc.cmdErr).Once()
+			}
+
+			reply, err := tc.call()
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.code.String(), status.Code().String())
+			if tc.code == codes.OK {
+				require.NotNil(t, reply)
+				assert.Equal(t, ID[:], reply.Id)
+			}
+		})
+	}
+	commandHandler.AssertExpectations(t)
+}
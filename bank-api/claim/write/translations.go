@@ -0,0 +1,6 @@
+package write
+
+import _ "embed"
+
+//go:embed translations.json
+var Translations []byte
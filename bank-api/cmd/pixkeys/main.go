@@ -0,0 +1,66 @@
+// Command pixkeys migrates the stored Pix keys, while the bank API keeps running.
+//
+//	pixkeys normalize   normalizes the keys stored before keys were normalized
+//
+// normalize only has to run once, after upgrading from a version which did not normalize
+// keys. Keys which conflict once normalized are logged and left as they are, and the
+// command fails, so it can be run again once their banks resolved them.
+package main
+
+import (
+	"codepix/bank-api/adapters/databaseclient"
+	"codepix/bank-api/config"
+	pixkeydatabase "codepix/bank-api/pixkey/repository/database"
+	"fmt"
+	"os"
+
+	"github.com/go-logr/zapr"
+	"go.uber.org/zap"
+)
+
+func main() {
+	if len(os.Args) != 2 || os.Args[1] != "normalize" {
+		usage()
+	}
+	logger, err := zap.NewProduction()
+	if err != nil {
+		panic(err)
+	}
+	config, err := config.New()
+	if err != nil {
+		logger.Fatal("failed to create config", zap.Error(err))
+	}
+	err = normalize(logger, *config)
+	if err != nil {
+		logger.Fatal("failed to normalize pix keys", zap.Error(err))
+	}
+}
+
+func normalize(loggerImpl *zap.Logger, config config.Config) error {
+	logger := zapr.NewLogger(loggerImpl)
+
+	database, err := databaseclient.Open(config, logger)
+	if err != nil {
+		return err
+	}
+	defer database.Close()
+
+	repository := pixkeydatabase.Database{Database: database}
+	conflicts, err := repository.NormalizeKeys()
+	if err != nil {
+		return err
+	}
+	for _, conflict := range conflicts {
+		logger.Error(nil, "conflicting pix keys",
+			"key", conflict.Key, "pixkeys", conflict.PixKeyIDs)
+	}
+	if len(conflicts) != 0 {
+		return fmt.Errorf("%d conflicting pix keys left as they are", len(conflicts))
+	}
+	return nil
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: pixkeys normalize")
+	os.Exit(2)
+}
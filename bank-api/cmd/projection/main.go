@@ -0,0 +1,81 @@
+// Command projection fixes the transactions read model from the event store, while the
+// bank API keeps running.
+//
+//	projection rebuild     rebuilds every row and swaps them in
+//	projection replay ID   projects the events of a single transaction again
+package main
+
+import (
+	"codepix/bank-api/adapters/eventstore"
+	"codepix/bank-api/adapters/projectionclient"
+	"codepix/bank-api/config"
+	txprojection "codepix/bank-api/transaction/read/repository/projection"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/go-logr/zapr"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var replayID uuid.UUID
+	switch {
+	case len(os.Args) == 2 && os.Args[1] == "rebuild":
+	case len(os.Args) == 3 && os.Args[1] == "replay":
+		ID, err := uuid.Parse(os.Args[2])
+		if err != nil {
+			usage()
+		}
+		replayID = ID
+	default:
+		usage()
+	}
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	logger, err := zap.NewProduction()
+	if err != nil {
+		panic(err)
+	}
+	config, err := config.New()
+	if err != nil {
+		logger.Fatal("failed to create config", zap.Error(err))
+	}
+	err = run(ctx, logger, *config, replayID)
+	if err != nil {
+		logger.Fatal("failed to fix transactions projection", zap.Error(err))
+	}
+}
+
+// run rebuilds the whole projection, or only replays the given transaction if any.
+func run(ctx context.Context, loggerImpl *zap.Logger, config config.Config, replayID uuid.UUID,
+) error {
+	logger := zapr.NewLogger(loggerImpl)
+
+	eventStore, err := eventstore.Open(ctx, config, logger)
+	if err != nil {
+		return err
+	}
+	defer eventStore.Close()
+	projection, err := projectionclient.Open(ctx, config, logger, eventStore.Outbox)
+	if err != nil {
+		return err
+	}
+	defer projection.Close()
+
+	rebuilder := txprojection.NewRebuilder(projection, eventStore)
+
+	if replayID != uuid.Nil {
+		return rebuilder.Replay(ctx, replayID)
+	}
+	return rebuilder.Rebuild(ctx)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: projection rebuild | projection replay <transaction ID>")
+	os.Exit(2)
+}
@@ -1,18 +1,22 @@
 package config
 
 import (
+	"crypto/ed25519"
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/caarlos0/env"
+	"github.com/google/uuid"
 	"github.com/subosito/gotenv"
 )
 
@@ -24,6 +28,14 @@ type Config struct {
 	RPC             rpc
 	BankAuth        bankAuth
 	Transaction     transaction
+	BRCode          brCode
+	Settlement      settlement
+	Reserve         reserve
+	Limits          limits
+	Risk            risk
+	Claim           claim
+	PixKey          pixKey
+	RateLimit       rateLimit
 }
 
 func New() (*Config, error) {
@@ -35,6 +47,14 @@ func New() (*Config, error) {
 		RPC:             rpc{},
 		BankAuth:        bankAuth{},
 		Transaction:     transaction{},
+		BRCode:          brCode{},
+		Settlement:      settlement{},
+		Reserve:         reserve{},
+		Limits:          limits{},
+		Risk:            risk{},
+		Claim:           claim{},
+		PixKey:          pixKey{},
+		RateLimit:       rateLimit{},
 	}
 	err := loadEnvFileIfAvailable()
 	if err != nil {
@@ -69,6 +89,42 @@ func New() (*Config, error) {
 		return nil, fmt.Errorf("failed to build bank auth config: %w", err)
 	}
 	env.Parse(&c.Transaction)
+	err = c.Transaction.build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transaction config: %w", err)
+	}
+	env.Parse(&c.BRCode)
+	env.Parse(&c.Settlement)
+	err = c.Settlement.build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build settlement config: %w", err)
+	}
+	env.Parse(&c.Reserve)
+	err = c.Reserve.build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build reserve config: %w", err)
+	}
+	env.Parse(&c.Limits)
+	env.Parse(&c.Risk)
+	err = c.Risk.build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build risk config: %w", err)
+	}
+	env.Parse(&c.Claim)
+	err = c.Claim.build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build claim config: %w", err)
+	}
+	env.Parse(&c.PixKey)
+	err = c.PixKey.build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build pix key config: %w", err)
+	}
+	env.Parse(&c.RateLimit)
+	err = c.RateLimit.build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build rate limit config: %w", err)
+	}
 	return c, nil
 }
 
@@ -161,6 +217,268 @@ func (c *bankAuth) build() error {
 type transaction struct {
 	BusBlockDuration time.Duration `env:"TX_BUS_BLOCK_DURATION"`
 	BusMaxPendingAge time.Duration `env:"TX_BUS_MAX_PENDING_AGE"`
+
+	// Idempotency keys are kept for IdempotencyRetention, and expired ones are deleted
+	// every IdempotencyPurgeInterval.
+	IdempotencyRetention     time.Duration `env:"TX_IDEMPOTENCY_RETENTION"`
+	IdempotencyPurgeInterval time.Duration `env:"TX_IDEMPOTENCY_PURGE_INTERVAL"`
+
+	ConfirmTimeout  time.Duration `env:"TX_CONFIRM_TIMEOUT"`
+	CompleteTimeout time.Duration `env:"TX_COMPLETE_TIMEOUT"`
+	TimeoutInterval time.Duration `env:"TX_TIMEOUT_INTERVAL"`
+
+	SnapshotInterval int `env:"TX_SNAPSHOT_INTERVAL"`
+}
+
+func (c *transaction) build() error {
+	if c.IdempotencyRetention <= 0 {
+		return errors.New("idempotency retention must be positive")
+	}
+	if c.IdempotencyPurgeInterval <= 0 {
+		return errors.New("idempotency purge interval must be positive")
+	}
+	if c.ConfirmTimeout <= 0 || c.CompleteTimeout <= 0 {
+		return errors.New("confirm and complete timeouts must be positive")
+	}
+	if c.TimeoutInterval <= 0 {
+		return errors.New("timeout interval must be positive")
+	}
+	return nil
+}
+
+type brCode struct {
+	// ChargeLocation is where charges are located, without the scheme. The charge ID is
+	// appended to it in dynamic payloads.
+	ChargeLocation string `env:"BRCODE_CHARGE_LOCATION"`
+}
+
+type settlement struct {
+	// Completed transactions are only recorded and settled when Enabled, which requires
+	// the SigningKey the reports are signed with.
+	Enabled bool `env:"SETTLEMENT_ENABLED"`
+	// Window is how long a settlement window lasts. Windows start at multiples of Window
+	// since the Unix epoch, shifted by WindowOffset.
+	Window       time.Duration `env:"SETTLEMENT_WINDOW"`
+	WindowOffset time.Duration `env:"SETTLEMENT_WINDOW_OFFSET"`
+	// CloseDelay is how long a window waits after its end before being closed, so
+	// transactions completed right before the end are still settled in it.
+	CloseDelay    time.Duration `env:"SETTLEMENT_CLOSE_DELAY"`
+	CloseInterval time.Duration `env:"SETTLEMENT_CLOSE_INTERVAL"`
+
+	SigningKey       ed25519.PrivateKey
+	SigningKeyString string `env:"SETTLEMENT_SIGNING_KEY"`
+}
+
+func (c *settlement) build() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Window <= 0 {
+		return fmt.Errorf("invalid window %s", c.Window)
+	}
+	if c.CloseInterval <= 0 {
+		return errors.New("close interval must be positive")
+	}
+	signingKeyPem, _ := pem.Decode([]byte(escapeNewLines(c.SigningKeyString)))
+	if signingKeyPem == nil {
+		return fmt.Errorf("failed to decode signing key")
+	}
+	signingKey, err := x509.ParsePKCS8PrivateKey(signingKeyPem.Bytes)
+	if err != nil {
+		return err
+	}
+	ed25519Key, ok := signingKey.(ed25519.PrivateKey)
+	if !ok {
+		return fmt.Errorf("signing key is not an ed25519 key")
+	}
+	c.SigningKey = ed25519Key
+	return nil
+}
+
+type reserve struct {
+	// Operators are the banks allowed to credit and debit the reserve of any bank.
+	Operators       []uuid.UUID
+	OperatorStrings []string `env:"RESERVE_OPERATORS"`
+}
+
+func (c *reserve) build() error {
+	c.Operators = nil
+	for _, operator := range c.OperatorStrings {
+		ID, err := uuid.Parse(operator)
+		if err != nil {
+			return fmt.Errorf("invalid operator %s: %w", operator, err)
+		}
+		c.Operators = append(c.Operators, ID)
+	}
+	return nil
+}
+
+type limits struct {
+	// Nighttime limits apply from NightStart to NightEnd, which are times of the day.
+	// Days and nights are in the zone UTCOffset away from UTC.
+	NightStart time.Duration `env:"LIMITS_NIGHT_START"`
+	NightEnd   time.Duration `env:"LIMITS_NIGHT_END"`
+	UTCOffset  time.Duration `env:"LIMITS_UTC_OFFSET"`
+}
+
+type risk struct {
+	// Transactions of senders who started VelocityMax transactions within VelocityWindow
+	// are held.
+	VelocityWindow time.Duration `env:"RISK_VELOCITY_WINDOW"`
+	VelocityMax    uint64        `env:"RISK_VELOCITY_MAX"`
+	// First transactions to a receiver of at least FirstReceiverAmount are held.
+	FirstReceiverAmount uint64 `env:"RISK_FIRST_RECEIVER_AMOUNT"`
+	// Amounts over AnomalyFactor times the average of the last AnomalyHistory
+	// transactions of the sender are held.
+	AnomalyHistory uint64 `env:"RISK_ANOMALY_HISTORY"`
+	AnomalyFactor  uint64 `env:"RISK_ANOMALY_FACTOR"`
+	// Transactions to FlaggedKeys are blocked.
+	FlaggedKeys []string `env:"RISK_FLAGGED_KEYS"`
+	// Reviewers are the banks allowed to release held transactions they are not part of.
+	Reviewers       []uuid.UUID
+	ReviewerStrings []string `env:"RISK_REVIEWERS"`
+}
+
+func (c *risk) build() error {
+	c.Reviewers = nil
+	for _, reviewer := range c.ReviewerStrings {
+		ID, err := uuid.Parse(reviewer)
+		if err != nil {
+			return fmt.Errorf("invalid reviewer %s: %w", reviewer, err)
+		}
+		c.Reviewers = append(c.Reviewers, ID)
+	}
+	return nil
+}
+
+type claim struct {
+	// Donor banks must confirm or cancel claims within ResolveTimeout, after which the
+	// claims complete on their own. Expired claims are looked up every TimeoutInterval.
+	ResolveTimeout  time.Duration `env:"CLAIM_RESOLVE_TIMEOUT"`
+	TimeoutInterval time.Duration `env:"CLAIM_TIMEOUT_INTERVAL"`
+}
+
+func (c *claim) build() error {
+	if c.ResolveTimeout <= 0 {
+		return errors.New("resolve timeout must be positive")
+	}
+	if c.TimeoutInterval <= 0 {
+		return errors.New("timeout interval must be positive")
+	}
+	return nil
+}
+
+type pixKey struct {
+	// Accounts hold at most MaxRandomKeys random keys which were not deleted.
+	MaxRandomKeys uint64 `env:"PIXKEY_MAX_RANDOM_KEYS"`
+	// Accounts of individuals hold at most MaxKeys.Individual keys which were not deleted,
+	// and accounts of companies at most MaxKeys.Company. BankMaxKeys overrides MaxKeys for
+	// some banks, each entry formatted as bankID:individual:company.
+	MaxKeys            MaxKeys
+	MaxKeysIndividual  uint64 `env:"PIXKEY_MAX_KEYS_INDIVIDUAL"`
+	MaxKeysCompany     uint64 `env:"PIXKEY_MAX_KEYS_COMPANY"`
+	BankMaxKeys        map[uuid.UUID]MaxKeys
+	BankMaxKeysStrings []string `env:"PIXKEY_BANK_MAX_KEYS"`
+	// Phone and e-mail keys must be verified within VerificationExpiry, in at most
+	// VerificationMaxAttempts attempts.
+	VerificationExpiry      time.Duration `env:"PIXKEY_VERIFICATION_EXPIRY"`
+	VerificationMaxAttempts uint64        `env:"PIXKEY_VERIFICATION_MAX_ATTEMPTS"`
+	// Key events are published every EventsInterval, at most EventsBatchSize at a time.
+	EventsInterval  time.Duration `env:"PIXKEY_EVENTS_INTERVAL"`
+	EventsBatchSize int           `env:"PIXKEY_EVENTS_BATCH_SIZE"`
+	// Verification codes are sent by the Notifier: "webhook" posts them to NotifierURL,
+	// authenticated by NotifierToken, and "log" only logs them, for tests and local runs.
+	Notifier        string        `env:"PIXKEY_NOTIFIER"`
+	NotifierURL     string        `env:"PIXKEY_NOTIFIER_URL"`
+	NotifierToken   string        `env:"PIXKEY_NOTIFIER_TOKEN"`
+	NotifierTimeout time.Duration `env:"PIXKEY_NOTIFIER_TIMEOUT"`
+}
+
+type MaxKeys struct {
+	Individual uint64
+	Company    uint64
+}
+
+func (c *pixKey) build() error {
+	if c.EventsInterval <= 0 {
+		return errors.New("events interval must be positive")
+	}
+	if c.EventsBatchSize <= 0 {
+		return errors.New("events batch size must be positive")
+	}
+	switch c.Notifier {
+	case "webhook":
+		notifierURL, err := url.Parse(c.NotifierURL)
+		if err != nil {
+			return fmt.Errorf("invalid notifier url: %w", err)
+		}
+		if !notifierURL.IsAbs() {
+			return errors.New("notifier url must be absolute")
+		}
+		if c.NotifierTimeout <= 0 {
+			return errors.New("notifier timeout must be positive")
+		}
+	case "log":
+	case "":
+		return errors.New("no notifier configured")
+	default:
+		return fmt.Errorf("unknown notifier %s", c.Notifier)
+	}
+	c.MaxKeys = MaxKeys{
+		Individual: c.MaxKeysIndividual,
+		Company:    c.MaxKeysCompany,
+	}
+	c.BankMaxKeys = map[uuid.UUID]MaxKeys{}
+	for _, entry := range c.BankMaxKeysStrings {
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 {
+			return fmt.Errorf("invalid bank max keys %s", entry)
+		}
+		bankID, err := uuid.Parse(parts[0])
+		if err != nil {
+			return fmt.Errorf("invalid bank max keys %s: %w", entry, err)
+		}
+		individual, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid bank max keys %s: %w", entry, err)
+		}
+		company, err := strconv.ParseUint(parts[2], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid bank max keys %s: %w", entry, err)
+		}
+		c.BankMaxKeys[bankID] = MaxKeys{
+			Individual: individual,
+			Company:    company,
+		}
+	}
+	return nil
+}
+
+type rateLimit struct {
+	// Key lookups take a token from the bucket of the bank and from the bucket of the end
+	// user, if one is given. Buckets hold at most BankCapacity or UserCapacity tokens and
+	// gain a token every BankRefill or UserRefill.
+	BankCapacity uint64        `env:"RATELIMIT_BANK_CAPACITY"`
+	BankRefill   time.Duration `env:"RATELIMIT_BANK_REFILL"`
+	UserCapacity uint64        `env:"RATELIMIT_USER_CAPACITY"`
+	UserRefill   time.Duration `env:"RATELIMIT_USER_REFILL"`
+	// Lookups of keys which do not exist take MissPenalty more tokens. Lookups leading to
+	// a transaction completed within CompletedWindow give back CompletedRefund tokens.
+	MissPenalty     uint64        `env:"RATELIMIT_MISS_PENALTY"`
+	CompletedRefund uint64        `env:"RATELIMIT_COMPLETED_REFUND"`
+	CompletedWindow time.Duration `env:"RATELIMIT_COMPLETED_WINDOW"`
+}
+
+// build rejects empty buckets, which would reject every lookup, and buckets which are
+// never refilled.
+func (c *rateLimit) build() error {
+	if c.BankCapacity == 0 || c.UserCapacity == 0 {
+		return errors.New("bank and user capacities must be positive")
+	}
+	if c.BankRefill <= 0 || c.UserRefill <= 0 {
+		return errors.New("bank and user refills must be positive")
+	}
+	return nil
 }
 
 func escapeNewLines(str string) string {
@@ -0,0 +1,177 @@
+package infraction
+
+import (
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/transaction"
+
+	eh "github.com/looplab/eventhorizon"
+	"github.com/looplab/eventhorizon/uuid"
+)
+
+var (
+	ErrAlreadyOpened = &aggregates.StatusMismatchError{
+		"infraction report already opened",
+	}
+	ErrCannotOpenIfNotCompleted = &aggregates.StatusMismatchError{
+		"cannot open infraction report if transaction not completed",
+	}
+	ErrCannotAcknowledgeIfNotOpen = &aggregates.StatusMismatchError{
+		"cannot acknowledge infraction report if not open",
+	}
+	ErrCannotCloseIfNotAcknowledged = &aggregates.StatusMismatchError{
+		"cannot accept or reject infraction report if not acknowledged",
+	}
+
+	ErrCannotOpenIfNotTheSender = &aggregates.PermissionError{
+		"cannot open infraction report if not the sender",
+	}
+	ErrCannotAcknowledgeIfNotTheReceiver = &aggregates.PermissionError{
+		"cannot acknowledge infraction report if not the receiver",
+	}
+	ErrCannotCloseIfNotTheReceiver = &aggregates.PermissionError{
+		"cannot accept or reject infraction report if not the receiver",
+	}
+
+	ErrRefundAmountExceeded = &aggregates.AmountError{
+		"refund amount exceeds the transaction amount",
+	}
+)
+
+type Command interface {
+	ToEvent(ag Aggregate) (Event, error)
+}
+
+// Open reports a transaction. The transaction fields are copied from the transaction
+// being reported, so the report can be checked and routed to both banks.
+type Open struct {
+	ID                uuid.UUID
+	BankID            uuid.UUID
+	TransactionID     uuid.UUID
+	TransactionStatus transaction.Status
+	SenderBank        uuid.UUID
+	ReceiverBank      uuid.UUID
+	Amount            Amount
+	Reason            string
+}
+
+func (c Open) ToEvent(ag Aggregate) (Event, error) {
+	if ag.Report.Status != 0 {
+		return nil, ErrAlreadyOpened
+	}
+	if c.BankID != c.SenderBank {
+		return nil, ErrCannotOpenIfNotTheSender
+	}
+	if c.TransactionStatus != transaction.Completed {
+		return nil, ErrCannotOpenIfNotCompleted
+	}
+	return InfractionReportOpened{
+		TransactionID: c.TransactionID,
+		SenderBank:    c.SenderBank,
+		ReceiverBank:  c.ReceiverBank,
+		Amount:        c.Amount,
+		Reason:        c.Reason,
+	}, nil
+}
+
+// Acknowledge tells the sender bank the report is being analyzed.
+type Acknowledge struct {
+	ID     uuid.UUID
+	BankID uuid.UUID
+}
+
+func (c Acknowledge) ToEvent(ag Aggregate) (Event, error) {
+	report := ag.Report
+	if report.Status != Opened {
+		return nil, ErrCannotAcknowledgeIfNotOpen
+	}
+	if c.BankID != report.ReceiverBank {
+		return nil, ErrCannotAcknowledgeIfNotTheReceiver
+	}
+	return InfractionReportAcknowledged{
+		SenderBank:   report.SenderBank,
+		ReceiverBank: report.ReceiverBank,
+	}, nil
+}
+
+// Accept closes the report as an infraction. A refund amount other than zero requests a
+// refund of the transaction back to the sender, so it may not exceed Refundable, the
+// amount of the transaction earlier refunds left.
+type Accept struct {
+	ID           uuid.UUID
+	BankID       uuid.UUID
+	Analysis     string `eh:"optional"`
+	RefundAmount Amount `eh:"optional"`
+	Refundable   Amount `eh:"optional"`
+}
+
+func (c Accept) ToEvent(ag Aggregate) (Event, error) {
+	report := ag.Report
+	if report.Status != Acknowledged {
+		return nil, ErrCannotCloseIfNotAcknowledged
+	}
+	if c.BankID != report.ReceiverBank {
+		return nil, ErrCannotCloseIfNotTheReceiver
+	}
+	if c.RefundAmount > report.Amount || c.RefundAmount > c.Refundable {
+		return nil, ErrRefundAmountExceeded
+	}
+	return InfractionReportAccepted{
+		TransactionID: report.TransactionID,
+		SenderBank:    report.SenderBank,
+		ReceiverBank:  report.ReceiverBank,
+		Analysis:      c.Analysis,
+		RefundAmount:  c.RefundAmount,
+	}, nil
+}
+
+// Reject closes the report as no infraction.
+type Reject struct {
+	ID       uuid.UUID
+	BankID   uuid.UUID
+	Analysis string `eh:"optional"`
+}
+
+func (c Reject) ToEvent(ag Aggregate) (Event, error) {
+	report := ag.Report
+	if report.Status != Acknowledged {
+		return nil, ErrCannotCloseIfNotAcknowledged
+	}
+	if c.BankID != report.ReceiverBank {
+		return nil, ErrCannotCloseIfNotTheReceiver
+	}
+	return InfractionReportRejected{
+		SenderBank:   report.SenderBank,
+		ReceiverBank: report.ReceiverBank,
+		Analysis:     c.Analysis,
+	}, nil
+}
+
+const (
+	OpenCommand        = eh.CommandType(AggregateType + "_open")
+	AcknowledgeCommand = eh.CommandType(AggregateType + "_acknowledge")
+	AcceptCommand      = eh.CommandType(AggregateType + "_accept")
+	RejectCommand      = eh.CommandType(AggregateType + "_reject")
+)
+
+func init() {
+	eh.RegisterCommand(func() eh.Command { return Open{} })
+	eh.RegisterCommand(func() eh.Command { return Acknowledge{} })
+	eh.RegisterCommand(func() eh.Command { return Accept{} })
+	eh.RegisterCommand(func() eh.Command { return Reject{} })
+}
+
+func (c Open) AggregateID() uuid.UUID          { return c.ID }
+func (c Open) AggregateType() eh.AggregateType { return AggregateType }
+func (c Open) CommandType() eh.CommandType     { return OpenCommand }
+
+func (c Acknowledge) AggregateID() uuid.UUID          { return c.ID }
+func (c Acknowledge) AggregateType() eh.AggregateType { return AggregateType }
+func (c Acknowledge) CommandType() eh.CommandType     { return AcknowledgeCommand }
+
+func (c Accept) AggregateID() uuid.UUID          { return c.ID }
+func (c Accept) AggregateType() eh.AggregateType { return AggregateType }
+func (c Accept) CommandType() eh.CommandType     { return AcceptCommand }
+
+func (c Reject) AggregateID() uuid.UUID          { return c.ID }
+func (c Reject) AggregateType() eh.AggregateType { return AggregateType }
+func (c Reject) CommandType() eh.CommandType     { return RejectCommand }
@@ -0,0 +1,210 @@
+package infraction_test
+
+import (
+	"codepix/bank-api/infraction"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/transaction"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var senderBank = uuid.New()
+var receiverBank = uuid.New()
+var transactionID = uuid.New()
+
+func openReport(mutate ...func(*infraction.Report)) *infraction.Report {
+	r := &infraction.Report{
+		TransactionID: transactionID,
+		SenderBank:    senderBank,
+		ReceiverBank:  receiverBank,
+		Amount:        100,
+		Reason:        "scam",
+		Status:        infraction.Opened,
+	}
+	for _, m := range mutate {
+		m(r)
+	}
+	return r
+}
+func acknowledgedReport() *infraction.Report {
+	return openReport(func(r *infraction.Report) { r.Status = infraction.Acknowledged })
+}
+func acceptedReport() *infraction.Report {
+	return openReport(func(r *infraction.Report) { r.Status = infraction.Accepted })
+}
+func rejectedReport() *infraction.Report {
+	return openReport(func(r *infraction.Report) { r.Status = infraction.Rejected })
+}
+
+// handle runs the command on a copy of the initial state, and returns the state after
+// its event is applied.
+func handle(t *testing.T, initialState *infraction.Report, cmd eventhorizon.Command, err error,
+) *infraction.Report {
+	ctx := context.Background()
+	ag := infraction.New(uuid.New())
+	state := *initialState
+	ag.Report = &state
+
+	actual := ag.HandleCommand(ctx, cmd)
+	if err != nil {
+		require.IsType(t, &aggregates.InvariantViolation{}, actual)
+		require.ErrorAs(t, actual, &err)
+		assert.Equal(t, initialState, ag.Report)
+		return ag.Report
+	}
+	require.NoError(t, actual)
+	lastEvent := ag.UncommittedEvents()[len(ag.UncommittedEvents())-1]
+	require.NoError(t, ag.ApplyEvent(ctx, lastEvent))
+	return ag.Report
+}
+
+func TestOpenReport(t *testing.T) {
+	valid := infraction.Open{
+		ID:                infraction.ID(transactionID),
+		BankID:            senderBank,
+		TransactionID:     transactionID,
+		TransactionStatus: transaction.Completed,
+		SenderBank:        senderBank,
+		ReceiverBank:      receiverBank,
+		Amount:            100,
+		Reason:            "scam",
+	}
+	notTheSender := valid
+	notTheSender.BankID = receiverBank
+	notCompleted := valid
+	notCompleted.TransactionStatus = transaction.Confirmed
+
+	testCases := []struct {
+		initialState *infraction.Report
+		cmd          infraction.Open
+		err          error
+	}{
+		{&infraction.Report{}, valid, nil},
+		{openReport(), valid, infraction.ErrAlreadyOpened},
+		{rejectedReport(), valid, infraction.ErrAlreadyOpened},
+
+		{&infraction.Report{}, notTheSender, infraction.ErrCannotOpenIfNotTheSender},
+		{&infraction.Report{}, notCompleted, infraction.ErrCannotOpenIfNotCompleted},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			state := handle(t, tc.initialState, tc.cmd, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, openReport(), state)
+			}
+		})
+	}
+}
+
+func TestAcknowledgeReport(t *testing.T) {
+	valid := infraction.Acknowledge{
+		ID:     infraction.ID(transactionID),
+		BankID: receiverBank,
+	}
+	notTheReceiver := valid
+	notTheReceiver.BankID = senderBank
+
+	testCases := []struct {
+		initialState *infraction.Report
+		cmd          infraction.Acknowledge
+		err          error
+	}{
+		{openReport(), valid, nil},
+		{&infraction.Report{}, valid, infraction.ErrCannotAcknowledgeIfNotOpen},
+		{acknowledgedReport(), valid, infraction.ErrCannotAcknowledgeIfNotOpen},
+		{acceptedReport(), valid, infraction.ErrCannotAcknowledgeIfNotOpen},
+
+		{openReport(), notTheReceiver, infraction.ErrCannotAcknowledgeIfNotTheReceiver},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			state := handle(t, tc.initialState, tc.cmd, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, infraction.Acknowledged, state.Status)
+			}
+		})
+	}
+}
+
+func TestAcceptReport(t *testing.T) {
+	valid := infraction.Accept{
+		ID:           infraction.ID(transactionID),
+		BankID:       receiverBank,
+		Analysis:     "confirmed scam",
+		RefundAmount: 100,
+		Refundable:   100,
+	}
+	withoutRefund := valid
+	withoutRefund.Refundable = 0
+	withoutRefund.RefundAmount = 0
+	notTheReceiver := valid
+	notTheReceiver.BankID = senderBank
+	exceeded := valid
+	exceeded.RefundAmount = 101
+	partlyRefunded := valid
+	partlyRefunded.Refundable = 60
+
+	testCases := []struct {
+		initialState *infraction.Report
+		cmd          infraction.Accept
+		err          error
+	}{
+		{acknowledgedReport(), valid, nil},
+		{acknowledgedReport(), withoutRefund, nil},
+		{openReport(), valid, infraction.ErrCannotCloseIfNotAcknowledged},
+		{acceptedReport(), valid, infraction.ErrCannotCloseIfNotAcknowledged},
+		{rejectedReport(), valid, infraction.ErrCannotCloseIfNotAcknowledged},
+
+		{acknowledgedReport(), notTheReceiver, infraction.ErrCannotCloseIfNotTheReceiver},
+		{acknowledgedReport(), exceeded, infraction.ErrRefundAmountExceeded},
+		{acknowledgedReport(), partlyRefunded, infraction.ErrRefundAmountExceeded},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			state := handle(t, tc.initialState, tc.cmd, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, infraction.Accepted, state.Status)
+				assert.Equal(t, tc.cmd.Analysis, state.Analysis)
+				assert.Equal(t, tc.cmd.RefundAmount, state.RefundAmount)
+			}
+		})
+	}
+}
+
+func TestRejectReport(t *testing.T) {
+	valid := infraction.Reject{
+		ID:       infraction.ID(transactionID),
+		BankID:   receiverBank,
+		Analysis: "legitimate purchase",
+	}
+	notTheReceiver := valid
+	notTheReceiver.BankID = senderBank
+
+	testCases := []struct {
+		initialState *infraction.Report
+		cmd          infraction.Reject
+		err          error
+	}{
+		{acknowledgedReport(), valid, nil},
+		{openReport(), valid, infraction.ErrCannotCloseIfNotAcknowledged},
+		{acceptedReport(), valid, infraction.ErrCannotCloseIfNotAcknowledged},
+
+		{acknowledgedReport(), notTheReceiver, infraction.ErrCannotCloseIfNotTheReceiver},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			state := handle(t, tc.initialState, tc.cmd, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, infraction.Rejected, state.Status)
+				assert.Equal(t, tc.cmd.Analysis, state.Analysis)
+				assert.Zero(t, state.RefundAmount)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package infraction
+
+import (
+	"github.com/google/uuid"
+	eh "github.com/looplab/eventhorizon"
+)
+
+type Event interface {
+	Apply(report *Report)
+	Type() eh.EventType
+}
+
+type InfractionReportOpened struct {
+	TransactionID uuid.UUID `json:"transaction_id" bson:"transaction_id"`
+	SenderBank    uuid.UUID `json:"sender_bank" bson:"sender_bank"`
+	ReceiverBank  uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
+	Amount        Amount    `json:"amount" bson:"amount"`
+	Reason        string    `json:"reason" bson:"reason"`
+}
+
+func (e InfractionReportOpened) Apply(report *Report) {
+	report.TransactionID = e.TransactionID
+	report.SenderBank = e.SenderBank
+	report.ReceiverBank = e.ReceiverBank
+	report.Amount = e.Amount
+	report.Reason = e.Reason
+	report.Status = Opened
+}
+
+type InfractionReportAcknowledged struct {
+	SenderBank   uuid.UUID `json:"sender_bank" bson:"sender_bank"`
+	ReceiverBank uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
+}
+
+func (e InfractionReportAcknowledged) Apply(report *Report) {
+	report.Status = Acknowledged
+}
+
+type InfractionReportAccepted struct {
+	TransactionID uuid.UUID `json:"transaction_id" bson:"transaction_id"`
+	SenderBank    uuid.UUID `json:"sender_bank" bson:"sender_bank"`
+	ReceiverBank  uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
+	Analysis      string    `json:"analysis" bson:"analysis"`
+	RefundAmount  Amount    `json:"refund_amount" bson:"refund_amount"`
+}
+
+func (e InfractionReportAccepted) Apply(report *Report) {
+	report.Status = Accepted
+	report.Analysis = e.Analysis
+	report.RefundAmount = e.RefundAmount
+}
+
+type InfractionReportRejected struct {
+	SenderBank   uuid.UUID `json:"sender_bank" bson:"sender_bank"`
+	ReceiverBank uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
+	Analysis     string    `json:"analysis" bson:"analysis"`
+}
+
+func (e InfractionReportRejected) Apply(report *Report) {
+	report.Status = Rejected
+	report.Analysis = e.Analysis
+}
+
+const (
+	OpenedEvent       = eh.EventType(AggregateType + "_opened")
+	AcknowledgedEvent = eh.EventType(AggregateType + "_acknowledged")
+	AcceptedEvent     = eh.EventType(AggregateType + "_accepted")
+	RejectedEvent     = eh.EventType(AggregateType + "_rejected")
+)
+
+func init() {
+	eh.RegisterEventData(OpenedEvent, func() eh.EventData { return &InfractionReportOpened{} })
+	eh.RegisterEventData(AcknowledgedEvent, func() eh.EventData { return &InfractionReportAcknowledged{} })
+	eh.RegisterEventData(AcceptedEvent, func() eh.EventData { return &InfractionReportAccepted{} })
+	eh.RegisterEventData(RejectedEvent, func() eh.EventData { return &InfractionReportRejected{} })
+}
+
+func (InfractionReportOpened) Type() eh.EventType       { return OpenedEvent }
+func (InfractionReportAcknowledged) Type() eh.EventType { return AcknowledgedEvent }
+func (InfractionReportAccepted) Type() eh.EventType     { return AcceptedEvent }
+func (InfractionReportRejected) Type() eh.EventType     { return RejectedEvent }
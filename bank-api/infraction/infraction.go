@@ -0,0 +1,37 @@
+package infraction
+
+import (
+	"codepix/bank-api/transaction"
+
+	"github.com/google/uuid"
+)
+
+type Status uint8
+type Amount = transaction.Amount
+
+const (
+	Opened Status = iota + 1
+	Acknowledged
+	Accepted
+	Rejected
+)
+
+// Report is an infraction the sender bank reports against a completed transaction,
+// such as a scam. The receiver bank acknowledges it while analyzing it, then accepts or
+// rejects it. Accepting it may refund part or all of the transaction.
+type Report struct {
+	TransactionID uuid.UUID
+	SenderBank    uuid.UUID
+	ReceiverBank  uuid.UUID
+	Amount        Amount
+	Reason        string
+	Status        Status
+	Analysis      string
+	RefundAmount  Amount
+}
+
+// ID returns the report ID of a transaction. Each transaction may only be reported once,
+// so opening a report again fails.
+func ID(transactionID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(transactionID, []byte("infraction_report"))
+}
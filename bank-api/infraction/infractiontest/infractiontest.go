@@ -0,0 +1,46 @@
+package infractiontest
+
+import (
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/bankapitest"
+	readservice "codepix/bank-api/infraction/read/service"
+	writeservice "codepix/bank-api/infraction/write/service"
+	readproto "codepix/bank-api/proto/codepix/infraction/read"
+	writeproto "codepix/bank-api/proto/codepix/infraction/write"
+	"codepix/bank-api/transaction/transactiontest"
+)
+
+func WriteServiceWithMocks() (writeproto.ServiceClient, *MockCommandHandler,
+	*MockReadRepo, *transactiontest.MockReadRepo) {
+	validator, err := validator.New()
+	if err != nil {
+		panic(err)
+	}
+	server, client, serve := bankapitest.Server(validator)
+	commandHandler := new(MockCommandHandler)
+	readRepo := new(MockReadRepo)
+	txReadRepo := new(transactiontest.MockReadRepo)
+
+	err = writeservice.Register(server, validator, commandHandler, readRepo, txReadRepo)
+	if err != nil {
+		panic(err)
+	}
+	serve()
+	return writeproto.NewServiceClient(client), commandHandler, readRepo, txReadRepo
+}
+
+func ReadServiceWithMocks() (readproto.ServiceClient, *MockReadRepo) {
+	validator, err := validator.New()
+	if err != nil {
+		panic(err)
+	}
+	server, client, serve := bankapitest.Server(validator)
+	readRepo := new(MockReadRepo)
+
+	err = readservice.Register(server, readRepo)
+	if err != nil {
+		panic(err)
+	}
+	serve()
+	return readproto.NewServiceClient(client), readRepo
+}
@@ -0,0 +1,27 @@
+package projection
+
+import (
+	"codepix/bank-api/adapters/projectionclient"
+	"codepix/bank-api/infraction"
+	"codepix/bank-api/infraction/read/repository"
+	"fmt"
+
+	"github.com/looplab/eventhorizon"
+)
+
+func New(client *projectionclient.StoreProjection) (*Projection, error) {
+	projector := &Projector{}
+	entityType := func() eventhorizon.Entity {
+		return &repository.Report{}
+	}
+	projection, err := client.Setup(
+		projector.ProjectorType(),
+		entityType,
+		projector,
+		infraction.AggregateType,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("new Projection: %w", err)
+	}
+	return &Projection{projection}, nil
+}
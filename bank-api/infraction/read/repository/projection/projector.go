@@ -0,0 +1,61 @@
+package projection
+
+import (
+	"codepix/bank-api/infraction"
+	"codepix/bank-api/infraction/read/repository"
+	"context"
+	"fmt"
+
+	"github.com/looplab/eventhorizon"
+	"github.com/looplab/eventhorizon/eventhandler/projector"
+)
+
+type Projector struct{}
+
+var _ projector.Projector = Projector{}
+
+func (p Projector) ProjectorType() projector.Type {
+	return projector.Type(repository.RepositoryType)
+}
+
+func (p Projector) Project(ctx context.Context, event eventhorizon.Event, entity eventhorizon.Entity,
+) (eventhorizon.Entity, error) {
+	r, ok := entity.(*repository.Report)
+	if !ok {
+		return nil, fmt.Errorf("unknown entity type %T", entity)
+	}
+	if event.Version() != r.Version+1 {
+		return nil, fmt.Errorf("%w: expected version %d, got %d",
+			eventhorizon.ErrIncorrectEntityVersion, r.Version+1, event.Version())
+	}
+	switch e := event.Data().(type) {
+	case *infraction.InfractionReportOpened:
+		r.ID = event.AggregateID()
+		r.TransactionID = e.TransactionID
+		r.SenderBank = e.SenderBank
+		r.ReceiverBank = e.ReceiverBank
+
+		r.CreatedAt = event.Timestamp()
+		r.Amount = e.Amount
+		r.Reason = e.Reason
+		r.Status = infraction.Opened
+
+	case *infraction.InfractionReportAcknowledged:
+		r.Status = infraction.Acknowledged
+
+	case *infraction.InfractionReportAccepted:
+		r.Status = infraction.Accepted
+		r.Analysis = e.Analysis
+		r.RefundAmount = e.RefundAmount
+
+	case *infraction.InfractionReportRejected:
+		r.Status = infraction.Rejected
+		r.Analysis = e.Analysis
+
+	default:
+		return nil, fmt.Errorf("unknown event type %s/%T", event.EventType(), event.Data())
+	}
+	r.UpdatedAt = event.Timestamp()
+	r.Version = event.Version()
+	return r, nil
+}
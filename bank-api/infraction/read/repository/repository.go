@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"codepix/bank-api/infraction"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+var EntityType = "infraction report"
+var RepositoryType = "infraction_reports"
+
+type Repository interface {
+	Find(ctx context.Context, ID uuid.UUID) (*Report, error)
+	List(ctx context.Context, options ListOptions) ([]ListItem, error)
+}
+
+type Report struct {
+	ID            uuid.UUID `bson:"_id"`
+	TransactionID uuid.UUID `bson:"transaction_id"`
+	SenderBank    uuid.UUID `bson:"sender_bank"`
+	ReceiverBank  uuid.UUID `bson:"receiver_bank"`
+
+	CreatedAt    time.Time         `bson:"created_at"`
+	UpdatedAt    time.Time         `bson:"updated_at"`
+	Amount       infraction.Amount `bson:"amount"`
+	Reason       string            `bson:"reason"`
+	Status       infraction.Status `bson:"status"`
+	Analysis     string            `bson:"analysis"`
+	RefundAmount infraction.Amount `bson:"refund_amount"`
+
+	Version int `bson:"version"`
+}
+
+var _ eventhorizon.Entity = Report{}
+
+func (r Report) EntityID() uuid.UUID {
+	return r.ID
+}
+
+var _ eventhorizon.Versionable = Report{}
+
+func (r Report) AggregateVersion() int {
+	return r.Version
+}
+
+type ListItem = Report
+
+// ListOptions lists the reports a bank opened or received.
+type ListOptions struct {
+	CreatedAfter time.Time
+	BankID       uuid.UUID
+	Limit        uint64
+	Skip         uint64
+}
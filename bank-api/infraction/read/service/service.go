@@ -0,0 +1,97 @@
+package service
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/infraction/read/repository"
+	proto "codepix/bank-api/proto/codepix/infraction/read"
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Service struct {
+	Repository repository.Repository
+	proto.UnimplementedServiceServer
+}
+
+var _ proto.ServiceServer = Service{}
+
+// Find is only allowed to the banks of the reported transaction.
+func (s Service) Find(ctx context.Context, req *proto.FindRequest) (*proto.FindReply, error) {
+	bankID := auth.GetBankID(ctx)
+	ID, _ := uuid.FromBytes(req.Id)
+
+	report, err := s.Repository.Find(ctx, ID)
+	if err == nil && report.SenderBank != bankID && report.ReceiverBank != bankID {
+		return nil, status.Error(codes.PermissionDenied, "")
+	}
+	return findReply(report), rpc.MapError(ctx, err)
+}
+
+func findReply(report *repository.Report) *proto.FindReply {
+	if report == nil {
+		return nil
+	}
+	return &proto.FindReply{
+		Id:            report.ID[:],
+		TransactionId: report.TransactionID[:],
+		SenderBank:    report.SenderBank[:],
+		ReceiverBank:  report.ReceiverBank[:],
+
+		CreatedAt:    timestamppb.New(report.CreatedAt),
+		UpdatedAt:    timestamppb.New(report.UpdatedAt),
+		Amount:       report.Amount,
+		Reason:       report.Reason,
+		Status:       proto.Status(report.Status),
+		Analysis:     report.Analysis,
+		RefundAmount: report.RefundAmount,
+	}
+}
+
+// List returns the reports the calling bank opened or received.
+func (s Service) List(ctx context.Context, req *proto.ListRequest) (*proto.ListReply, error) {
+	bankID := auth.GetBankID(ctx)
+
+	options := repository.ListOptions{
+		CreatedAfter: req.CreatedAfter.AsTime(),
+		BankID:       bankID,
+		Limit:        req.Limit,
+		Skip:         req.Skip,
+	}
+	reports, err := s.Repository.List(ctx, options)
+	return listReply(reports), rpc.MapError(ctx, err)
+}
+
+func listReply(reports []repository.ListItem) *proto.ListReply {
+	if reports == nil {
+		return nil
+	}
+	items := []*proto.ListItem{}
+	for _, report := range reports {
+		items = append(items, listItemReply(report))
+	}
+	return &proto.ListReply{
+		Items: items,
+	}
+}
+
+func listItemReply(report repository.ListItem) *proto.ListItem {
+	return &proto.ListItem{
+		Id:            report.ID[:],
+		TransactionId: report.TransactionID[:],
+		SenderBank:    report.SenderBank[:],
+		ReceiverBank:  report.ReceiverBank[:],
+
+		CreatedAt:    timestamppb.New(report.CreatedAt),
+		UpdatedAt:    timestamppb.New(report.UpdatedAt),
+		Amount:       report.Amount,
+		Reason:       report.Reason,
+		Status:       proto.Status(report.Status),
+		Analysis:     report.Analysis,
+		RefundAmount: report.RefundAmount,
+	}
+}
@@ -0,0 +1,99 @@
+package service_test
+
+import (
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/infraction"
+	"codepix/bank-api/infraction/infractiontest"
+	"codepix/bank-api/infraction/read/repository"
+	"codepix/bank-api/lib/repositories"
+	proto "codepix/bank-api/proto/codepix/infraction/read"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func validReport() *repository.Report {
+	return &repository.Report{
+		ID:            uuid.New(),
+		TransactionID: uuid.New(),
+		SenderBank:    uuid.New(),
+		ReceiverBank:  uuid.New(),
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+		Amount:        100,
+		Reason:        "scam",
+		Status:        infraction.Opened,
+		Version:       1,
+	}
+}
+
+func TestFind(t *testing.T) {
+	client, readRepo := infractiontest.ReadServiceWithMocks()
+
+	report := validReport()
+	accepted := validReport()
+	accepted.Status = infraction.Accepted
+	accepted.RefundAmount = 50
+
+	testCases := []struct {
+		description string
+		bankID      uuid.UUID
+		report      *repository.Report
+		err         error
+		code        codes.Code
+	}{
+		{"sender bank", report.SenderBank, report, nil, codes.OK},
+		{"receiver bank", report.ReceiverBank, report, nil, codes.OK},
+		{"accepted", accepted.SenderBank, accepted, nil, codes.OK},
+		{"other bank", uuid.New(), report, nil, codes.PermissionDenied},
+		{"not found", uuid.New(), nil, &repositories.NotFoundError{}, codes.NotFound},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			ID := uuid.New()
+			if tc.report != nil {
+				ID = tc.report.ID
+			}
+			readRepo.On("Find", mock.Anything, ID).Return(tc.report, tc.err).Once()
+			ctx := bankapitest.AuthenticatedContext(context.Background(), tc.bankID)
+
+			reply, err := client.Find(ctx, &proto.FindRequest{Id: ID[:]})
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.code.String(), status.Code().String())
+			if tc.code == codes.OK {
+				require.NotNil(t, reply)
+				assert.Equal(t, proto.Status(tc.report.Status), reply.Status)
+				assert.Equal(t, tc.report.TransactionID[:], reply.TransactionId)
+				assert.Equal(t, tc.report.RefundAmount, reply.RefundAmount)
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	client, readRepo := infractiontest.ReadServiceWithMocks()
+
+	bankID := uuid.New()
+	ctx := bankapitest.AuthenticatedContext(context.Background(), bankID)
+	reports := []repository.ListItem{*validReport(), *validReport()}
+
+	// only the reports of the calling bank are listed
+	readRepo.On("List", mock.Anything, mock.MatchedBy(func(options repository.ListOptions) bool {
+		return options.BankID == bankID && options.Limit == 10
+	})).Return(reports, nil).Once()
+
+	reply, err := client.List(ctx, &proto.ListRequest{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, reply.Items, 2)
+	assert.Equal(t, reports[0].ID[:], reply.Items[0].Id)
+	assert.Equal(t, reports[1].ID[:], reply.Items[1].Id)
+}
@@ -0,0 +1,71 @@
+package stream
+
+import (
+	"codepix/bank-api/adapters/eventbus"
+	"codepix/bank-api/config"
+	"codepix/bank-api/infraction"
+	proto "codepix/bank-api/proto/codepix/infraction/read"
+	txstream "codepix/bank-api/transaction/read/stream"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+	"google.golang.org/grpc"
+)
+
+func Register(server *grpc.Server, config config.Config, logger logr.Logger,
+	eventBus *eventbus.EventBus) error {
+	cfg := config.Transaction
+
+	busReader, err := eventBus.CreateReader(cfg.BusBlockDuration, cfg.BusMaxPendingAge)
+	if err != nil {
+		return err
+	}
+	stream := &Stream{
+		Consumer: txstream.Stream{
+			Logger:    logger.WithName("infractionstream"),
+			BusReader: busReader,
+		},
+	}
+	proto.RegisterStreamServer(server, stream)
+	return nil
+}
+
+func SetupWriters(eventBus *eventbus.EventBus) error {
+	err := eventBus.SetupWriter(infraction.OpenedEvent, func(event eventhorizon.Event) []string {
+		opened := event.Data().(*infraction.InfractionReportOpened)
+		return []string{
+			infraction.OpenedStream(opened.ReceiverBank),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = eventBus.SetupWriter(infraction.AcknowledgedEvent, func(event eventhorizon.Event) []string {
+		acknowledged := event.Data().(*infraction.InfractionReportAcknowledged)
+		return []string{
+			infraction.AcknowledgedStream(acknowledged.SenderBank),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = eventBus.SetupWriter(infraction.AcceptedEvent, func(event eventhorizon.Event) []string {
+		accepted := event.Data().(*infraction.InfractionReportAccepted)
+		return []string{
+			infraction.AcceptedStream(accepted.SenderBank),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = eventBus.SetupWriter(infraction.RejectedEvent, func(event eventhorizon.Event) []string {
+		rejected := event.Data().(*infraction.InfractionReportRejected)
+		return []string{
+			infraction.RejectedStream(rejected.SenderBank),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
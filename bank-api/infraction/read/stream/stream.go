@@ -0,0 +1,158 @@
+package stream
+
+import (
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/infraction"
+	proto "codepix/bank-api/proto/codepix/infraction/read"
+	txproto "codepix/bank-api/proto/codepix/transaction/read"
+	txstream "codepix/bank-api/transaction/read/stream"
+
+	"github.com/looplab/eventhorizon"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Stream sends opened reports to the receiver bank, and their outcome to the sender bank,
+// consuming them the same way the transaction stream does.
+type Stream struct {
+	Consumer txstream.Stream
+	proto.UnimplementedStreamServer
+}
+
+var _ proto.StreamServer = Stream{}
+
+type ackReceiver interface {
+	Recv() (*proto.Ack, error)
+}
+
+func receiveAck(stream ackReceiver) func() (*txproto.Ack, error) {
+	return func() (*txproto.Ack, error) {
+		ack, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return &txproto.Ack{Nacks: ack.Nacks}, nil
+	}
+}
+
+func (s Stream) Opened(stream proto.Stream_OpenedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.OpenedReport{}
+		for _, event := range events {
+			p := openedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.OpenedReports{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consumer.Consume(stream.Context(),
+		sender,
+		receiveAck(stream),
+		infraction.OpenedEvent,
+		infraction.OpenedStream(bankID),
+		bankID.String(),
+	)
+}
+func openedMapper(event eventhorizon.Event) *proto.OpenedReport {
+	ID := event.AggregateID()
+	opened := event.Data().(*infraction.InfractionReportOpened)
+	return &proto.OpenedReport{
+		Id:            ID[:],
+		Timestamp:     timestamppb.New(event.Timestamp()),
+		TransactionId: opened.TransactionID[:],
+		SenderBank:    opened.SenderBank[:],
+		Amount:        opened.Amount,
+		Reason:        opened.Reason,
+	}
+}
+
+func (s Stream) Acknowledged(stream proto.Stream_AcknowledgedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.AcknowledgedReport{}
+		for _, event := range events {
+			p := acknowledgedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.AcknowledgedReports{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consumer.Consume(stream.Context(),
+		sender,
+		receiveAck(stream),
+		infraction.AcknowledgedEvent,
+		infraction.AcknowledgedStream(bankID),
+		bankID.String(),
+	)
+}
+func acknowledgedMapper(event eventhorizon.Event) *proto.AcknowledgedReport {
+	ID := event.AggregateID()
+	return &proto.AcknowledgedReport{
+		Id:        ID[:],
+		Timestamp: timestamppb.New(event.Timestamp()),
+	}
+}
+
+func (s Stream) Accepted(stream proto.Stream_AcceptedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.AcceptedReport{}
+		for _, event := range events {
+			p := acceptedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.AcceptedReports{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consumer.Consume(stream.Context(),
+		sender,
+		receiveAck(stream),
+		infraction.AcceptedEvent,
+		infraction.AcceptedStream(bankID),
+		bankID.String(),
+	)
+}
+func acceptedMapper(event eventhorizon.Event) *proto.AcceptedReport {
+	ID := event.AggregateID()
+	accepted := event.Data().(*infraction.InfractionReportAccepted)
+	return &proto.AcceptedReport{
+		Id:            ID[:],
+		Timestamp:     timestamppb.New(event.Timestamp()),
+		TransactionId: accepted.TransactionID[:],
+		Analysis:      accepted.Analysis,
+		RefundAmount:  accepted.RefundAmount,
+	}
+}
+
+func (s Stream) Rejected(stream proto.Stream_RejectedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.RejectedReport{}
+		for _, event := range events {
+			p := rejectedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.RejectedReports{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consumer.Consume(stream.Context(),
+		sender,
+		receiveAck(stream),
+		infraction.RejectedEvent,
+		infraction.RejectedStream(bankID),
+		bankID.String(),
+	)
+}
+func rejectedMapper(event eventhorizon.Event) *proto.RejectedReport {
+	ID := event.AggregateID()
+	rejected := event.Data().(*infraction.InfractionReportRejected)
+	return &proto.RejectedReport{
+		Id:        ID[:],
+		Timestamp: timestamppb.New(event.Timestamp()),
+		Analysis:  rejected.Analysis,
+	}
+}
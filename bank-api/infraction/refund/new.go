@@ -0,0 +1,29 @@
+package refund
+
+import (
+	"codepix/bank-api/adapters/eventhandler"
+	"codepix/bank-api/infraction"
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+)
+
+func Setup(logger logr.Logger, outbox eventhorizon.Outbox,
+	commandHandler eventhorizon.CommandHandler,
+) error {
+	logger = logger.WithName("infractionrefund")
+	requester := Requester{Logger: logger, CommandHandler: commandHandler}
+	err := outbox.AddHandler(context.Background(),
+		eventhorizon.MatchEvents{infraction.AcceptedEvent},
+		eventhandler.Named(
+			eventhandler.Logger(logger, requester),
+			requester.HandlerType(),
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("setup infraction refund: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package refund
+
+import (
+	"codepix/bank-api/infraction"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/reserve"
+	"codepix/bank-api/transaction"
+	"context"
+	"errors"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+)
+
+const Reason = "infraction report accepted"
+
+// Requester requests a refund of the reported transaction once a report is accepted with
+// a refund amount. The refund ID is the report ID, so each report refunds at most once.
+// Refunds that can't be requested yet, as when the store is unavailable or the reserve of
+// the receiver doesn't cover them, are returned to the outbox, which logs and retries them.
+// Refunds the transaction rejects for good, as when its refundable amount was used up by
+// another refund, are logged and dropped, so they don't block the events after them.
+type Requester struct {
+	Logger         logr.Logger
+	CommandHandler eventhorizon.CommandHandler
+}
+
+var _ eventhorizon.EventHandler = Requester{}
+
+func (r Requester) HandlerType() eventhorizon.EventHandlerType {
+	return eventhorizon.EventHandlerType(infraction.AggregateType + "_refund")
+}
+
+func (r Requester) HandleEvent(ctx context.Context, event eventhorizon.Event) error {
+	accepted, ok := event.Data().(*infraction.InfractionReportAccepted)
+	if !ok || accepted.RefundAmount == 0 {
+		return nil
+	}
+	cmd := transaction.RequestRefund{
+		ID:       accepted.TransactionID,
+		RefundID: event.AggregateID(),
+		BankID:   accepted.ReceiverBank,
+		Amount:   accepted.RefundAmount,
+		Reason:   Reason,
+	}
+	err := r.CommandHandler.HandleCommand(ctx, cmd)
+	invariantViolation := &aggregates.InvariantViolation{}
+	if !errors.As(err, &invariantViolation) {
+		return err
+	}
+	switch invariantViolation.Err {
+	case reserve.ErrInsufficientReserve:
+		return err
+	case transaction.ErrRefundAlreadyRequested:
+		return nil
+	}
+	r.Logger.Error(err, "fail: request refund, dropped",
+		"report", event.AggregateID(), "tx", accepted.TransactionID)
+	return nil
+}
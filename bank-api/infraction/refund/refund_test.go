@@ -0,0 +1,89 @@
+package refund_test
+
+import (
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/infraction"
+	"codepix/bank-api/infraction/infractiontest"
+	"codepix/bank-api/infraction/refund"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/reserve"
+	"codepix/bank-api/transaction"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRequester(t *testing.T) {
+	ID := uuid.New()
+	transactionID := uuid.New()
+	senderBank := uuid.New()
+	receiverBank := uuid.New()
+
+	event := func(eventType eventhorizon.EventType, data eventhorizon.EventData,
+	) eventhorizon.Event {
+		return eventhorizon.NewEvent(eventType, data, time.Now(),
+			eventhorizon.ForAggregate(infraction.AggregateType, ID, 3))
+	}
+	accepted := event(infraction.AcceptedEvent, &infraction.InfractionReportAccepted{
+		TransactionID: transactionID, SenderBank: senderBank, ReceiverBank: receiverBank,
+		RefundAmount: 100,
+	})
+	withoutRefund := event(infraction.AcceptedEvent, &infraction.InfractionReportAccepted{
+		TransactionID: transactionID, SenderBank: senderBank, ReceiverBank: receiverBank,
+	})
+	rejected := event(infraction.RejectedEvent, &infraction.InfractionReportRejected{
+		SenderBank: senderBank, ReceiverBank: receiverBank,
+	})
+	requestRefund := transaction.RequestRefund{
+		ID:       transactionID,
+		RefundID: ID,
+		BankID:   receiverBank,
+		Amount:   100,
+		Reason:   refund.Reason,
+	}
+
+	testCases := []struct {
+		description string
+		event       eventhorizon.Event
+		cmd         eventhorizon.Command
+		cmdErr      error
+		err         bool
+	}{
+		{"accepted requests a refund", accepted, requestRefund, nil, false},
+		{"accepted without a refund", withoutRefund, nil, nil, false},
+		{"rejected", rejected, nil, nil, false},
+		{"already requested", accepted, requestRefund,
+			&aggregates.InvariantViolation{transaction.ErrRefundAlreadyRequested}, false},
+		{"insufficient reserve is retried", accepted, requestRefund,
+			&aggregates.InvariantViolation{reserve.ErrInsufficientReserve}, true},
+		{"exceeded amount is dropped", accepted, requestRefund,
+			&aggregates.InvariantViolation{transaction.ErrRefundAmountExceeded}, false},
+		{"not completed is dropped", accepted, requestRefund,
+			&aggregates.InvariantViolation{transaction.ErrCannotRefundIfNotCompleted}, false},
+		{"command error is retried", accepted, requestRefund, errors.New("some error"), true},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			commandHandler := new(infractiontest.MockCommandHandler)
+			if tc.cmd != nil {
+				commandHandler.On("HandleCommand", mock.Anything, tc.cmd).
+					Return(tc.cmdErr).Once()
+			}
+			requester := refund.Requester{
+				Logger:         bankapitest.Logger,
+				CommandHandler: commandHandler,
+			}
+
+			err := requester.HandleEvent(context.Background(), tc.event)
+			assert.Equal(t, tc.err, err != nil)
+			commandHandler.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package infraction
+
+import "github.com/google/uuid"
+
+const openedStream = string(OpenedEvent) + "_"
+const acknowledgedStream = string(AcknowledgedEvent) + "_"
+const acceptedStream = string(AcceptedEvent) + "_"
+const rejectedStream = string(RejectedEvent) + "_"
+
+func OpenedStream(bankID uuid.UUID) string       { return openedStream + bankID.String() }
+func AcknowledgedStream(bankID uuid.UUID) string { return acknowledgedStream + bankID.String() }
+func AcceptedStream(bankID uuid.UUID) string     { return acceptedStream + bankID.String() }
+func RejectedStream(bankID uuid.UUID) string     { return rejectedStream + bankID.String() }
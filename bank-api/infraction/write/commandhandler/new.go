@@ -0,0 +1,35 @@
+package commandhandler
+
+import (
+	"codepix/bank-api/adapters/eventstore"
+	"codepix/bank-api/infraction"
+
+	"github.com/looplab/eventhorizon"
+	"github.com/looplab/eventhorizon/commandhandler/aggregate"
+	"github.com/looplab/eventhorizon/commandhandler/bus"
+)
+
+// Setup handles infraction report commands. Reports only have a few events, so they are
+// not snapshotted.
+func Setup(eventStore *eventstore.EventStore, commandBus *bus.CommandHandler) error {
+	aggregateStore, err := eventStore.NewAggregateStore(0)
+	if err != nil {
+		return err
+	}
+	commandHandler, err := aggregate.NewCommandHandler(infraction.AggregateType, aggregateStore)
+	if err != nil {
+		return err
+	}
+	commands := []eventhorizon.CommandType{
+		infraction.OpenCommand,
+		infraction.AcknowledgeCommand,
+		infraction.AcceptCommand,
+		infraction.RejectCommand,
+	}
+	for _, cmdType := range commands {
+		if err := commandBus.SetHandler(commandHandler, cmdType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
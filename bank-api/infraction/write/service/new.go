@@ -0,0 +1,36 @@
+package service
+
+import (
+	"bytes"
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/infraction/read/repository"
+	"codepix/bank-api/infraction/write"
+	"codepix/bank-api/lib/validation"
+	proto "codepix/bank-api/proto/codepix/infraction/write"
+	txrepository "codepix/bank-api/transaction/read/repository"
+
+	"github.com/looplab/eventhorizon"
+	"google.golang.org/grpc"
+)
+
+func Register(server *grpc.Server, val *validation.Validator,
+	commandHandler eventhorizon.CommandHandler, reportRepository repository.Repository,
+	transactionRepository txrepository.Repository,
+) error {
+	err := validator.LoadTranslationFile(val, bytes.NewReader(write.Translations),
+		proto.OpenRequest{},
+		proto.AcknowledgeRequest{},
+		proto.AcceptRequest{},
+		proto.RejectRequest{},
+	)
+	if err != nil {
+		return err
+	}
+	service := &Service{
+		CommandHandler:        commandHandler,
+		ReportRepository:      reportRepository,
+		TransactionRepository: transactionRepository,
+	}
+	proto.RegisterServiceServer(server, service)
+	return nil
+}
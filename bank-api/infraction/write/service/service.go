@@ -0,0 +1,108 @@
+package service
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/infraction"
+	"codepix/bank-api/infraction/read/repository"
+	proto "codepix/bank-api/proto/codepix/infraction/write"
+	txrepository "codepix/bank-api/transaction/read/repository"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+type Service struct {
+	CommandHandler        eventhorizon.CommandHandler
+	ReportRepository      repository.Repository
+	TransactionRepository txrepository.Repository
+	proto.UnimplementedServiceServer
+}
+
+var _ proto.ServiceServer = Service{}
+
+// Open reports a transaction of the calling bank. The transaction is read from the
+// projection, so it must have been projected as completed.
+func (s Service) Open(ctx context.Context, req *proto.OpenRequest) (*proto.Opened, error) {
+	bankID := auth.GetBankID(ctx)
+	transactionID, _ := uuid.FromBytes(req.TransactionId)
+
+	tx, err := s.TransactionRepository.Find(ctx, transactionID)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	cmd := openCommand(req, bankID, tx)
+	err = s.CommandHandler.HandleCommand(ctx, cmd)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	return &proto.Opened{Id: cmd.ID[:]}, nil
+}
+
+func openCommand(req *proto.OpenRequest, bankID uuid.UUID, tx *txrepository.Transaction,
+) infraction.Open {
+	return infraction.Open{
+		ID:                infraction.ID(tx.ID),
+		BankID:            bankID,
+		TransactionID:     tx.ID,
+		TransactionStatus: tx.Status,
+		SenderBank:        tx.SenderBank,
+		ReceiverBank:      tx.ReceiverBank,
+		Amount:            tx.Amount,
+		Reason:            req.Reason,
+	}
+}
+
+func (s Service) Acknowledge(ctx context.Context, req *proto.AcknowledgeRequest,
+) (*proto.Updated, error) {
+	ID, _ := uuid.FromBytes(req.Id)
+	cmd := infraction.Acknowledge{
+		ID:     ID,
+		BankID: auth.GetBankID(ctx),
+	}
+	return s.update(ctx, cmd)
+}
+
+// Accept closes a report as an infraction. Refunds are checked against what is left to
+// refund of the transaction, which is read from the projections.
+func (s Service) Accept(ctx context.Context, req *proto.AcceptRequest) (*proto.Updated, error) {
+	ID, _ := uuid.FromBytes(req.Id)
+	cmd := infraction.Accept{
+		ID:           ID,
+		BankID:       auth.GetBankID(ctx),
+		Analysis:     req.Analysis,
+		RefundAmount: req.RefundAmount,
+	}
+	if cmd.RefundAmount != 0 {
+		report, err := s.ReportRepository.Find(ctx, ID)
+		if err != nil {
+			return nil, rpc.MapError(ctx, err)
+		}
+		tx, err := s.TransactionRepository.Find(ctx, report.TransactionID)
+		if err != nil {
+			return nil, rpc.MapError(ctx, err)
+		}
+		cmd.Refundable = tx.Refundable()
+	}
+	return s.update(ctx, cmd)
+}
+
+func (s Service) Reject(ctx context.Context, req *proto.RejectRequest) (*proto.Updated, error) {
+	ID, _ := uuid.FromBytes(req.Id)
+	cmd := infraction.Reject{
+		ID:       ID,
+		BankID:   auth.GetBankID(ctx),
+		Analysis: req.Analysis,
+	}
+	return s.update(ctx, cmd)
+}
+
+func (s Service) update(ctx context.Context, cmd eventhorizon.Command) (*proto.Updated, error) {
+	err := s.CommandHandler.HandleCommand(ctx, cmd)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	ID := cmd.AggregateID()
+	return &proto.Updated{Id: ID[:]}, nil
+}
@@ -0,0 +1,177 @@
+package service_test
+
+import (
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/infraction"
+	"codepix/bank-api/infraction/infractiontest"
+	"codepix/bank-api/infraction/read/repository"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/lib/repositories"
+	proto "codepix/bank-api/proto/codepix/infraction/write"
+	"codepix/bank-api/transaction"
+	txrepository "codepix/bank-api/transaction/read/repository"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestOpen(t *testing.T) {
+	client, commandHandler, _, txReadRepo := infractiontest.WriteServiceWithMocks()
+
+	tx := &txrepository.Transaction{
+		ID:           uuid.New(),
+		SenderBank:   uuid.New(),
+		ReceiverBank: uuid.New(),
+		Amount:       100,
+		Status:       transaction.Completed,
+	}
+	ctx := bankapitest.AuthenticatedContext(context.Background(), tx.SenderBank)
+	ctxWithLocale := metadata.AppendToOutgoingContext(ctx, "locale", validator.EN_US)
+
+	valid := &proto.OpenRequest{TransactionId: tx.ID[:], Reason: "scam"}
+	invalid := &proto.OpenRequest{TransactionId: tx.ID[:]}
+	opened := mock.MatchedBy(func(cmd infraction.Open) bool {
+		return cmd.ID == infraction.ID(tx.ID) &&
+			cmd.BankID == tx.SenderBank &&
+			cmd.TransactionID == tx.ID &&
+			cmd.TransactionStatus == transaction.Completed &&
+			cmd.SenderBank == tx.SenderBank &&
+			cmd.ReceiverBank == tx.ReceiverBank &&
+			cmd.Amount == tx.Amount &&
+			cmd.Reason == "scam"
+	})
+
+	testCases := []struct {
+		description string
+		ctx         context.Context
+		request     *proto.OpenRequest
+		findErr     error
+		find        bool
+		cmdErr      error
+		cmd         bool
+		code        codes.Code
+	}{
+		{"valid", ctx, valid, nil, true, nil, true, codes.OK},
+		{"invalid", ctxWithLocale, invalid, nil, false, nil, false, codes.InvalidArgument},
+		{"transaction not found", ctx, valid, &repositories.NotFoundError{}, true,
+			nil, false, codes.NotFound},
+		{"not the sender", ctx, valid, nil, true,
+			&aggregates.InvariantViolation{infraction.ErrCannotOpenIfNotTheSender}, true,
+			codes.PermissionDenied},
+		{"already opened", ctx, valid, nil, true,
+			&aggregates.InvariantViolation{infraction.ErrAlreadyOpened}, true, codes.Aborted},
+		{"unauthenticated", context.Background(), valid, nil, false,
+			nil, false, codes.Unauthenticated},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			if tc.find && tc.findErr != nil {
+				txReadRepo.On("Find", mock.Anything, tx.ID).Return(nil, tc.findErr).Once()
+			} else if tc.find {
+				txReadRepo.On("Find", mock.Anything, tx.ID).Return(tx, nil).Once()
+			}
+			if tc.cmd {
+				commandHandler.On("HandleCommand", mock.Anything, opened).
+					Return(tc.cmdErr).Once()
+			}
+
+			reply, err := client.Open(tc.ctx, tc.request)
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.code.String(), status.Code().String())
+			if tc.code == codes.OK {
+				require.NotNil(t, reply)
+				ID := infraction.ID(tx.ID)
+				assert.Equal(t, ID[:], reply.Id)
+			}
+		})
+	}
+}
+
+func TestClose(t *testing.T) {
+	client, commandHandler, readRepo, txReadRepo := infractiontest.WriteServiceWithMocks()
+
+	ID := uuid.New()
+	bankID := uuid.New()
+	ctx := bankapitest.AuthenticatedContext(context.Background(), bankID)
+
+	report := &repository.Report{ID: ID, TransactionID: uuid.New(), Amount: 100}
+	tx := &txrepository.Transaction{ID: report.TransactionID, Amount: 100,
+		Refunds: []txrepository.Refund{
+			{Amount: 20, Status: transaction.RefundCompleted},
+			{Amount: 30, Status: transaction.RefundFailed},
+		},
+	}
+	readRepo.On("Find", mock.Anything, ID).Return(report, nil).Times(3)
+	txReadRepo.On("Find", mock.Anything, tx.ID).Return(tx, nil).Times(3)
+
+	testCases := []struct {
+		description string
+		call        func() (*proto.Updated, error)
+		cmd         any
+		cmdErr      error
+		code        codes.Code
+	}{
+		{"acknowledge", func() (*proto.Updated, error) {
+			return client.Acknowledge(ctx, &proto.AcknowledgeRequest{Id: ID[:]})
+		}, infraction.Acknowledge{ID, bankID}, nil, codes.OK},
+		{"accept with refund", func() (*proto.Updated, error) {
+			return client.Accept(ctx, &proto.AcceptRequest{
+				Id: ID[:], Analysis: "scam", RefundAmount: 80,
+			})
+		}, infraction.Accept{ID, bankID, "scam", 80, 80}, nil, codes.OK},
+		{"accept without refund", func() (*proto.Updated, error) {
+			return client.Accept(ctx, &proto.AcceptRequest{Id: ID[:]})
+		}, infraction.Accept{ID, bankID, "", 0, 0}, nil, codes.OK},
+		{"reject", func() (*proto.Updated, error) {
+			return client.Reject(ctx, &proto.RejectRequest{Id: ID[:], Analysis: "no scam"})
+		}, infraction.Reject{ID, bankID, "no scam"}, nil, codes.OK},
+		{"not the receiver", func() (*proto.Updated, error) {
+			return client.Reject(ctx, &proto.RejectRequest{Id: ID[:]})
+		}, infraction.Reject{ID, bankID, ""},
+			&aggregates.InvariantViolation{infraction.ErrCannotCloseIfNotTheReceiver},
+			codes.PermissionDenied},
+		{"refund exceeded", func() (*proto.Updated, error) {
+			return client.Accept(ctx, &proto.AcceptRequest{Id: ID[:], RefundAmount: 101})
+		}, infraction.Accept{ID, bankID, "", 101, 80},
+			&aggregates.InvariantViolation{infraction.ErrRefundAmountExceeded},
+			codes.FailedPrecondition},
+		{"partly refunded", func() (*proto.Updated, error) {
+			return client.Accept(ctx, &proto.AcceptRequest{Id: ID[:], RefundAmount: 81})
+		}, infraction.Accept{ID, bankID, "", 81, 80},
+			&aggregates.InvariantViolation{infraction.ErrRefundAmountExceeded},
+			codes.FailedPrecondition},
+		{"invalid", func() (*proto.Updated, error) {
+			return client.Acknowledge(ctx, &proto.AcknowledgeRequest{Id: []byte("short")})
+		}, nil, nil, codes.InvalidArgument},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			if tc.cmd != nil {
+				commandHandler.On("HandleCommand", mock.Anything, tc.cmd).
+					Return(tc.cmdErr).Once()
+			}
+
+			reply, err := tc.call()
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.code.String(), status.Code().String())
+			if tc.code == codes.OK {
+				require.NotNil(t, reply)
+				assert.Equal(t, ID[:], reply.Id)
+			}
+		})
+	}
+	commandHandler.AssertExpectations(t)
+	readRepo.AssertExpectations(t)
+	txReadRepo.AssertExpectations(t)
+}
@@ -1,5 +1,7 @@
 package aggregates
 
+import "fmt"
+
 type InvariantViolation struct {
 	Err error
 }
@@ -23,3 +25,23 @@ type PermissionError struct {
 func (e *PermissionError) Error() string {
 	return "permission error: " + e.Message
 }
+
+type AmountError struct {
+	Message string
+}
+
+func (e *AmountError) Error() string {
+	return "amount error: " + e.Message
+}
+
+// LimitError is raised when an amount would exceed one of the limits set to an account.
+type LimitError struct {
+	Limit   string
+	Maximum uint64
+	Used    uint64
+	Amount  uint64
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("limit error: %s limit of %d exceeded", e.Limit, e.Maximum)
+}
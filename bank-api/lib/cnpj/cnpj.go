@@ -0,0 +1,46 @@
+// Package cnpj validates CNPJ numbers the way go-cpf validates CPF numbers.
+package cnpj
+
+import (
+	"regexp"
+)
+
+var nonDigits = regexp.MustCompile(`\D`)
+
+// IsValid checks the length and both check digits of a CNPJ number, masked or not.
+func IsValid(n string) bool {
+	u := Unmask(n)
+	if len(u) != 14 {
+		return false
+	}
+	ds := make([]int, 14)
+	same := true
+	for i, r := range u {
+		ds[i] = int(r - '0')
+		same = same && u[i] == u[0]
+	}
+	// if all digits are the same, the CNPJ is not valid
+	if same {
+		return false
+	}
+	return checksum(ds[:12]) == ds[12] && checksum(ds[:13]) == ds[13]
+}
+
+// checksum weighs the digits from right to left with 2 to 9, starting over after 9.
+func checksum(ds []int) int {
+	s := 0
+	for i := range ds {
+		weight := 2 + i%8
+		s += ds[len(ds)-1-i] * weight
+	}
+	r := s % 11
+	if r < 2 {
+		return 0
+	}
+	return 11 - r
+}
+
+// Unmask removes any non-digit from the CNPJ number.
+func Unmask(n string) string {
+	return nonDigits.ReplaceAllString(n, "")
+}
@@ -0,0 +1,35 @@
+package cnpj_test
+
+import (
+	"codepix/bank-api/lib/cnpj"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValid(t *testing.T) {
+	testCases := []struct {
+		cnpj  string
+		valid bool
+	}{
+		{"11222333000181", true},
+		{"11.222.333/0001-81", true},
+		{"11.444.777/0001-61", true},
+		{"33000167000101", true},
+
+		{"", false},
+		{"11222333000182", false},
+		{"11222333000191", false},
+		{"1122233300018", false},
+		{"112223330001811", false},
+		{"00000000000000", false},
+		{"11111111111111", false},
+		{"AB.CDE.FGH/IJKL-MN", false},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			assert.Equal(t, tc.valid, cnpj.IsValid(tc.cnpj))
+		})
+	}
+}
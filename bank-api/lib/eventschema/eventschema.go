@@ -0,0 +1,54 @@
+package eventschema
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/looplab/eventhorizon"
+)
+
+// Upcaster migrates the payload of an event from one schema version to the next. The
+// payload holds the fields by their serialized names.
+type Upcaster func(data map[string]any) error
+
+var (
+	upcasters   = map[eventhorizon.EventType][]Upcaster{}
+	upcastersMu sync.RWMutex
+)
+
+// Register sets the upcasters of an event type, oldest first. Payloads written before
+// versioning existed are version 1, so the current version of an event type is one more
+// than its number of upcasters.
+func Register(eventType eventhorizon.EventType, eventUpcasters ...Upcaster) {
+	upcastersMu.Lock()
+	defer upcastersMu.Unlock()
+	upcasters[eventType] = eventUpcasters
+}
+
+func Current(eventType eventhorizon.EventType) int {
+	upcastersMu.RLock()
+	defer upcastersMu.RUnlock()
+	return len(upcasters[eventType]) + 1
+}
+
+// Upcast migrates a payload of the given version to the current version. A version
+// lower than 1 is treated as 1.
+func Upcast(eventType eventhorizon.EventType, version int, data map[string]any) error {
+	upcastersMu.RLock()
+	eventUpcasters := upcasters[eventType]
+	upcastersMu.RUnlock()
+
+	if version < 1 {
+		version = 1
+	}
+	if version > len(eventUpcasters)+1 {
+		return fmt.Errorf("upcast %s: unknown schema version %d", eventType, version)
+	}
+	for i, upcaster := range eventUpcasters[version-1:] {
+		err := upcaster(data)
+		if err != nil {
+			return fmt.Errorf("upcast %s from version %d: %w", eventType, version+i, err)
+		}
+	}
+	return nil
+}
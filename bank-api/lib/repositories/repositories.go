@@ -20,6 +20,15 @@ func (e AlreadyExistsError) Error() string {
 	return e.What + " already exists"
 }
 
+type ConflictError struct {
+	What   string
+	Reason string
+}
+
+func (e ConflictError) Error() string {
+	return e.What + " conflict: " + e.Reason
+}
+
 type InternalError struct {
 	Operation  string
 	Collection string
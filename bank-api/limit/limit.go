@@ -0,0 +1,126 @@
+// Package limit caps how much an account may send. Limits are set by each bank for its
+// accounts, with overrides for single accounts, and are checked when a transaction starts.
+package limit
+
+import (
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/transaction"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	TransactionLimit      = "transaction"
+	DailyLimit            = "daily"
+	NightTransactionLimit = "night_transaction"
+	NightlyLimit          = "nightly"
+)
+
+// Limits are maximum amounts, where 0 is no limit. Nighttime limits apply on top of the
+// others at night.
+type Limits struct {
+	TransactionAmount      transaction.Amount
+	DailyAmount            transaction.Amount
+	NightTransactionAmount transaction.Amount
+	NightlyAmount          transaction.Amount
+}
+
+// Override returns the limits with the ones set by the override replacing them.
+func (l Limits) Override(override Limits) Limits {
+	replace := func(limit *transaction.Amount, override transaction.Amount) {
+		if override != 0 {
+			*limit = override
+		}
+	}
+	replace(&l.TransactionAmount, override.TransactionAmount)
+	replace(&l.DailyAmount, override.DailyAmount)
+	replace(&l.NightTransactionAmount, override.NightTransactionAmount)
+	replace(&l.NightlyAmount, override.NightlyAmount)
+	return l
+}
+
+// Used is what an account already sent in the current day and night.
+type Used struct {
+	Daily   transaction.Amount
+	Nightly transaction.Amount
+}
+
+// Check fails with an invariant violation naming the first limit the amount exceeds.
+func (l Limits) Check(amount transaction.Amount, used Used, night bool) error {
+	type check struct {
+		limit   string
+		maximum transaction.Amount
+		used    transaction.Amount
+	}
+	checks := []check{
+		{TransactionLimit, l.TransactionAmount, 0},
+		{DailyLimit, l.DailyAmount, used.Daily},
+	}
+	if night {
+		checks = append(checks,
+			check{NightTransactionLimit, l.NightTransactionAmount, 0},
+			check{NightlyLimit, l.NightlyAmount, used.Nightly},
+		)
+	}
+	for _, check := range checks {
+		// compared without adding, so huge amounts cannot wrap around the maximum
+		if check.maximum != 0 &&
+			(check.used > check.maximum || amount > check.maximum-check.used) {
+			return &aggregates.InvariantViolation{&aggregates.LimitError{
+				Limit:   check.limit,
+				Maximum: check.maximum,
+				Used:    check.used,
+				Amount:  amount,
+			}}
+		}
+	}
+	return nil
+}
+
+// Clock tells the day and night a time is in. Nights start in one day and end in the next
+// if NightStart is after NightEnd.
+type Clock struct {
+	NightStart time.Duration
+	NightEnd   time.Duration
+	Zone       *time.Location
+}
+
+// Period is when the day, and the night if any, that a time is in started.
+type Period struct {
+	DayStart   time.Time
+	NightStart time.Time
+}
+
+func (p Period) Night() bool {
+	return !p.NightStart.IsZero()
+}
+
+func (c Clock) Period(t time.Time) Period {
+	local := t.In(c.Zone)
+	dayStart := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.Zone)
+	sinceDayStart := local.Sub(dayStart)
+	period := Period{DayStart: dayStart}
+
+	switch {
+	case c.NightStart == c.NightEnd:
+	case c.NightStart < c.NightEnd:
+		if sinceDayStart >= c.NightStart && sinceDayStart < c.NightEnd {
+			period.NightStart = dayStart.Add(c.NightStart)
+		}
+	case sinceDayStart >= c.NightStart:
+		period.NightStart = dayStart.Add(c.NightStart)
+	case sinceDayStart < c.NightEnd:
+		period.NightStart = dayStart.AddDate(0, 0, -1).Add(c.NightStart)
+	}
+	return period
+}
+
+// Usage is the amount an account sent in a transaction.
+type Usage struct {
+	TransactionID uuid.UUID
+	BankID        uuid.UUID
+	AccountID     uuid.UUID
+	Amount        transaction.Amount
+	UsedAt        time.Time
+}
@@ -0,0 +1,90 @@
+package limit_test
+
+import (
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/limit"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverride(t *testing.T) {
+	bank := limit.Limits{100, 300, 50, 80}
+	account := limit.Limits{DailyAmount: 500, NightlyAmount: 60}
+	assert.Equal(t, limit.Limits{100, 500, 50, 60}, bank.Override(account))
+	assert.Equal(t, bank, bank.Override(limit.Limits{}))
+}
+
+func TestCheck(t *testing.T) {
+	limits := limit.Limits{100, 300, 50, 80}
+	exceeded := func(name string, maximum, used, amount uint64) error {
+		return &aggregates.InvariantViolation{&aggregates.LimitError{name, maximum, used, amount}}
+	}
+
+	testCases := []struct {
+		description string
+		limits      limit.Limits
+		amount      uint64
+		used        limit.Used
+		night       bool
+		err         error
+	}{
+		{"within limits", limits, 100, limit.Used{Daily: 200}, false, nil},
+		{"no limits", limit.Limits{}, 1000, limit.Used{1000, 1000}, true, nil},
+		{"transaction", limits, 101, limit.Used{}, false,
+			exceeded(limit.TransactionLimit, 100, 0, 101)},
+		{"daily", limits, 60, limit.Used{Daily: 250}, false,
+			exceeded(limit.DailyLimit, 300, 250, 60)},
+		{"night limits at day", limits, 60, limit.Used{Nightly: 80}, false, nil},
+		{"night transaction", limits, 60, limit.Used{}, true,
+			exceeded(limit.NightTransactionLimit, 50, 0, 60)},
+		{"nightly", limits, 41, limit.Used{Daily: 40, Nightly: 40}, true,
+			exceeded(limit.NightlyLimit, 80, 40, 41)},
+		{"overflowing amount", limit.Limits{DailyAmount: 300}, math.MaxUint64 - 100,
+			limit.Used{Daily: 250}, false,
+			exceeded(limit.DailyLimit, 300, 250, math.MaxUint64-100)},
+		{"used above a lowered limit", limit.Limits{DailyAmount: 300}, 1,
+			limit.Used{Daily: 350}, false, exceeded(limit.DailyLimit, 300, 350, 1)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := tc.limits.Check(tc.amount, tc.used, tc.night)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestPeriod(t *testing.T) {
+	zone := time.FixedZone("", -3*60*60)
+	at := func(day, hour int) time.Time {
+		return time.Date(2022, 1, day, hour, 0, 0, 0, zone)
+	}
+	night := limit.Clock{20 * time.Hour, 6 * time.Hour, zone}
+	morning := limit.Clock{time.Hour, 5 * time.Hour, zone}
+
+	testCases := []struct {
+		description string
+		clock       limit.Clock
+		time        time.Time
+		period      limit.Period
+	}{
+		{"day", night, at(2, 12), limit.Period{at(2, 0), time.Time{}}},
+		{"evening", night, at(2, 21), limit.Period{at(2, 0), at(2, 20)}},
+		{"after midnight", night, at(3, 2), limit.Period{at(3, 0), at(2, 20)}},
+		{"night end", night, at(3, 6), limit.Period{at(3, 0), time.Time{}}},
+		{"other zone", night, at(2, 21).UTC(), limit.Period{at(2, 0), at(2, 20)}},
+		{"within day", morning, at(2, 3), limit.Period{at(2, 0), at(2, 1)}},
+		{"before night", morning, at(2, 0), limit.Period{at(2, 0), time.Time{}}},
+		{"no night", limit.Clock{Zone: zone}, at(2, 21), limit.Period{at(2, 0), time.Time{}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			period := tc.clock.Period(tc.time)
+			assert.True(t, tc.period.DayStart.Equal(period.DayStart), period)
+			assert.True(t, tc.period.NightStart.Equal(period.NightStart), period)
+			assert.Equal(t, tc.period.Night(), period.Night())
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package limit
+
+import (
+	"codepix/bank-api/lib/repositories"
+	"codepix/bank-api/transaction"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+// Usages is the part of the limits repository the limiter needs.
+type Usages interface {
+	Use(usage Usage, period Period, check func(bank, account Limits, used Used) error) error
+	Release(transactionID uuid.UUID) error
+}
+
+// Limiter checks the limits of the sender account before a transaction is started, and
+// records what it sent in the same database transaction. The usage is released if the
+// command fails.
+func Limiter(logger logr.Logger, usages Usages, clock Clock,
+) eventhorizon.CommandHandlerMiddleware {
+	return func(h eventhorizon.CommandHandler) eventhorizon.CommandHandler {
+		return eventhorizon.CommandHandlerFunc(func(ctx context.Context,
+			cmd eventhorizon.Command,
+		) error {
+			start, ok := cmd.(transaction.Start)
+			if !ok {
+				return h.HandleCommand(ctx, cmd)
+			}
+			now := time.Now()
+			period := clock.Period(now)
+			usage := Usage{
+				TransactionID: start.ID,
+				BankID:        start.SenderBank,
+				AccountID:     start.Sender,
+				Amount:        start.Amount,
+				UsedAt:        now,
+			}
+			err := usages.Use(usage, period, func(bank, account Limits, used Used) error {
+				return bank.Override(account).Check(start.Amount, used, period.Night())
+			})
+			// the transaction was already recorded, so the command is a retry
+			alreadyExists := &repositories.AlreadyExistsError{}
+			if errors.As(err, &alreadyExists) {
+				return h.HandleCommand(ctx, cmd)
+			}
+			if err != nil {
+				return err
+			}
+			err = h.HandleCommand(ctx, cmd)
+			if err != nil {
+				releaseErr := usages.Release(start.ID)
+				if releaseErr != nil {
+					logger.Error(releaseErr, "fail: release limit usage", "tx", start.ID)
+				}
+				return err
+			}
+			return nil
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package limit_test
+
+import (
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/lib/repositories"
+	"codepix/bank-api/limit"
+	"codepix/bank-api/limit/limittest"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/transactiontest"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLimiter(t *testing.T) {
+	ctx := context.Background()
+	rejected := &aggregates.InvariantViolation{transaction.ErrCannotStartIfNotTheSender}
+
+	start := transactiontest.ValidStartCommand(uuid.New())
+	exceeded := &aggregates.InvariantViolation{&aggregates.LimitError{
+		limit.TransactionLimit, start.Amount - 1, 0, start.Amount,
+	}}
+	usage := mock.MatchedBy(func(usage limit.Usage) bool {
+		return usage.TransactionID == start.ID && usage.BankID == start.SenderBank &&
+			usage.AccountID == start.Sender && usage.Amount == start.Amount
+	})
+	within := limit.Limits{TransactionAmount: start.Amount}
+	below := limit.Limits{TransactionAmount: start.Amount - 1}
+
+	testCases := []struct {
+		description string
+		cmd         eventhorizon.Command
+		bank        limit.Limits
+		account     limit.Limits
+		useErr      error
+		handleErr   error
+		release     bool
+		releaseErr  error
+		err         error
+	}{
+		{"within limits", start, within, limit.Limits{}, nil, nil, false, nil, nil},
+		{"other command", transactiontest.ValidConfirmCommand(start.ID),
+			limit.Limits{}, limit.Limits{}, nil, nil, false, nil, nil},
+		{"exceeded", start, below, limit.Limits{}, nil, nil, false, nil, exceeded},
+		{"overridden", start, below, within, nil, nil, false, nil, nil},
+		{"retry", start, limit.Limits{}, limit.Limits{},
+			&repositories.AlreadyExistsError{}, rejected, false, nil, rejected},
+		{"rejected", start, within, limit.Limits{}, nil, rejected, true, nil, rejected},
+		{"release failed", start, within, limit.Limits{}, nil, rejected, true,
+			errors.New("some error"), rejected},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			repo := &limittest.MockRepo{}
+			commandHandler := &transactiontest.MockCommandHandler{}
+			clock := limit.Clock{Zone: time.UTC}
+			handler := eventhorizon.UseCommandHandlerMiddleware(commandHandler,
+				limit.Limiter(bankapitest.Logger, repo, clock))
+
+			_, isStart := tc.cmd.(transaction.Start)
+			if isStart {
+				repo.On("Use", usage, mock.Anything).
+					Return(tc.bank, tc.account, limit.Used{}, tc.useErr).Once()
+			}
+			if tc.err != exceeded {
+				commandHandler.On("HandleCommand", mock.Anything, tc.cmd).
+					Return(tc.handleErr).Once()
+			}
+			if tc.release {
+				repo.On("Release", start.ID).Return(tc.releaseErr).Once()
+			}
+
+			err := handler.HandleCommand(ctx, tc.cmd)
+
+			assert.Equal(t, tc.err, err)
+			repo.AssertExpectations(t)
+			commandHandler.AssertExpectations(t)
+			if !tc.release {
+				repo.AssertNotCalled(t, "Release", mock.Anything)
+			}
+		})
+	}
+}
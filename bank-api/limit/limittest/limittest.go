@@ -0,0 +1,24 @@
+package limittest
+
+import (
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/limit/service"
+	proto "codepix/bank-api/proto/codepix/limit"
+)
+
+func ServiceWithMocks() (proto.ServiceClient, *MockRepo) {
+	validator, err := validator.New()
+	if err != nil {
+		panic(err)
+	}
+	server, client, serve := bankapitest.Server(validator)
+	repo := new(MockRepo)
+
+	err = service.Register(server, validator, repo)
+	if err != nil {
+		panic(err)
+	}
+	serve()
+	return proto.NewServiceClient(client), repo
+}
@@ -0,0 +1,51 @@
+package limittest
+
+import (
+	"codepix/bank-api/limit"
+	"codepix/bank-api/limit/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepo struct {
+	mock.Mock
+}
+
+var _ repository.Repository = MockRepo{}
+
+func (m MockRepo) Find(bankID, accountID uuid.UUID) (*limit.Limits, error) {
+	args := m.Called(bankID, accountID)
+	return get[*limit.Limits](args, 0), get[error](args, 1)
+}
+
+func (m MockRepo) Save(bankID, accountID uuid.UUID, limits limit.Limits) error {
+	args := m.Called(bankID, accountID, limits)
+	return get[error](args, 0)
+}
+
+// Use returns the bank limits, account limits, usage and error to run check with. Check is
+// not run if the error is not nil.
+func (m MockRepo) Use(usage limit.Usage, period limit.Period,
+	check func(bank, account limit.Limits, used limit.Used) error,
+) error {
+	args := m.Called(usage, period)
+	err := get[error](args, 3)
+	if err != nil {
+		return err
+	}
+	return check(get[limit.Limits](args, 0), get[limit.Limits](args, 1),
+		get[limit.Used](args, 2))
+}
+
+func (m MockRepo) Release(transactionID uuid.UUID) error {
+	args := m.Called(transactionID)
+	return get[error](args, 0)
+}
+
+func get[T any](args mock.Arguments, index int) T {
+	if args[index] == nil {
+		return *new(T)
+	}
+	return args[index].(T)
+}
@@ -0,0 +1,38 @@
+package limit
+
+import (
+	"codepix/bank-api/adapters/eventhandler"
+	"codepix/bank-api/config"
+	"codepix/bank-api/transaction"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+)
+
+// Setup releases the usage of failed transactions, and returns the limiter to handle
+// transaction commands with.
+func Setup(logger logr.Logger, config config.Config, outbox eventhorizon.Outbox,
+	usages Usages,
+) (eventhorizon.CommandHandlerMiddleware, error) {
+	releaser := Releaser{Usages: usages}
+	err := outbox.AddHandler(context.Background(),
+		eventhorizon.MatchEvents{transaction.FailedEvent},
+		eventhandler.Named(
+			eventhandler.Logger(logger, releaser),
+			releaser.HandlerType(),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("setup limits: %w", err)
+	}
+	logger = logger.WithName("limit")
+	clock := Clock{
+		NightStart: config.Limits.NightStart,
+		NightEnd:   config.Limits.NightEnd,
+		Zone:       time.FixedZone("", int(config.Limits.UTCOffset.Seconds())),
+	}
+	return Limiter(logger, usages, clock), nil
+}
@@ -0,0 +1,27 @@
+package limit
+
+import (
+	"codepix/bank-api/transaction"
+	"context"
+
+	"github.com/looplab/eventhorizon"
+)
+
+// Releaser releases the usage of failed transactions, so they do not count against the
+// limits of their sender.
+type Releaser struct {
+	Usages Usages
+}
+
+var _ eventhorizon.EventHandler = Releaser{}
+
+func (r Releaser) HandlerType() eventhorizon.EventHandlerType {
+	return eventhorizon.EventHandlerType("limit")
+}
+
+func (r Releaser) HandleEvent(ctx context.Context, event eventhorizon.Event) error {
+	if _, ok := event.Data().(*transaction.TransactionFailed); !ok {
+		return nil
+	}
+	return r.Usages.Release(event.AggregateID())
+}
@@ -0,0 +1,167 @@
+package database
+
+import (
+	"codepix/bank-api/adapters/databaseclient"
+	"codepix/bank-api/limit"
+	"codepix/bank-api/limit/repository"
+	"codepix/bank-api/transaction"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Database struct {
+	*databaseclient.Database
+}
+
+var _ repository.Repository = Database{}
+
+func (db Database) Find(bankID, accountID uuid.UUID) (*limit.Limits, error) {
+	limits, err := find(db.DB, bankID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	return &limits, nil
+}
+
+func (db Database) Save(bankID, accountID uuid.UUID, limits limit.Limits) error {
+	tx := db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "bank_id"}, {Name: "account_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"updated_at",
+			"transaction_amount",
+			"daily_amount",
+			"night_transaction_amount",
+			"nightly_amount",
+		}),
+	}).Create(NewLimitSetting(bankID, accountID, limits))
+	return databaseclient.MapError(tx)
+}
+
+func (db Database) Use(usage limit.Usage, period limit.Period,
+	check func(bank, account limit.Limits, used limit.Used) error,
+) error {
+	return db.Transaction(func(db *gorm.DB) error {
+		// touching the setting of the account locks it until the usage is recorded
+		tx := db.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "bank_id"}, {Name: "account_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"updated_at"}),
+		}).Create(NewLimitSetting(usage.BankID, usage.AccountID, limit.Limits{}))
+		if tx.Error != nil {
+			return databaseclient.MapError(tx)
+		}
+		bank, err := find(db, usage.BankID, uuid.Nil)
+		if err != nil {
+			return err
+		}
+		account, err := find(db, usage.BankID, usage.AccountID)
+		if err != nil {
+			return err
+		}
+		var used limit.Used
+		used.Daily, err = sum(db, usage, period.DayStart)
+		if err != nil {
+			return err
+		}
+		if period.Night() {
+			used.Nightly, err = sum(db, usage, period.NightStart)
+			if err != nil {
+				return err
+			}
+		}
+		err = check(bank, account, used)
+		if err != nil {
+			return err
+		}
+		tx = db.Create(NewLimitUsage(usage))
+		return databaseclient.MapError(tx)
+	})
+}
+
+func (db Database) Release(transactionID uuid.UUID) error {
+	tx := db.Delete(&LimitUsage{}, "id = ?", transactionID)
+	return databaseclient.MapError(tx)
+}
+
+func find(db *gorm.DB, bankID, accountID uuid.UUID) (limit.Limits, error) {
+	var settings []LimitSetting
+	tx := db.Where("bank_id = ? and account_id = ?", bankID, accountID).
+		Limit(1).Find(&settings)
+	if tx.Error != nil {
+		return limit.Limits{}, databaseclient.MapError(tx)
+	}
+	if len(settings) == 0 {
+		return limit.Limits{}, nil
+	}
+	return LimitSettingFromDB(settings[0]), nil
+}
+
+// sum returns what the account of the usage sent since a time.
+func sum(db *gorm.DB, usage limit.Usage, since time.Time) (transaction.Amount, error) {
+	var used transaction.Amount
+	tx := db.Model(&LimitUsage{}).
+		Select("coalesce(sum(amount), 0)").
+		Where("bank_id = ? and account_id = ? and used_at >= ?",
+			usage.BankID, usage.AccountID, since).
+		Scan(&used)
+	if tx.Error != nil {
+		return 0, databaseclient.MapError(tx)
+	}
+	return used, nil
+}
+
+// LimitSetting holds the limits of a bank with a nil account ID, or the ones set to an
+// account of it.
+type LimitSetting struct {
+	databaseclient.BaseModel
+	BankID                 uuid.UUID          `gorm:"<-:create;uniqueIndex:idx_limit_settings_account"`
+	AccountID              uuid.UUID          `gorm:"<-:create;uniqueIndex:idx_limit_settings_account"`
+	TransactionAmount      transaction.Amount `gorm:"not null"`
+	DailyAmount            transaction.Amount `gorm:"not null"`
+	NightTransactionAmount transaction.Amount `gorm:"not null"`
+	NightlyAmount          transaction.Amount `gorm:"not null"`
+}
+
+func NewLimitSetting(bankID, accountID uuid.UUID, limits limit.Limits) *LimitSetting {
+	return &LimitSetting{
+		BaseModel:              databaseclient.NewBaseModel(),
+		BankID:                 bankID,
+		AccountID:              accountID,
+		TransactionAmount:      limits.TransactionAmount,
+		DailyAmount:            limits.DailyAmount,
+		NightTransactionAmount: limits.NightTransactionAmount,
+		NightlyAmount:          limits.NightlyAmount,
+	}
+}
+
+func LimitSettingFromDB(dbSetting LimitSetting) limit.Limits {
+	return limit.Limits{
+		TransactionAmount:      dbSetting.TransactionAmount,
+		DailyAmount:            dbSetting.DailyAmount,
+		NightTransactionAmount: dbSetting.NightTransactionAmount,
+		NightlyAmount:          dbSetting.NightlyAmount,
+	}
+}
+
+// LimitUsage is identified by the transaction it was used by.
+type LimitUsage struct {
+	databaseclient.BaseModel
+	BankID    uuid.UUID          `gorm:"<-:create;index:idx_limit_usages_account"`
+	AccountID uuid.UUID          `gorm:"<-:create;index:idx_limit_usages_account"`
+	Amount    transaction.Amount `gorm:"<-:create"`
+	UsedAt    time.Time          `gorm:"<-:create;index:idx_limit_usages_account"`
+}
+
+func NewLimitUsage(usage limit.Usage) *LimitUsage {
+	baseModel := databaseclient.NewBaseModel()
+	baseModel.ID = usage.TransactionID
+	return &LimitUsage{
+		BaseModel: baseModel,
+		BankID:    usage.BankID,
+		AccountID: usage.AccountID,
+		Amount:    usage.Amount,
+		UsedAt:    usage.UsedAt,
+	}
+}
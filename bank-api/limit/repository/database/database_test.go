@@ -0,0 +1,109 @@
+package database_test
+
+import (
+	"codepix/bank-api/adapters/databaseclient"
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/lib/repositories"
+	"codepix/bank-api/limit"
+	"codepix/bank-api/limit/repository"
+	"codepix/bank-api/limit/repository/database"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Repo() repository.Repository {
+	client, err := databaseclient.Open(bankapitest.Config, bankapitest.Logger)
+	if err != nil {
+		panic(err)
+	}
+	err = client.AutoMigrate(
+		&database.LimitSetting{},
+		&database.LimitUsage{},
+	)
+	if err != nil {
+		panic(err)
+	}
+	return &database.Database{Database: client}
+}
+
+func TestLimits(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	repo := Repo()
+	bankID, accountID := uuid.New(), uuid.New()
+
+	found, err := repo.Find(bankID, uuid.Nil)
+	require.NoError(t, err)
+	assert.Equal(t, limit.Limits{}, *found)
+
+	bankLimits := limit.Limits{100, 300, 50, 80}
+	require.NoError(t, repo.Save(bankID, uuid.Nil, bankLimits))
+	accountLimits := limit.Limits{DailyAmount: 500}
+	require.NoError(t, repo.Save(bankID, accountID, accountLimits))
+	accountLimits.DailyAmount = 400
+	require.NoError(t, repo.Save(bankID, accountID, accountLimits))
+
+	found, err = repo.Find(bankID, uuid.Nil)
+	require.NoError(t, err)
+	assert.Equal(t, bankLimits, *found)
+	found, err = repo.Find(bankID, accountID)
+	require.NoError(t, err)
+	assert.Equal(t, accountLimits, *found)
+}
+
+func TestUse(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	repo := Repo()
+	bankID, accountID := uuid.New(), uuid.New()
+	bankLimits := limit.Limits{100, 300, 50, 80}
+	require.NoError(t, repo.Save(bankID, uuid.Nil, bankLimits))
+
+	dayStart := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := limit.Period{DayStart: dayStart}
+	night := limit.Period{DayStart: dayStart, NightStart: dayStart.Add(20 * time.Hour)}
+	usage := func(amount uint64, usedAt time.Time) limit.Usage {
+		return limit.Usage{uuid.New(), bankID, accountID, amount, usedAt}
+	}
+	var lastUsed limit.Used
+	check := func(bank, account limit.Limits, used limit.Used) error {
+		assert.Equal(t, bankLimits, bank)
+		assert.Equal(t, limit.Limits{}, account)
+		lastUsed = used
+		return nil
+	}
+
+	// usage of the day before is not counted
+	require.NoError(t, repo.Use(usage(70, dayStart.Add(-time.Hour)), day, check))
+	first := usage(40, dayStart.Add(time.Hour))
+	require.NoError(t, repo.Use(first, day, check))
+	assert.Equal(t, limit.Used{}, lastUsed)
+	require.NoError(t, repo.Use(usage(30, dayStart.Add(21*time.Hour)), night, check))
+	assert.Equal(t, limit.Used{Daily: 40}, lastUsed)
+	require.NoError(t, repo.Use(usage(10, dayStart.Add(22*time.Hour)), night, check))
+	assert.Equal(t, limit.Used{Daily: 70, Nightly: 30}, lastUsed)
+
+	err := repo.Use(first, day, check)
+	assert.IsType(t, &repositories.AlreadyExistsError{}, err)
+
+	require.NoError(t, repo.Release(first.TransactionID))
+	require.NoError(t, repo.Release(first.TransactionID))
+	require.NoError(t, repo.Use(usage(10, dayStart.Add(23*time.Hour)), night, check))
+	assert.Equal(t, limit.Used{Daily: 40, Nightly: 40}, lastUsed)
+
+	rejection := &aggregates.InvariantViolation{&aggregates.LimitError{}}
+	rejected := usage(20, dayStart.Add(23*time.Hour))
+	err = repo.Use(rejected, night, func(limit.Limits, limit.Limits, limit.Used) error {
+		return rejection
+	})
+	assert.Equal(t, rejection, err)
+	require.NoError(t, repo.Use(usage(1, dayStart.Add(23*time.Hour)), night, check))
+	assert.Equal(t, limit.Used{Daily: 50, Nightly: 50}, lastUsed)
+}
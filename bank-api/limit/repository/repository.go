@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"codepix/bank-api/limit"
+
+	"github.com/google/uuid"
+)
+
+// Repository keeps the limits of banks and accounts. Limits of a bank are set with a nil
+// account ID.
+type Repository interface {
+	// Find returns empty limits if none were set.
+	Find(bankID, accountID uuid.UUID) (*limit.Limits, error)
+	Save(bankID, accountID uuid.UUID, limits limit.Limits) error
+	// Use records the usage once check passes, given the limits of the bank, the ones set
+	// to the account and what the account used in the period. Uses of an account are
+	// serialized, so concurrent transactions cannot exceed a limit together.
+	Use(usage limit.Usage, period limit.Period,
+		check func(bank, account limit.Limits, used limit.Used) error) error
+	// Release forgets the usage of a transaction which failed.
+	Release(transactionID uuid.UUID) error
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"bytes"
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/lib/validation"
+	"codepix/bank-api/limit/repository"
+	proto "codepix/bank-api/proto/codepix/limit"
+	_ "embed"
+
+	"google.golang.org/grpc"
+)
+
+//go:embed translations.json
+var translations []byte
+
+func Register(server *grpc.Server, val *validation.Validator,
+	repository repository.Repository,
+) error {
+	err := validator.LoadTranslationFile(val, bytes.NewReader(translations),
+		proto.FindRequest{},
+		proto.SetRequest{},
+	)
+	if err != nil {
+		return err
+	}
+	service := &Service{Repository: repository}
+	proto.RegisterServiceServer(server, service)
+	return nil
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/limit"
+	"codepix/bank-api/limit/repository"
+	proto "codepix/bank-api/proto/codepix/limit"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	Repository repository.Repository
+	proto.UnimplementedServiceServer
+}
+
+var _ proto.ServiceServer = Service{}
+
+// Find returns only the limits set to the account, not the ones it inherits from the bank.
+func (s Service) Find(ctx context.Context, req *proto.FindRequest) (*proto.Limits, error) {
+	accountID, _ := accountID(req.AccountId)
+
+	limits, err := s.Repository.Find(auth.GetBankID(ctx), accountID)
+	return limitsReply(limits), rpc.MapError(ctx, err)
+}
+
+func (s Service) Set(ctx context.Context, req *proto.SetRequest) (*proto.Limits, error) {
+	accountID, _ := accountID(req.AccountId)
+	limits := limit.Limits{
+		TransactionAmount:      req.Limits.TransactionAmount,
+		DailyAmount:            req.Limits.DailyAmount,
+		NightTransactionAmount: req.Limits.NightTransactionAmount,
+		NightlyAmount:          req.Limits.NightlyAmount,
+	}
+
+	err := s.Repository.Save(auth.GetBankID(ctx), accountID, limits)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	return limitsReply(&limits), nil
+}
+
+func accountID(id []byte) (uuid.UUID, error) {
+	if len(id) == 0 {
+		return uuid.Nil, nil
+	}
+	return uuid.FromBytes(id)
+}
+
+func limitsReply(limits *limit.Limits) *proto.Limits {
+	if limits == nil {
+		return nil
+	}
+	return &proto.Limits{
+		TransactionAmount:      limits.TransactionAmount,
+		DailyAmount:            limits.DailyAmount,
+		NightTransactionAmount: limits.NightTransactionAmount,
+		NightlyAmount:          limits.NightlyAmount,
+	}
+}
@@ -0,0 +1,87 @@
+package service_test
+
+import (
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/limit"
+	"codepix/bank-api/limit/limittest"
+	proto "codepix/bank-api/proto/codepix/limit"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFind(t *testing.T) {
+	client, repo := limittest.ServiceWithMocks()
+	bankID, accountID := uuid.New(), uuid.New()
+	ctx := bankapitest.AuthenticatedContext(context.Background(), bankID)
+
+	testCases := []struct {
+		description string
+		ctx         context.Context
+		accountID   []byte
+		code        codes.Code
+	}{
+		{"bank limits", ctx, nil, codes.OK},
+		{"account limits", ctx, accountID[:], codes.OK},
+		{"invalid ID", ctx, []byte{1}, codes.InvalidArgument},
+		{"unauthenticated", context.Background(), nil, codes.Unauthenticated},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			if tc.code == codes.OK {
+				found := &limit.Limits{TransactionAmount: 100}
+				id, _ := uuid.FromBytes(tc.accountID)
+				repo.On("Find", bankID, id).Return(found, nil).Once()
+			}
+
+			reply, err := client.Find(tc.ctx, &proto.FindRequest{AccountId: tc.accountID})
+
+			assert.Equal(t, tc.code.String(), statusCode(err).String())
+			if tc.code == codes.OK {
+				require.NotNil(t, reply)
+				assert.Equal(t, uint64(100), reply.TransactionAmount)
+			}
+		})
+	}
+	repo.AssertExpectations(t)
+}
+
+func TestSet(t *testing.T) {
+	client, repo := limittest.ServiceWithMocks()
+	bankID, accountID := uuid.New(), uuid.New()
+	ctx := bankapitest.AuthenticatedContext(context.Background(), bankID)
+	limits := &proto.Limits{
+		TransactionAmount:      100,
+		DailyAmount:            300,
+		NightTransactionAmount: 50,
+		NightlyAmount:          80,
+	}
+
+	repo.On("Save", bankID, uuid.Nil, limit.Limits{100, 300, 50, 80}).Return(nil).Once()
+	reply, err := client.Set(ctx, &proto.SetRequest{Limits: limits})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(300), reply.DailyAmount)
+
+	repo.On("Save", bankID, accountID, limit.Limits{DailyAmount: 500}).Return(nil).Once()
+	_, err = client.Set(ctx, &proto.SetRequest{
+		AccountId: accountID[:],
+		Limits:    &proto.Limits{DailyAmount: 500},
+	})
+	require.NoError(t, err)
+
+	_, err = client.Set(ctx, &proto.SetRequest{AccountId: accountID[:]})
+	assert.Equal(t, codes.InvalidArgument.String(), statusCode(err).String())
+
+	repo.AssertExpectations(t)
+}
+
+func statusCode(err error) codes.Code {
+	status, _ := status.FromError(err)
+	return status.Code()
+}
@@ -0,0 +1,65 @@
+package pixkey
+
+import (
+	"github.com/google/uuid"
+	eh "github.com/looplab/eventhorizon"
+)
+
+// AggregateType names the events of keys. Keys are not event sourced: their events are
+// written along with the key changes and published to banks.
+const AggregateType = eh.AggregateType("pixkey")
+
+// PixKeyRegistered is published once a key is active, so pending keys are only published
+// once verified.
+type PixKeyRegistered struct {
+	KeyType   Type      `json:"type" bson:"type"`
+	Key       Key       `json:"key" bson:"key"`
+	AccountID uuid.UUID `json:"account_id" bson:"account_id"`
+	BankID    uuid.UUID `json:"bank_id" bson:"bank_id"`
+}
+
+type PixKeyDeleted struct {
+	Key    Key       `json:"key" bson:"key"`
+	BankID uuid.UUID `json:"bank_id" bson:"bank_id"`
+	Reason string    `json:"reason" bson:"reason"`
+}
+
+type PixKeyBlocked struct {
+	Key    Key       `json:"key" bson:"key"`
+	BankID uuid.UUID `json:"bank_id" bson:"bank_id"`
+}
+
+type PixKeyUnblocked struct {
+	Key    Key       `json:"key" bson:"key"`
+	BankID uuid.UUID `json:"bank_id" bson:"bank_id"`
+}
+
+// PixKeyTransferred moves a key from the donor bank to an account of another bank.
+type PixKeyTransferred struct {
+	Key       Key       `json:"key" bson:"key"`
+	DonorBank uuid.UUID `json:"donor_bank" bson:"donor_bank"`
+	AccountID uuid.UUID `json:"account_id" bson:"account_id"`
+	BankID    uuid.UUID `json:"bank_id" bson:"bank_id"`
+}
+
+const (
+	RegisteredEvent  = eh.EventType(AggregateType + "_registered")
+	DeletedEvent     = eh.EventType(AggregateType + "_deleted")
+	BlockedEvent     = eh.EventType(AggregateType + "_blocked")
+	UnblockedEvent   = eh.EventType(AggregateType + "_unblocked")
+	TransferredEvent = eh.EventType(AggregateType + "_transferred")
+)
+
+func init() {
+	eh.RegisterEventData(RegisteredEvent, func() eh.EventData { return &PixKeyRegistered{} })
+	eh.RegisterEventData(DeletedEvent, func() eh.EventData { return &PixKeyDeleted{} })
+	eh.RegisterEventData(BlockedEvent, func() eh.EventData { return &PixKeyBlocked{} })
+	eh.RegisterEventData(UnblockedEvent, func() eh.EventData { return &PixKeyUnblocked{} })
+	eh.RegisterEventData(TransferredEvent, func() eh.EventData { return &PixKeyTransferred{} })
+}
+
+func (PixKeyRegistered) Type() eh.EventType  { return RegisteredEvent }
+func (PixKeyDeleted) Type() eh.EventType     { return DeletedEvent }
+func (PixKeyBlocked) Type() eh.EventType     { return BlockedEvent }
+func (PixKeyUnblocked) Type() eh.EventType   { return UnblockedEvent }
+func (PixKeyTransferred) Type() eh.EventType { return TransferredEvent }
@@ -0,0 +1,50 @@
+package pixkey
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Masked returns the owner as shown to banks other than the one holding the key. Names of
+// individuals keep their first word and the initials of the others, while company names
+// are public. Documents only keep their middle digits, and are never shown in full.
+func (o Owner) Masked() Owner {
+	masked := Owner{
+		Name:     o.Name,
+		Document: maskDocument(o.Document),
+	}
+	if !o.IsCompany() {
+		masked.Name = maskName(o.Name)
+	}
+	return masked
+}
+
+// maskName leaves out lowercase particles such as "da" or "dos", which have no initial
+// worth showing.
+func maskName(name string) string {
+	words := strings.Fields(name)
+	if len(words) == 0 {
+		return ""
+	}
+	masked := []string{words[0]}
+	for _, word := range words[1:] {
+		if strings.ToLower(word) == word && utf8.RuneCountInString(word) <= 3 {
+			continue
+		}
+		initial, _ := utf8.DecodeRuneInString(word)
+		masked = append(masked, string(unicode.ToUpper(initial))+".")
+	}
+	return strings.Join(masked, " ")
+}
+
+func maskDocument(document string) string {
+	switch len(document) {
+	case 11:
+		return "***." + document[3:6] + "." + document[6:9] + "-**"
+	case 14:
+		return "**." + document[2:5] + "." + document[5:8] + "/" + document[8:12] + "-**"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,46 @@
+package pixkey_test
+
+import (
+	"codepix/bank-api/pixkey"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMasked(t *testing.T) {
+	testCases := []struct {
+		owner  pixkey.Owner
+		masked pixkey.Owner
+	}{
+		{
+			pixkey.Owner{Name: "João Silva", Document: "28556370071"},
+			pixkey.Owner{Name: "João S.", Document: "***.563.700-**"},
+		},
+		{
+			pixkey.Owner{Name: " maria  da Silva dos santos ", Document: "28556370071"},
+			pixkey.Owner{Name: "maria S. S.", Document: "***.563.700-**"},
+		},
+		{
+			pixkey.Owner{Name: "Ana", Document: "28556370071"},
+			pixkey.Owner{Name: "Ana", Document: "***.563.700-**"},
+		},
+		{
+			pixkey.Owner{Name: "Élan Ávila", Document: "28556370071"},
+			pixkey.Owner{Name: "Élan Á.", Document: "***.563.700-**"},
+		},
+		{
+			pixkey.Owner{Name: "Empresa da Silva Ltda", Document: "11222333000181"},
+			pixkey.Owner{Name: "Empresa da Silva Ltda", Document: "**.222.333/0001-**"},
+		},
+		{
+			pixkey.Owner{Name: "", Document: "123"},
+			pixkey.Owner{Name: "", Document: ""},
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			assert.Equal(t, tc.masked, tc.owner.Masked())
+		})
+	}
+}
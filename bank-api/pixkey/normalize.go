@@ -0,0 +1,80 @@
+package pixkey
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/net/idna"
+)
+
+// Normalize returns the canonical form of a key, so a key is stored and looked up the
+// same way however it was written: emails are lowercased with their domain in ASCII,
+// phones only keep the plus sign and digits, CPF and CNPJ keys only keep digits, and
+// random keys are lowercased.
+func Normalize(t Type, key Key) Key {
+	key = strings.TrimSpace(key)
+	switch t {
+	case CPFKey, CNPJKey:
+		return digits(key)
+	case PhoneKey:
+		return "+" + digits(key)
+	case EmailKey:
+		return normalizeEmail(key)
+	case RandomKey:
+		return strings.ToLower(key)
+	default:
+		return key
+	}
+}
+
+// NormalizeDocument only keeps the digits of an owner document, as documents are stored.
+func NormalizeDocument(document string) string {
+	return digits(document)
+}
+
+// TypeOf tells the type of a key from how it is written, for lookups which only have the
+// key. It returns zero when the key cannot be of any type.
+func TypeOf(key Key) Type {
+	key = strings.TrimSpace(key)
+	switch {
+	case strings.Contains(key, "@"):
+		return EmailKey
+	case strings.HasPrefix(key, "+"):
+		return PhoneKey
+	}
+	if _, err := uuid.Parse(key); err == nil && len(key) == 36 {
+		return RandomKey
+	}
+	if strings.Trim(key, "0123456789.-/") != "" {
+		return 0
+	}
+	switch len(digits(key)) {
+	case 11:
+		return CPFKey
+	case 14:
+		return CNPJKey
+	default:
+		return 0
+	}
+}
+
+func normalizeEmail(key Key) Key {
+	at := strings.LastIndex(key, "@")
+	if at < 0 {
+		return strings.ToLower(key)
+	}
+	local, domain := key[:at], key[at+1:]
+	if ascii, err := idna.Lookup.ToASCII(domain); err == nil {
+		domain = ascii
+	}
+	return strings.ToLower(local) + "@" + strings.ToLower(domain)
+}
+
+func digits(key Key) Key {
+	return strings.Map(func(r rune) rune {
+		if r < '0' || r > '9' {
+			return -1
+		}
+		return r
+	}, key)
+}
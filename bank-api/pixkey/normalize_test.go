@@ -0,0 +1,65 @@
+package pixkey_test
+
+import (
+	"codepix/bank-api/pixkey"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		keyType    pixkey.Type
+		key        pixkey.Key
+		normalized pixkey.Key
+	}{
+		{pixkey.EmailKey, "name@domain.com", "name@domain.com"},
+		{pixkey.EmailKey, "Name@Domain.COM", "name@domain.com"},
+		{pixkey.EmailKey, " name@domain.com ", "name@domain.com"},
+		{pixkey.EmailKey, "name@bücher.example", "name@xn--bcher-kva.example"},
+		{pixkey.EmailKey, "Name@BÜCHER.example", "name@xn--bcher-kva.example"},
+		{pixkey.EmailKey, "Name", "name"},
+
+		{pixkey.PhoneKey, "+5511999887766", "+5511999887766"},
+		{pixkey.PhoneKey, "+55 (11) 99988-7766", "+5511999887766"},
+
+		{pixkey.CPFKey, "28556370071", "28556370071"},
+		{pixkey.CPFKey, "285.563.700-71", "28556370071"},
+		{pixkey.CNPJKey, "11.222.333/0001-81", "11222333000181"},
+
+		{pixkey.RandomKey, "4872C870-C94D-490A-A138-596893A415FB",
+			"4872c870-c94d-490a-a138-596893a415fb"},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			normalized := pixkey.Normalize(tc.keyType, tc.key)
+			assert.Equal(t, tc.normalized, normalized)
+			assert.Equal(t, normalized, pixkey.Normalize(tc.keyType, normalized))
+		})
+	}
+}
+
+func TestTypeOf(t *testing.T) {
+	testCases := []struct {
+		key     pixkey.Key
+		keyType pixkey.Type
+	}{
+		{"name@domain.com", pixkey.EmailKey},
+		{"+5511999887766", pixkey.PhoneKey},
+		{"+55 (11) 99988-7766", pixkey.PhoneKey},
+		{"28556370071", pixkey.CPFKey},
+		{"285.563.700-71", pixkey.CPFKey},
+		{"11.222.333/0001-81", pixkey.CNPJKey},
+		{"4872c870-c94d-490a-a138-596893a415fb", pixkey.RandomKey},
+
+		{"", 0},
+		{"123", 0},
+		{"ABC.DEF.GHI-JK", 0},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			assert.Equal(t, tc.keyType, pixkey.TypeOf(tc.key))
+		})
+	}
+}
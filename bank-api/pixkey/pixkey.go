@@ -1,16 +1,67 @@
 package pixkey
 
+import "time"
+
 type Type uint8
 
+// RandomKey is an EVP key: its key is a UUID generated on registration.
 const (
 	CPFKey Type = iota + 1
 	PhoneKey
 	EmailKey
+	CNPJKey
+	RandomKey
 )
 
 type Key = string
 
+// Status is the lifecycle of a key. Only active keys receive transactions. Deleted keys
+// are kept for audit, and their key may be registered again. Pending keys wait for their
+// owner to verify them.
+type Status uint8
+
+const (
+	Active Status = iota + 1
+	Deleted
+	Blocked
+	Pending
+)
+
 type PixKey struct {
-	Type Type
-	Key  Key
+	Type           Type
+	Key            Key
+	Owner          Owner
+	Account        Account
+	Status         Status
+	CreatedAt      time.Time
+	DeletedAt      time.Time
+	DeletionReason string
+}
+
+// Owner holds the key. Document is the CPF of individuals or the CNPJ of companies,
+// stored digits only.
+type Owner struct {
+	Name     string
+	Document string
 }
+
+// IsCompany tells whether the owner is identified by a CNPJ.
+func (o Owner) IsCompany() bool {
+	return len(o.Document) == 14
+}
+
+// Account is the account holding the key, at the bank holding the key.
+type Account struct {
+	Branch string
+	Number string
+	Type   AccountType
+}
+
+type AccountType uint8
+
+const (
+	Checking AccountType = iota + 1
+	Savings
+	Payment
+	Salary
+)
@@ -3,6 +3,7 @@ package pixkeytest
 import (
 	"codepix/bank-api/pixkey"
 	"codepix/bank-api/pixkey/repository"
+	"codepix/bank-api/pixkey/verification"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -14,10 +15,27 @@ type MockRepo struct {
 
 var _ repository.Repository = MockRepo{}
 
-func (m MockRepo) Add(pixKey pixkey.PixKey, accountID, bankID uuid.UUID) (*uuid.UUID, error) {
+func (m MockRepo) Add(pixKey pixkey.PixKey, accountID, bankID uuid.UUID,
+	check repository.Check,
+) (*uuid.UUID, error) {
 	args := m.Called(pixKey, accountID)
 	return get[*uuid.UUID](args, 0), get[error](args, 1)
 }
+func (m MockRepo) AddPending(pixKey pixkey.PixKey, accountID, bankID uuid.UUID,
+	verification verification.Verification, check repository.Check,
+) (*uuid.UUID, error) {
+	args := m.Called(pixKey, accountID)
+	return get[*uuid.UUID](args, 0), get[error](args, 1)
+}
+func (m MockRepo) Attempt(ID, bankID uuid.UUID, maxAttempts uint64,
+) (*verification.Verification, error) {
+	args := m.Called(ID, bankID)
+	return get[*verification.Verification](args, 0), get[error](args, 1)
+}
+func (m MockRepo) Verify(ID, bankID uuid.UUID) error {
+	args := m.Called(ID, bankID)
+	return get[error](args, 0)
+}
 func (m MockRepo) Find(ID uuid.UUID) (*pixkey.PixKey, *repository.IDs, error) {
 	args := m.Called(ID)
 	return get[*pixkey.PixKey](args, 0), get[*repository.IDs](args, 1), get[error](args, 2)
@@ -30,6 +48,24 @@ func (m MockRepo) List(options repository.ListOptions) ([]repository.ListItem, e
 	args := m.Called(options)
 	return get[[]repository.ListItem](args, 0), get[error](args, 1)
 }
+func (m MockRepo) Delete(ID, bankID uuid.UUID, reason string) error {
+	args := m.Called(ID, bankID, reason)
+	return get[error](args, 0)
+}
+func (m MockRepo) Block(ID, bankID uuid.UUID) error {
+	args := m.Called(ID, bankID)
+	return get[error](args, 0)
+}
+func (m MockRepo) Unblock(ID, bankID uuid.UUID) error {
+	args := m.Called(ID, bankID)
+	return get[error](args, 0)
+}
+func (m MockRepo) Transfer(ID, donorBankID, accountID, bankID uuid.UUID,
+	account pixkey.Account, check repository.Check,
+) error {
+	args := m.Called(ID, donorBankID, accountID, bankID, account)
+	return get[error](args, 0)
+}
 
 func get[T any](args mock.Arguments, index int) T {
 	if args[index] == nil {
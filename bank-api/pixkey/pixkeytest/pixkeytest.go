@@ -8,8 +8,11 @@ import (
 	"codepix/bank-api/pixkey/repository"
 	"codepix/bank-api/pixkey/repository/database"
 	"codepix/bank-api/pixkey/service"
+	"codepix/bank-api/pixkey/verification"
 	proto "codepix/bank-api/proto/codepix/pixkey"
+	"context"
 	"strings"
+	"sync"
 
 	"github.com/google/uuid"
 )
@@ -19,6 +22,16 @@ func ValidPixKey() pixkey.PixKey {
 	return pixkey.PixKey{
 		Type: pixkey.EmailKey,
 		Key:  uniqueKey,
+		Owner: pixkey.Owner{
+			Name:     "João Silva",
+			Document: "28556370071",
+		},
+		Account: pixkey.Account{
+			Branch: "0001",
+			Number: "12345678",
+			Type:   pixkey.Checking,
+		},
+		Status: pixkey.Active,
 	}
 }
 func InvalidPixKey() pixkey.PixKey {
@@ -28,6 +41,44 @@ func InvalidPixKey() pixkey.PixKey {
 	}
 }
 
+func RegisterRequest(pixKey pixkey.PixKey, accountID []byte) *proto.RegisterRequest {
+	return &proto.RegisterRequest{
+		Type:          proto.Type(pixKey.Type),
+		Key:           pixKey.Key,
+		AccountId:     accountID,
+		OwnerName:     pixKey.Owner.Name,
+		OwnerDocument: pixKey.Owner.Document,
+		Branch:        pixKey.Account.Branch,
+		AccountNumber: pixKey.Account.Number,
+		AccountType:   proto.AccountType(pixKey.Account.Type),
+	}
+}
+
+// Notifier logs verification codes and keeps the last one sent to each key.
+var Notifier = &CodeNotifier{
+	LogNotifier: verification.LogNotifier{Logger: bankapitest.Logger},
+	codes:       map[pixkey.Key]string{},
+}
+
+type CodeNotifier struct {
+	verification.LogNotifier
+	mutex sync.Mutex
+	codes map[pixkey.Key]string
+}
+
+func (n *CodeNotifier) Notify(ctx context.Context, pixKey pixkey.PixKey, code string) error {
+	n.mutex.Lock()
+	n.codes[pixKey.Key] = code
+	n.mutex.Unlock()
+	return n.LogNotifier.Notify(ctx, pixKey, code)
+}
+
+func (n *CodeNotifier) Code(key pixkey.Key) string {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.codes[key]
+}
+
 type Creator struct {
 	PixKeyIDs func(pixkey.PixKey) repository.IDs
 }
@@ -39,6 +90,8 @@ func Repo() (repository.Repository, Creator) {
 	}
 	err = client.AutoMigrate(
 		&database.PixKey{},
+		&database.PixKeyHolder{},
+		&database.PixKeyEvent{},
 	)
 	if err != nil {
 		panic(err)
@@ -52,7 +105,7 @@ func Repo() (repository.Repository, Creator) {
 func PixKeyIDs(repo repository.Repository) func(pixkey.PixKey) repository.IDs {
 	return func(pk pixkey.PixKey) repository.IDs {
 		accountID, bankID := uuid.New(), uuid.New()
-		ID, _ := repo.Add(pk, accountID, bankID)
+		ID, _ := repo.Add(pk, accountID, bankID, nil)
 		return repository.IDs{
 			PixKeyID:  *ID,
 			AccountID: accountID,
@@ -69,7 +122,7 @@ func Service() (proto.ServiceClient, repository.Repository, Creator) {
 	server, client, serve := bankapitest.Server(validator)
 	repo, creator := Repo()
 
-	err = service.Register(server, validator, repo)
+	err = service.Register(server, bankapitest.Config, validator, repo, Notifier)
 	if err != nil {
 		panic(err)
 	}
@@ -84,7 +137,7 @@ func ServiceWithMocks() (proto.ServiceClient, *MockRepo) {
 	server, client, serve := bankapitest.Server(validator)
 	repo := new(MockRepo)
 
-	err = service.Register(server, validator, repo)
+	err = service.Register(server, bankapitest.Config, validator, repo, Notifier)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,113 @@
+package policy
+
+import (
+	"bytes"
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/config"
+	"codepix/bank-api/lib/validation"
+	"codepix/bank-api/pixkey"
+	"codepix/bank-api/pixkey/repository"
+	_ "embed"
+
+	"github.com/google/uuid"
+)
+
+//go:embed translations.json
+var translations []byte
+
+// Policy caps how many keys which were not deleted an account holds, and how many an owner
+// holds at a bank across their accounts. Owners identified by a CNPJ are companies, and
+// any other owner an individual. Banks may have their own caps. Accounts also hold at most
+// MaxRandomKeys random keys.
+type Policy struct {
+	Validator     *validation.Validator
+	MaxKeys       config.MaxKeys
+	BankMaxKeys   map[uuid.UUID]config.MaxKeys
+	MaxRandomKeys uint64
+}
+
+func New(config config.Config, val *validation.Validator) (*Policy, error) {
+	err := SetupValidator(val)
+	if err != nil {
+		return nil, err
+	}
+	return &Policy{
+		Validator:     val,
+		MaxKeys:       config.PixKey.MaxKeys,
+		BankMaxKeys:   config.PixKey.BankMaxKeys,
+		MaxRandomKeys: config.PixKey.MaxRandomKeys,
+	}, nil
+}
+
+// Keys is how many keys an account and its owner hold, checked against the most they may
+// hold.
+type Keys struct {
+	AccountId         []byte
+	OwnerDocument     string
+	AccountKeys       uint64
+	OwnerKeys         uint64
+	Max               uint64
+	Random            bool
+	AccountRandomKeys uint64
+	MaxRandom         uint64
+}
+
+func SetupValidator(val *validation.Validator) error {
+	err := validator.LoadTranslationFile(val, bytes.NewReader(translations),
+		Keys{},
+	)
+	if err != nil {
+		return err
+	}
+	return validation.AddStructValidations(val,
+		validation.StructValidation[Keys]{
+			Field: "AccountId",
+			Tag:   "max_keys",
+			IsValid: func(keys *Keys) bool {
+				return keys.AccountKeys < keys.Max
+			},
+		},
+		validation.StructValidation[Keys]{
+			Field: "AccountId",
+			Tag:   "max_random_keys",
+			IsValid: func(keys *Keys) bool {
+				return !keys.Random || keys.AccountRandomKeys < keys.MaxRandom
+			},
+		},
+		validation.StructValidation[Keys]{
+			Field: "OwnerDocument",
+			Tag:   "max_keys",
+			IsValid: func(keys *Keys) bool {
+				return keys.OwnerKeys < keys.Max
+			},
+		},
+	)
+}
+
+// Check returns a check of the keys held when the key is added, which returns a
+// *validation.Error if the account cannot hold the key being registered.
+func (p Policy) Check(pixKey pixkey.PixKey, accountID, bankID uuid.UUID) repository.Check {
+	maxKeys, found := p.BankMaxKeys[bankID]
+	if !found {
+		maxKeys = p.MaxKeys
+	}
+	max := maxKeys.Individual
+	if pixKey.Owner.IsCompany() {
+		max = maxKeys.Company
+	}
+	return func(held repository.Held) error {
+		// keys registered before owners were stored have no owner document, and are only
+		// counted by account
+		keys := Keys{
+			AccountId:         accountID[:],
+			OwnerDocument:     pixKey.Owner.Document,
+			AccountKeys:       held.AccountKeys,
+			OwnerKeys:         held.OwnerKeys,
+			Max:               max,
+			Random:            pixKey.Type == pixkey.RandomKey,
+			AccountRandomKeys: held.AccountRandomKeys,
+			MaxRandom:         p.MaxRandomKeys,
+		}
+		return validation.Validate(p.Validator, keys)
+	}
+}
@@ -0,0 +1,21 @@
+package publisher
+
+import (
+	"codepix/bank-api/config"
+	"codepix/bank-api/pixkey/repository"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+)
+
+func New(logger logr.Logger, config config.Config, events repository.Events,
+	outbox eventhorizon.EventHandler,
+) *Publisher {
+	return &Publisher{
+		Logger:    logger.WithName("pixkeypublisher"),
+		Events:    events,
+		Outbox:    outbox,
+		Interval:  config.PixKey.EventsInterval,
+		BatchSize: config.PixKey.EventsBatchSize,
+	}
+}
@@ -0,0 +1,74 @@
+package publisher
+
+import (
+	"codepix/bank-api/pixkey/repository"
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+// Publisher relays the key events written by the repository to the event store outbox,
+// which writes them to the event bus. Events are published at least once and in order,
+// as publishing stops at the first event which fails.
+type Publisher struct {
+	Logger    logr.Logger
+	Events    repository.Events
+	Outbox    eventhorizon.EventHandler
+	Interval  time.Duration
+	BatchSize int
+}
+
+// Run publishes the unpublished events every interval until the context is done.
+func (p Publisher) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.Publish(ctx)
+		}
+	}
+}
+
+// Publish publishes batches of events until none are left or one fails.
+func (p Publisher) Publish(ctx context.Context) {
+	for {
+		events, err := p.Events.Unpublished(p.BatchSize)
+		if err != nil {
+			p.Logger.Error(err, "fail: list unpublished events")
+			return
+		}
+		published := p.publish(ctx, events)
+		if len(published) > 0 {
+			err = p.Events.MarkPublished(published)
+			if err != nil {
+				p.Logger.Error(err, "fail: mark events published")
+				return
+			}
+		}
+		if len(events) == 0 || len(events) < p.BatchSize || len(published) < len(events) {
+			return
+		}
+	}
+}
+
+func (p Publisher) publish(ctx context.Context, events []repository.Event) []uuid.UUID {
+	published := []uuid.UUID{}
+	for _, event := range events {
+		err := p.Outbox.HandleEvent(ctx, event.Event)
+		if err != nil {
+			p.Logger.Error(err, "fail: publish event",
+				"event", event.ID,
+				"pixkey", event.Event.AggregateID(),
+			)
+			break
+		}
+		published = append(published, event.ID)
+	}
+	return published
+}
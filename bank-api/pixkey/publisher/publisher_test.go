@@ -0,0 +1,84 @@
+package publisher_test
+
+import (
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/pixkey"
+	"codepix/bank-api/pixkey/pixkeytest"
+	"codepix/bank-api/pixkey/publisher"
+	"codepix/bank-api/pixkey/repository"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type outbox struct {
+	events []eventhorizon.Event
+	fail   map[pixkey.Key]bool
+}
+
+func (o *outbox) HandlerType() eventhorizon.EventHandlerType { return "outbox" }
+
+func (o *outbox) HandleEvent(ctx context.Context, event eventhorizon.Event) error {
+	if blocked, ok := event.Data().(*pixkey.PixKeyBlocked); ok && o.fail[blocked.Key] {
+		return errors.New("an error")
+	}
+	o.events = append(o.events, event)
+	return nil
+}
+
+func TestPublish(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	repo, _ := pixkeytest.Repo()
+	events := repo.(repository.Events)
+	outbox := &outbox{fail: map[pixkey.Key]bool{}}
+	p := publisher.Publisher{
+		Logger:    bankapitest.Logger,
+		Events:    events,
+		Outbox:    outbox,
+		BatchSize: 2,
+	}
+	p.Publish(context.Background())
+	outbox.events = nil
+
+	keys := []pixkey.PixKey{}
+	for i := 0; i < 5; i++ {
+		pixKey := pixkeytest.ValidPixKey()
+		bankID := uuid.New()
+		ID, err := repo.Add(pixKey, uuid.New(), bankID, nil)
+		require.NoError(t, err)
+		require.NoError(t, repo.Block(*ID, bankID))
+		keys = append(keys, pixKey)
+	}
+	// keep only the block events, so the outbox can fail on a key
+	unpublished, err := events.Unpublished(100)
+	require.NoError(t, err)
+	registered := []uuid.UUID{}
+	for _, event := range unpublished {
+		if event.Event.EventType() == pixkey.RegisteredEvent {
+			registered = append(registered, event.ID)
+		}
+	}
+	require.NoError(t, events.MarkPublished(registered))
+
+	outbox.fail[keys[3].Key] = true
+	p.Publish(context.Background())
+	require.Len(t, outbox.events, 3, "publishing stops at the failed event")
+	for i, event := range outbox.events {
+		assert.Equal(t, keys[i].Key, event.Data().(*pixkey.PixKeyBlocked).Key)
+	}
+
+	outbox.events = nil
+	delete(outbox.fail, keys[3].Key)
+	p.Publish(context.Background())
+	require.Len(t, outbox.events, 2, "published events are not published again")
+	for i, event := range outbox.events {
+		assert.Equal(t, keys[i+3].Key, event.Data().(*pixkey.PixKeyBlocked).Key)
+	}
+}
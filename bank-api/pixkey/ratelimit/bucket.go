@@ -0,0 +1,45 @@
+package ratelimit
+
+import "time"
+
+// bucket gains a token every Refill, up to Capacity. A zero Refill never gains tokens.
+type bucket struct {
+	Capacity   float64
+	Refill     time.Duration
+	Tokens     float64
+	RefilledAt time.Time
+}
+
+func newBucket(capacity uint64, refill time.Duration, now time.Time) *bucket {
+	return &bucket{
+		Capacity:   float64(capacity),
+		Refill:     refill,
+		Tokens:     float64(capacity),
+		RefilledAt: now,
+	}
+}
+
+func (b *bucket) refill(now time.Time) {
+	if b.Refill > 0 && now.After(b.RefilledAt) {
+		b.give(float64(now.Sub(b.RefilledAt)) / float64(b.Refill))
+	}
+	b.RefilledAt = now
+}
+
+func (b *bucket) give(n float64) {
+	b.Tokens += n
+	if b.Tokens > b.Capacity {
+		b.Tokens = b.Capacity
+	}
+}
+
+func (b *bucket) take(n float64) {
+	b.Tokens -= n
+	if b.Tokens < 0 {
+		b.Tokens = 0
+	}
+}
+
+func (b *bucket) full() bool {
+	return b.Tokens >= b.Capacity
+}
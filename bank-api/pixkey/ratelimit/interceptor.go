@@ -0,0 +1,150 @@
+package ratelimit
+
+import (
+	"codepix/bank-api/bank/auth"
+	writeproto "codepix/bank-api/proto/codepix/transaction/write"
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// EndUserIDKey is the metadata key of the end user on whose behalf a bank looks up keys.
+const EndUserIDKey = "end_user_id"
+
+var ErrExhausted = status.Error(codes.ResourceExhausted, "too many key lookups")
+
+// Methods which look up keys. Others are not limited.
+var (
+	unaryLookups = map[string]bool{
+		"/codepix.pixkey.Service/Lookup":                 true,
+		"/codepix.transaction.write.Service/Start":       true,
+		"/codepix.transaction.write.Service/StartBatch":  true,
+		"/codepix.transaction.write.Service/StartBRCode": true,
+	}
+	streamLookups = map[string]bool{
+		"/codepix.transaction.write.Stream/Start": true,
+	}
+)
+
+// UnaryInterceptor must come after the token validator, as buckets are per bank.
+func (l *Limiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any,
+		info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (any, error) {
+		if !unaryLookups[info.FullMethod] {
+			return next(ctx, req)
+		}
+		bankID := auth.GetBankID(ctx)
+		userID := endUserID(ctx)
+		if !l.Take(bankID, userID, cost(req)) {
+			return nil, ErrExhausted
+		}
+		reply, err := next(ctx, req)
+		if status.Code(err) == codes.NotFound {
+			l.Miss(bankID, userID)
+		}
+		l.record(bankID, userID, reply)
+		return reply, err
+	}
+}
+
+// StreamInterceptor must come after the token validator, as buckets are per bank.
+func (l *Limiter) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(server any, stream grpc.ServerStream,
+		info *grpc.StreamServerInfo, next grpc.StreamHandler) error {
+		if !streamLookups[info.FullMethod] {
+			return next(server, stream)
+		}
+		ctx := stream.Context()
+		limited := &limitedStream{
+			ServerStream: stream,
+			limiter:      l,
+			bankID:       auth.GetBankID(ctx),
+			userID:       endUserID(ctx),
+		}
+		return next(server, limited)
+	}
+}
+
+type limitedStream struct {
+	grpc.ServerStream
+	limiter *Limiter
+	bankID  uuid.UUID
+	userID  string
+}
+
+// RecvMsg replies to messages over the limit with an error, the same way the stream
+// replies to commands which fail, and waits for the next message, so the stream stays open.
+func (s *limitedStream) RecvMsg(m any) error {
+	for {
+		err := s.ServerStream.RecvMsg(m)
+		if err != nil {
+			return err
+		}
+		if s.limiter.Take(s.bankID, s.userID, cost(m)) {
+			return nil
+		}
+		err = s.ServerStream.SendMsg(&writeproto.StartReply{
+			Message: &writeproto.StartReply_Error{
+				Error: status.Convert(ErrExhausted).Proto(),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *limitedStream) SendMsg(m any) error {
+	s.limiter.record(s.bankID, s.userID, m)
+	return s.ServerStream.SendMsg(m)
+}
+
+func endUserID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(EndUserIDKey); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+func cost(req any) uint64 {
+	if batch, ok := req.(*writeproto.StartBatchRequest); ok {
+		return uint64(len(batch.Items))
+	}
+	return 1
+}
+
+// record penalizes misses and remembers started transactions found in replies.
+func (l *Limiter) record(bankID uuid.UUID, userID string, reply any) {
+	switch reply := reply.(type) {
+	case *writeproto.Started:
+		l.recordStarted(bankID, userID, reply)
+	case *writeproto.StartReply:
+		l.recordStartReply(bankID, userID, reply)
+	case *writeproto.BatchStarted:
+		for _, item := range reply.GetItems() {
+			l.recordStartReply(bankID, userID, item)
+		}
+	}
+}
+
+func (l *Limiter) recordStartReply(bankID uuid.UUID, userID string, reply *writeproto.StartReply) {
+	if reply.GetError() != nil && codes.Code(reply.GetError().Code) == codes.NotFound {
+		l.Miss(bankID, userID)
+	}
+	if reply.GetStarted() != nil {
+		l.recordStarted(bankID, userID, reply.GetStarted())
+	}
+}
+
+func (l *Limiter) recordStarted(bankID uuid.UUID, userID string, started *writeproto.Started) {
+	ID, err := uuid.FromBytes(started.GetId())
+	if err == nil {
+		l.Started(ID, bankID, userID)
+	}
+}
@@ -0,0 +1,43 @@
+package ratelimit
+
+import (
+	"codepix/bank-api/adapters/eventhandler"
+	"codepix/bank-api/transaction"
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+// Setup gives tokens back once transactions complete.
+func Setup(logger logr.Logger, outbox eventhorizon.Outbox, limiter *Limiter) error {
+	refunder := refunder{limiter}
+	err := outbox.AddHandler(context.Background(),
+		eventhorizon.MatchEvents{transaction.CompletedEvent},
+		eventhandler.Named(
+			eventhandler.Logger(logger.WithName("ratelimit"), refunder),
+			refunder.HandlerType(),
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("setup rate limit: %w", err)
+	}
+	return nil
+}
+
+type refunder struct {
+	limiter *Limiter
+}
+
+var _ eventhorizon.EventHandler = refunder{}
+
+func (r refunder) HandlerType() eventhorizon.EventHandlerType {
+	return eventhorizon.EventHandlerType("ratelimit_refund")
+}
+
+func (r refunder) HandleEvent(ctx context.Context, event eventhorizon.Event) error {
+	r.limiter.Completed(uuid.UUID(event.AggregateID()))
+	return nil
+}
@@ -0,0 +1,175 @@
+// Package ratelimit keeps banks and their end users from enumerating Pix keys. Every key
+// lookup takes tokens from token buckets, lookups of missing keys take more, and lookups
+// leading to completed transactions give some back.
+//
+// Buckets are kept in memory, so each instance of the API limits on its own, and tokens
+// are only given back when the instance which started a transaction handles its completion.
+package ratelimit
+
+import (
+	"codepix/bank-api/config"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// minPrune is how many user buckets are kept before full ones are dropped.
+const minPrune = 1024
+
+type Limiter struct {
+	Config config.Config
+	Now    func() time.Time
+
+	mutex   sync.Mutex
+	banks   map[uuid.UUID]*bucket
+	users   map[endUser]*bucket
+	pruneAt int
+	// started maps transactions to who looked up their receivers. Transactions are
+	// queued in the order they started, so expired ones are dropped from the front.
+	started map[uuid.UUID]endUser
+	queue   []startedAt
+}
+
+type endUser struct {
+	BankID uuid.UUID
+	ID     string
+}
+
+type startedAt struct {
+	ID uuid.UUID
+	At time.Time
+}
+
+func New(config config.Config) *Limiter {
+	return &Limiter{
+		Config:  config,
+		Now:     time.Now,
+		banks:   map[uuid.UUID]*bucket{},
+		users:   map[endUser]*bucket{},
+		pruneAt: minPrune,
+		started: map[uuid.UUID]endUser{},
+	}
+}
+
+// Take takes n tokens from the buckets of the bank and of the end user, which may be
+// empty. Tokens are only taken if both buckets hold enough of them.
+func (l *Limiter) Take(bankID uuid.UUID, userID string, n uint64) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	now := l.Now()
+
+	buckets := []*bucket{l.bank(bankID, now)}
+	if userID != "" {
+		buckets = append(buckets, l.user(endUser{bankID, userID}, now))
+	}
+	for _, b := range buckets {
+		if b.Tokens < float64(n) {
+			return false
+		}
+	}
+	for _, b := range buckets {
+		b.take(float64(n))
+	}
+	return true
+}
+
+// Miss takes the miss penalty from the buckets of the bank and of the end user, emptying
+// them if they do not hold enough tokens.
+func (l *Limiter) Miss(bankID uuid.UUID, userID string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	now := l.Now()
+	penalty := float64(l.Config.RateLimit.MissPenalty)
+
+	l.bank(bankID, now).take(penalty)
+	if userID != "" {
+		l.user(endUser{bankID, userID}, now).take(penalty)
+	}
+}
+
+// Started records that the bank and end user looked up the receiver of a transaction, so
+// tokens are given back if it completes.
+func (l *Limiter) Started(ID, bankID uuid.UUID, userID string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	now := l.Now()
+
+	l.expire(now)
+	l.started[ID] = endUser{bankID, userID}
+	l.queue = append(l.queue, startedAt{ID, now})
+}
+
+// Completed gives tokens back to the bank and end user which started a transaction.
+func (l *Limiter) Completed(ID uuid.UUID) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	now := l.Now()
+
+	l.expire(now)
+	startedBy, ok := l.started[ID]
+	if !ok {
+		return
+	}
+	delete(l.started, ID)
+	refund := float64(l.Config.RateLimit.CompletedRefund)
+
+	l.bank(startedBy.BankID, now).give(refund)
+	if startedBy.ID != "" {
+		l.user(startedBy, now).give(refund)
+	}
+}
+
+func (l *Limiter) expire(now time.Time) {
+	window := l.Config.RateLimit.CompletedWindow
+	expired := 0
+	for _, started := range l.queue {
+		if now.Sub(started.At) < window {
+			break
+		}
+		delete(l.started, started.ID)
+		expired++
+	}
+	l.queue = l.queue[expired:]
+}
+
+func (l *Limiter) bank(ID uuid.UUID, now time.Time) *bucket {
+	cfg := l.Config.RateLimit
+	b, ok := l.banks[ID]
+	if !ok {
+		b = newBucket(cfg.BankCapacity, cfg.BankRefill, now)
+		l.banks[ID] = b
+	}
+	b.refill(now)
+	return b
+}
+
+func (l *Limiter) user(u endUser, now time.Time) *bucket {
+	cfg := l.Config.RateLimit
+	b, ok := l.users[u]
+	if !ok {
+		l.prune(now)
+		b = newBucket(cfg.UserCapacity, cfg.UserRefill, now)
+		l.users[u] = b
+	}
+	b.refill(now)
+	return b
+}
+
+// prune drops full user buckets once there are too many of them, since a new bucket is
+// just as full. End users are given by banks, so there is no bound on how many there are.
+func (l *Limiter) prune(now time.Time) {
+	if len(l.users) < l.pruneAt {
+		return
+	}
+	for u, b := range l.users {
+		b.refill(now)
+		if b.full() {
+			delete(l.users, u)
+		}
+	}
+	l.pruneAt = 2 * len(l.users)
+	if l.pruneAt < minPrune {
+		l.pruneAt = minPrune
+	}
+}
@@ -0,0 +1,184 @@
+package ratelimit_test
+
+import (
+	"codepix/bank-api/adapters/jwtclaims"
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/pixkey/ratelimit"
+	writeproto "codepix/bank-api/proto/codepix/transaction/write"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func newLimiter() (*ratelimit.Limiter, *time.Time) {
+	config := bankapitest.Config
+	config.RateLimit.BankCapacity = 10
+	config.RateLimit.BankRefill = time.Second
+	config.RateLimit.UserCapacity = 3
+	config.RateLimit.UserRefill = time.Minute
+	config.RateLimit.MissPenalty = 2
+	config.RateLimit.CompletedRefund = 1
+	config.RateLimit.CompletedWindow = time.Hour
+
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := ratelimit.New(config)
+	limiter.Now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func TestTake(t *testing.T) {
+	limiter, now := newLimiter()
+	bankID := uuid.New()
+
+	for i := 0; i < 3; i++ {
+		assert.True(t, limiter.Take(bankID, "user", 1))
+	}
+	assert.False(t, limiter.Take(bankID, "user", 1), "user bucket is empty")
+	assert.True(t, limiter.Take(bankID, "other user", 1))
+	assert.True(t, limiter.Take(uuid.New(), "user", 1), "users are per bank")
+
+	assert.False(t, limiter.Take(bankID, "", 7), "bank bucket has 6 tokens")
+	assert.True(t, limiter.Take(bankID, "", 6))
+	assert.False(t, limiter.Take(bankID, "", 1))
+
+	*now = now.Add(2 * time.Second)
+	assert.True(t, limiter.Take(bankID, "", 2), "bank bucket refilled")
+	assert.False(t, limiter.Take(bankID, "", 1))
+
+	*now = now.Add(time.Hour)
+	assert.True(t, limiter.Take(bankID, "", 10), "bank bucket refilled up to its capacity")
+	assert.False(t, limiter.Take(bankID, "", 1))
+}
+
+func TestMiss(t *testing.T) {
+	limiter, _ := newLimiter()
+	bankID := uuid.New()
+
+	limiter.Miss(bankID, "user")
+	assert.True(t, limiter.Take(bankID, "user", 1))
+	assert.False(t, limiter.Take(bankID, "user", 1), "miss took 2 tokens")
+
+	limiter.Miss(bankID, "other user")
+	limiter.Miss(bankID, "other user")
+	assert.True(t, limiter.Take(bankID, "", 3), "misses took 6 tokens from the bank")
+	assert.False(t, limiter.Take(bankID, "", 1))
+}
+
+func TestCompleted(t *testing.T) {
+	limiter, now := newLimiter()
+	bankID := uuid.New()
+	ID, expiredID, unknownID := uuid.New(), uuid.New(), uuid.New()
+
+	limiter.Started(expiredID, bankID, "user")
+	*now = now.Add(time.Hour)
+	limiter.Take(bankID, "user", 3)
+	limiter.Started(ID, bankID, "user")
+
+	limiter.Completed(expiredID)
+	limiter.Completed(unknownID)
+	assert.False(t, limiter.Take(bankID, "user", 1))
+
+	limiter.Completed(ID)
+	limiter.Completed(ID)
+	assert.True(t, limiter.Take(bankID, "user", 1), "completion gave back one token once")
+	assert.False(t, limiter.Take(bankID, "user", 1))
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	limiter, _ := newLimiter()
+	bankID := uuid.New()
+	ctx := jwtclaims.AddClaims(context.Background(), jwt.MapClaims{auth.BankIDKey: bankID})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(ratelimit.EndUserIDKey, "user"))
+	interceptor := limiter.UnaryInterceptor()
+	call := func(method string, req any, reply any, err error) error {
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		_, err = interceptor(ctx, req, info, func(context.Context, any) (any, error) {
+			return reply, err
+		})
+		return err
+	}
+	lookup := "/codepix.pixkey.Service/Lookup"
+	ID := uuid.New()
+	started := &writeproto.Started{Id: ID[:]}
+	notFound := &writeproto.StartReply{Message: &writeproto.StartReply_Error{
+		Error: &status.Status{Code: int32(codes.NotFound)},
+	}}
+
+	assert.Nil(t, call("/codepix.pixkey.Service/Find", nil, nil, nil))
+	assert.Nil(t, call("/codepix.transaction.write.Service/Start", nil, started, nil))
+	assert.Nil(t, call(lookup, nil, nil, nil))
+	limiter.Completed(ID)
+	assert.Nil(t, call(lookup, nil, nil, nil))
+	assert.Nil(t, call(lookup, nil, nil, nil), "completion gave back a token")
+	assert.Equal(t, ratelimit.ErrExhausted, call(lookup, nil, nil, nil))
+
+	limiter, _ = newLimiter()
+	interceptor = limiter.UnaryInterceptor()
+	batch := &writeproto.StartBatchRequest{Items: make([]*writeproto.StartRequest, 4)}
+	assert.Equal(t, ratelimit.ErrExhausted,
+		call("/codepix.transaction.write.Service/StartBatch", batch, nil, nil),
+		"batch items each take a token")
+	batch.Items = batch.Items[:1]
+	assert.Nil(t, call("/codepix.transaction.write.Service/StartBatch", batch,
+		&writeproto.BatchStarted{Items: []*writeproto.StartReply{notFound}}, nil))
+	assert.Equal(t, ratelimit.ErrExhausted, call(lookup, nil, nil, nil),
+		"missed batch item took 2 tokens")
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	received int
+	sent     []any
+}
+
+func (s *fakeStream) Context() context.Context { return s.ctx }
+func (s *fakeStream) RecvMsg(m any) error {
+	if s.received == 5 {
+		return io.EOF
+	}
+	s.received++
+	return nil
+}
+func (s *fakeStream) SendMsg(m any) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	limiter, _ := newLimiter()
+	bankID := uuid.New()
+	ctx := jwtclaims.AddClaims(context.Background(), jwt.MapClaims{auth.BankIDKey: bankID})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(ratelimit.EndUserIDKey, "user"))
+	stream := &fakeStream{ctx: ctx}
+	info := &grpc.StreamServerInfo{FullMethod: "/codepix.transaction.write.Stream/Start"}
+
+	accepted := 0
+	handler := func(_ any, stream grpc.ServerStream) error {
+		for {
+			err := stream.RecvMsg(&writeproto.StartRequest{})
+			if err != nil {
+				return err
+			}
+			accepted++
+		}
+	}
+	err := limiter.StreamInterceptor()(nil, stream, info, handler)
+	assert.Equal(t, io.EOF, err, "exhausted messages don't end the stream")
+	assert.Equal(t, 3, accepted)
+	assert.Len(t, stream.sent, 2)
+	for _, sent := range stream.sent {
+		reply := sent.(*writeproto.StartReply)
+		assert.Equal(t, int32(codes.ResourceExhausted), reply.GetError().GetCode())
+	}
+}
@@ -2,22 +2,184 @@ package database
 
 import (
 	"codepix/bank-api/adapters/databaseclient"
+	"codepix/bank-api/lib/repositories"
 	"codepix/bank-api/pixkey"
 	"codepix/bank-api/pixkey/repository"
+	"codepix/bank-api/pixkey/verification"
+	"encoding/hex"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+var errNotFound = &repositories.NotFoundError{"pix key"}
+
 type Database struct {
 	*databaseclient.Database
 }
 
 var _ repository.Repository = Database{}
 
-func (db Database) Add(pixKey pixkey.PixKey, accountID, bankID uuid.UUID) (*uuid.UUID, error) {
+// Add, Verify, Delete, Block, Unblock and Transfer write the events of their changes in
+// the same transaction, so events are published for every change and only for changes
+// which were made.
+func (db Database) Add(pixKey pixkey.PixKey, accountID, bankID uuid.UUID,
+	check repository.Check,
+) (*uuid.UUID, error) {
+	new := NewPixKey(pixKey, accountID, bankID)
+	var ID *uuid.UUID
+	err := db.transaction(func(db *gorm.DB) error {
+		err := checkHeld(db, new, check)
+		if err != nil {
+			return err
+		}
+		tx := db.Create(new)
+		ID = databaseclient.GetID(tx)
+		if tx.Error != nil {
+			return databaseclient.MapError(tx)
+		}
+		return addEvent(db, new.ID, registered(*new))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ID, nil
+}
+
+// AddPending deletes pending keys with the same key whose verification expired, so they
+// do not hold the key forever.
+func (db Database) AddPending(pixKey pixkey.PixKey, accountID, bankID uuid.UUID,
+	verification verification.Verification, check repository.Check,
+) (*uuid.UUID, error) {
 	new := NewPixKey(pixKey, accountID, bankID)
-	tx := db.Create(new)
-	return databaseclient.GetID(tx), databaseclient.MapError(tx)
+	new.Status = pixkey.Pending
+	new.VerificationCodeHash = hex.EncodeToString(verification.CodeHash)
+	new.VerificationExpiresAt = &verification.ExpiresAt
+
+	var ID *uuid.UUID
+	err := db.transaction(func(db *gorm.DB) error {
+		tx := db.Model(&PixKey{}).
+			Where("key = ? and status = ? and verification_expires_at <= ?",
+				new.Key, pixkey.Pending, time.Now()).
+			Updates(map[string]any{
+				"status":          pixkey.Deleted,
+				"deleted_at":      time.Now(),
+				"deletion_reason": "verification expired",
+			})
+		if tx.Error != nil {
+			return databaseclient.MapError(tx)
+		}
+		err := checkHeld(db, new, check)
+		if err != nil {
+			return err
+		}
+		tx = db.Create(new)
+		ID = databaseclient.GetID(tx)
+		return databaseclient.MapError(tx)
+	})
+	return ID, err
+}
+
+// checkHeld runs the check with the keys the account and owner of the key hold. It first
+// touches their holders, which locks them until the key is added.
+func checkHeld(db *gorm.DB, pixKey *PixKey, check repository.Check) error {
+	if check == nil {
+		return nil
+	}
+	holders := []string{pixKey.AccountID.String()}
+	if pixKey.OwnerDocument != "" {
+		holders = append(holders, pixKey.OwnerDocument)
+	}
+	for _, holder := range holders {
+		tx := db.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "bank_id"}, {Name: "holder"}},
+			DoUpdates: clause.AssignmentColumns([]string{"updated_at"}),
+		}).Create(NewPixKeyHolder(pixKey.BankID, holder))
+		if tx.Error != nil {
+			return databaseclient.MapError(tx)
+		}
+	}
+	var held repository.Held
+	var err error
+	held.AccountKeys, err = count(db, pixKey.BankID, "account_id = ?", pixKey.AccountID)
+	if err != nil {
+		return err
+	}
+	held.AccountRandomKeys, err = count(db, pixKey.BankID, "account_id = ? and type = ?",
+		pixKey.AccountID, pixkey.RandomKey)
+	if err != nil {
+		return err
+	}
+	if pixKey.OwnerDocument != "" {
+		held.OwnerKeys, err = count(db, pixKey.BankID, "owner_document = ?", pixKey.OwnerDocument)
+		if err != nil {
+			return err
+		}
+	}
+	return check(held)
+}
+
+// count returns how many keys of a bank which were not deleted match a condition.
+func count(db *gorm.DB, bankID uuid.UUID, query string, args ...any) (uint64, error) {
+	var count int64
+	tx := db.Model(&PixKey{}).
+		Where("bank_id = ? and status <> ?", bankID, pixkey.Deleted).
+		Where(query, args...).
+		Count(&count)
+	return uint64(count), databaseclient.MapError(tx)
+}
+
+func (db Database) Attempt(ID, bankID uuid.UUID, maxAttempts uint64,
+) (*verification.Verification, error) {
+	var pixKey PixKey
+	err := db.transaction(func(db *gorm.DB) error {
+		tx := db.First(&pixKey, "id = ? and bank_id = ? and status = ?",
+			ID, bankID, pixkey.Pending)
+		if tx.Error != nil {
+			return databaseclient.MapError(tx)
+		}
+		tx = db.Model(&PixKey{}).
+			Where("id = ? and verification_attempts < ?", ID, maxAttempts).
+			Update("verification_attempts", gorm.Expr("verification_attempts + 1"))
+		if tx.Error != nil {
+			return databaseclient.MapError(tx)
+		}
+		if tx.RowsAffected == 0 {
+			return verification.ErrNoAttemptsLeft
+		}
+		pixKey.VerificationAttempts++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return VerificationFromDB(pixKey), nil
+}
+
+func (db Database) Verify(ID, bankID uuid.UUID) error {
+	return db.transaction(func(db *gorm.DB) error {
+		pixKey, err := find(db.Where("id = ? and bank_id = ? and status = ?",
+			ID, bankID, pixkey.Pending))
+		if err != nil {
+			return err
+		}
+		tx := db.Model(&PixKey{}).
+			Where("id = ? and status = ?", ID, pixkey.Pending).
+			Updates(map[string]any{
+				"status":                 pixkey.Active,
+				"verification_code_hash": "",
+			})
+		if tx.Error != nil {
+			return databaseclient.MapError(tx)
+		}
+		if tx.RowsAffected == 0 {
+			return errNotFound
+		}
+		return addEvent(db, ID, registered(*pixKey))
+	})
 }
 
 func (db Database) Find(ID uuid.UUID) (*pixkey.PixKey, *repository.IDs, error) {
@@ -26,33 +188,236 @@ func (db Database) Find(ID uuid.UUID) (*pixkey.PixKey, *repository.IDs, error) {
 	return PixKeyFromDB(pixKey), PixKeyIDs(pixKey), databaseclient.MapError(tx)
 }
 
+// FindByKey normalizes the key, as keys are stored normalized.
 func (db Database) FindByKey(key pixkey.Key) (*pixkey.PixKey, *repository.IDs, error) {
 	var pixKey PixKey
-	tx := db.First(&pixKey, "key = ?", key)
+	key = pixkey.Normalize(pixkey.TypeOf(key), key)
+	tx := db.First(&pixKey, "key = ? and status = ?", key, pixkey.Active)
 	return PixKeyFromDB(pixKey), PixKeyIDs(pixKey), databaseclient.MapError(tx)
 }
 
 func (db Database) List(options repository.ListOptions) ([]repository.ListItem, error) {
 	var pixKeys []PixKey
-	tx := db.DB.Find(&pixKeys, "account_id = ? and bank_id = ?", options.AccountID, options.BankID)
+	tx := db.DB.Where("bank_id = ? and status <> ?", options.BankID, pixkey.Deleted)
+	if options.AccountID != uuid.Nil {
+		tx = tx.Where("account_id = ?", options.AccountID)
+	}
+	if options.OwnerDocument != "" {
+		tx = tx.Where("owner_document = ?", pixkey.NormalizeDocument(options.OwnerDocument))
+	}
+	if options.Type != 0 {
+		tx = tx.Where("type = ?", options.Type)
+	}
+	tx = tx.Find(&pixKeys)
 	return PixKeysFromDB(pixKeys), databaseclient.MapError(tx)
 }
 
+func (db Database) Delete(ID, bankID uuid.UUID, reason string) error {
+	return db.transaction(func(db *gorm.DB) error {
+		pixKey, err := find(db.Where("id = ? and bank_id = ? and status <> ?",
+			ID, bankID, pixkey.Deleted))
+		if err != nil {
+			return err
+		}
+		tx := db.Model(&PixKey{}).
+			Where("id = ? and status <> ?", ID, pixkey.Deleted).
+			Updates(map[string]any{
+				"status":          pixkey.Deleted,
+				"deleted_at":      time.Now(),
+				"deletion_reason": reason,
+			})
+		if tx.Error != nil {
+			return databaseclient.MapError(tx)
+		}
+		if tx.RowsAffected == 0 {
+			return errNotFound
+		}
+		// pending keys were never published as registered
+		if pixKey.Status == pixkey.Pending {
+			return nil
+		}
+		return addEvent(db, ID, pixkey.PixKeyDeleted{
+			Key:    pixKey.Key,
+			BankID: bankID,
+			Reason: reason,
+		})
+	})
+}
+
+// Block keeps a key from receiving transactions until it is unblocked. Blocking or
+// unblocking a key twice has no effect. Pending keys are neither blocked nor unblocked, so
+// they are only activated by verifying them.
+func (db Database) Block(ID, bankID uuid.UUID) error {
+	return db.setStatus(ID, bankID, pixkey.Blocked, func(pixKey PixKey) event {
+		return pixkey.PixKeyBlocked{Key: pixKey.Key, BankID: bankID}
+	})
+}
+
+func (db Database) Unblock(ID, bankID uuid.UUID) error {
+	return db.setStatus(ID, bankID, pixkey.Active, func(pixKey PixKey) event {
+		return pixkey.PixKeyUnblocked{Key: pixKey.Key, BankID: bankID}
+	})
+}
+
+func (db Database) setStatus(ID, bankID uuid.UUID, status pixkey.Status,
+	changed func(PixKey) event,
+) error {
+	return db.transaction(func(db *gorm.DB) error {
+		pixKey, err := find(db.Where("id = ? and bank_id = ? and status in ?",
+			ID, bankID, []pixkey.Status{pixkey.Active, pixkey.Blocked}))
+		if err != nil {
+			return err
+		}
+		if pixKey.Status == status {
+			return nil
+		}
+		tx := db.Model(&PixKey{}).
+			Where("id = ? and status = ?", ID, pixKey.Status).
+			Update("status", status)
+		if tx.Error != nil {
+			return databaseclient.MapError(tx)
+		}
+		if tx.RowsAffected == 0 {
+			return errNotFound
+		}
+		return addEvent(db, ID, changed(*pixKey))
+	})
+}
+
+// Transfer moves a key of the donor bank to an account of another bank, checked against
+// the keys the account and owner hold there as Add does. Transferring a key already moved
+// to the account has no effect.
+func (db Database) Transfer(ID, donorBankID, accountID, bankID uuid.UUID,
+	account pixkey.Account, check repository.Check,
+) error {
+	return db.transaction(func(db *gorm.DB) error {
+		pixKey, err := find(db.
+			Where("id = ? and status <> ?", ID, pixkey.Deleted).
+			Where(db.Where("bank_id = ?", donorBankID).
+				Or("bank_id = ? and account_id = ?", bankID, accountID)))
+		if err != nil {
+			return err
+		}
+		if pixKey.BankID == bankID && pixKey.AccountID == accountID {
+			return nil
+		}
+		moved := *pixKey
+		moved.AccountID, moved.BankID = accountID, bankID
+		err = checkHeld(db, &moved, check)
+		if err != nil {
+			return err
+		}
+		tx := db.Model(&PixKey{}).
+			Where("id = ? and bank_id = ? and status <> ?", ID, donorBankID, pixkey.Deleted).
+			Updates(map[string]any{
+				"account_id":     accountID,
+				"bank_id":        bankID,
+				"branch":         account.Branch,
+				"account_number": account.Number,
+				"account_type":   account.Type,
+			})
+		if tx.Error != nil {
+			return databaseclient.MapError(tx)
+		}
+		if tx.RowsAffected == 0 {
+			return errNotFound
+		}
+		return addEvent(db, ID, pixkey.PixKeyTransferred{
+			Key:       pixKey.Key,
+			DonorBank: donorBankID,
+			AccountID: accountID,
+			BankID:    bankID,
+		})
+	})
+}
+
+// find returns the key matching the conditions of db, or errNotFound.
+func find(db *gorm.DB) (*PixKey, error) {
+	var pixKey PixKey
+	tx := db.First(&pixKey)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, errNotFound
+	}
+	if tx.Error != nil {
+		return nil, databaseclient.MapError(tx)
+	}
+	return &pixKey, nil
+}
+
+// oldKeyIndex is the unique index of keys from before keys could be deleted, which would
+// keep deleted keys from being registered again.
+const oldKeyIndex = "idx_pix_keys_key"
+
+// DropOldKeyIndex drops the unique index keys had before keys could be deleted, as
+// migrations don't drop indexes which were removed from the model.
+func (db Database) DropOldKeyIndex() error {
+	migrator := db.DB.Migrator()
+	if !migrator.HasIndex(&PixKey{}, oldKeyIndex) {
+		return nil
+	}
+	return migrator.DropIndex(&PixKey{}, oldKeyIndex)
+}
+
+func registered(pixKey PixKey) pixkey.PixKeyRegistered {
+	return pixkey.PixKeyRegistered{
+		KeyType:   pixKey.Type,
+		Key:       pixKey.Key,
+		AccountID: pixKey.AccountID,
+		BankID:    pixKey.BankID,
+	}
+}
+
+// PixKey is unique by key among the keys which were not deleted, so deleted keys can be
+// registered again. Tags can't refer to constants, so the 2 in the index is pixkey.Deleted,
+// which TestDelete relies on when it registers a deleted key again.
 type PixKey struct {
 	databaseclient.BaseModel
-	Type      pixkey.Type `gorm:"<-:create;"`
-	Key       pixkey.Key  `gorm:"<-:create;uniqueIndex"`
-	AccountID uuid.UUID   `gorm:"<-:create;index"`
-	BankID    uuid.UUID   `gorm:"<-:create;index"`
+	Type           pixkey.Type `gorm:"<-:create;"`
+	Key            pixkey.Key  `gorm:"<-:create;uniqueIndex:idx_pix_keys_live_key,where:status <> 2"`
+	OwnerName      string
+	OwnerDocument  string    `gorm:"index"`
+	AccountID      uuid.UUID `gorm:"index"`
+	BankID         uuid.UUID `gorm:"index"`
+	Branch         string
+	AccountNumber  string
+	AccountType    pixkey.AccountType
+	Status         pixkey.Status `gorm:"not null;default:1"`
+	DeletedAt      *time.Time
+	DeletionReason string
+
+	VerificationCodeHash  string
+	VerificationExpiresAt *time.Time
+	VerificationAttempts  uint64
 }
 
-func NewPixKey(pixKey pixkey.PixKey, accountID, bankID uuid.UUID) *PixKey {
-	return &PixKey{
+// PixKeyHolder is an account or owner document holding keys at a bank.
+type PixKeyHolder struct {
+	databaseclient.BaseModel
+	BankID uuid.UUID `gorm:"<-:create;uniqueIndex:idx_pix_key_holders_holder"`
+	Holder string    `gorm:"<-:create;uniqueIndex:idx_pix_key_holders_holder"`
+}
+
+func NewPixKeyHolder(bankID uuid.UUID, holder string) *PixKeyHolder {
+	return &PixKeyHolder{
 		BaseModel: databaseclient.NewBaseModel(),
-		Type:      pixKey.Type,
-		Key:       pixKey.Key,
-		AccountID: accountID,
 		BankID:    bankID,
+		Holder:    holder,
+	}
+}
+
+func NewPixKey(pixKey pixkey.PixKey, accountID, bankID uuid.UUID) *PixKey {
+	return &PixKey{
+		BaseModel:     databaseclient.NewBaseModel(),
+		Type:          pixKey.Type,
+		Key:           pixkey.Normalize(pixKey.Type, pixKey.Key),
+		OwnerName:     pixKey.Owner.Name,
+		OwnerDocument: pixkey.NormalizeDocument(pixKey.Owner.Document),
+		AccountID:     accountID,
+		BankID:        bankID,
+		Branch:        pixKey.Account.Branch,
+		AccountNumber: pixKey.Account.Number,
+		AccountType:   pixKey.Account.Type,
+		Status:        pixkey.Active,
 	}
 }
 
@@ -60,9 +425,37 @@ func PixKeyFromDB(dbPixKey PixKey) *pixkey.PixKey {
 	if dbPixKey == (PixKey{}) {
 		return nil
 	}
-	return &pixkey.PixKey{
+	pixKey := &pixkey.PixKey{
 		Type: dbPixKey.Type,
 		Key:  dbPixKey.Key,
+		Owner: pixkey.Owner{
+			Name:     dbPixKey.OwnerName,
+			Document: dbPixKey.OwnerDocument,
+		},
+		Account: pixkey.Account{
+			Branch: dbPixKey.Branch,
+			Number: dbPixKey.AccountNumber,
+			Type:   dbPixKey.AccountType,
+		},
+		Status:         dbPixKey.Status,
+		CreatedAt:      dbPixKey.CreatedAt,
+		DeletionReason: dbPixKey.DeletionReason,
+	}
+	if dbPixKey.DeletedAt != nil {
+		pixKey.DeletedAt = *dbPixKey.DeletedAt
+	}
+	return pixKey
+}
+
+func VerificationFromDB(dbPixKey PixKey) *verification.Verification {
+	if dbPixKey.VerificationExpiresAt == nil {
+		return nil
+	}
+	codeHash, _ := hex.DecodeString(dbPixKey.VerificationCodeHash)
+	return &verification.Verification{
+		CodeHash:  codeHash,
+		ExpiresAt: *dbPixKey.VerificationExpiresAt,
+		Attempts:  dbPixKey.VerificationAttempts,
 	}
 }
 
@@ -84,10 +477,19 @@ func PixKeysFromDB(dbPixKeys []PixKey) []repository.ListItem {
 	pixKeys := []repository.ListItem{}
 	for _, pixKey := range dbPixKeys {
 		pixKeys = append(pixKeys, repository.ListItem{
-			ID:   pixKey.ID,
-			Type: pixKey.Type,
-			Key:  pixKey.Key,
+			ID:     pixKey.ID,
+			Type:   pixKey.Type,
+			Key:    pixKey.Key,
+			Status: pixKey.Status,
 		})
 	}
 	return pixKeys
 }
+
+// transaction maps errors of the connection, which the transaction returns as they are.
+func (db Database) transaction(fn func(db *gorm.DB) error) error {
+	if db.Error != nil {
+		return databaseclient.MapError(db.Database.DB)
+	}
+	return db.Transaction(fn)
+}
@@ -3,21 +3,27 @@ package database_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"codepix/bank-api/lib/repositories"
 	"codepix/bank-api/pixkey"
 	"codepix/bank-api/pixkey/pixkeytest"
 	"codepix/bank-api/pixkey/repository"
 	"codepix/bank-api/pixkey/repository/database"
+	"codepix/bank-api/pixkey/verification"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var ValidPixKey = pixkeytest.ValidPixKey
 var Repo = pixkeytest.Repo
 
+var ignoreCreatedAt = cmpopts.IgnoreFields(pixkey.PixKey{}, "CreatedAt")
+
 func TestAdd(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -27,13 +33,14 @@ func TestAdd(t *testing.T) {
 	pixKey := ValidPixKey()
 	accountID, bankID := uuid.New(), uuid.New()
 
-	ID, err := repo.Add(pixKey, accountID, bankID)
+	ID, err := repo.Add(pixKey, accountID, bankID, nil)
 	assert.NotNil(t, ID)
 	assert.NoError(t, err)
 
 	persisted, IDs, err := repo.FindByKey(pixKey.Key)
 	assert.NoError(t, err)
-	assert.Empty(t, cmp.Diff(pixKey, *persisted))
+	assert.Empty(t, cmp.Diff(pixKey, *persisted, ignoreCreatedAt))
+	assert.False(t, persisted.CreatedAt.IsZero())
 	assert.Empty(t, cmp.Diff(repository.IDs{
 		PixKeyID:  *ID,
 		AccountID: accountID,
@@ -42,27 +49,70 @@ func TestAdd(t *testing.T) {
 
 	persisted, IDs, err = repo.Find(*ID)
 	assert.NoError(t, err)
-	assert.Empty(t, cmp.Diff(pixKey, *persisted))
+	assert.Empty(t, cmp.Diff(pixKey, *persisted, ignoreCreatedAt))
+	assert.False(t, persisted.CreatedAt.IsZero())
 	assert.Empty(t, cmp.Diff(repository.IDs{
 		PixKeyID:  *ID,
 		AccountID: accountID,
 		BankID:    bankID,
 	}, *IDs))
 
-	ID, err = repo.Add(pixKey, accountID, bankID)
+	ID, err = repo.Add(pixKey, accountID, bankID, nil)
 	assert.Nil(t, ID)
 	assert.IsType(t, &repositories.AlreadyExistsError{}, err)
 
-	ID, err = repo.Add(pixKey, uuid.New(), uuid.New())
+	ID, err = repo.Add(pixKey, uuid.New(), uuid.New(), nil)
 	assert.Nil(t, ID)
 	assert.IsType(t, &repositories.AlreadyExistsError{}, err)
 
 	repo.(*database.Database).AddError(errors.New("an error"))
-	ID, err = repo.Add(pixKey, accountID, bankID)
+	ID, err = repo.Add(pixKey, accountID, bankID, nil)
 	assert.Nil(t, ID)
 	assert.IsType(t, &repositories.InternalError{}, err)
 }
 
+func TestAddCheck(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	repo, _ := Repo()
+
+	accountID, bankID := uuid.New(), uuid.New()
+	_, err := repo.Add(ValidPixKey(), accountID, bankID, nil)
+	require.NoError(t, err)
+	_, err = repo.Add(ValidPixKey(), uuid.New(), bankID, nil)
+	require.NoError(t, err)
+	_, err = repo.Add(ValidPixKey(), accountID, uuid.New(), nil)
+	require.NoError(t, err)
+	randomKey := ValidPixKey()
+	randomKey.Type, randomKey.Key = pixkey.RandomKey, uuid.NewString()
+	_, err = repo.Add(randomKey, accountID, bankID, nil)
+	require.NoError(t, err)
+
+	var held repository.Held
+	check := func(h repository.Held) error {
+		held = h
+		return nil
+	}
+	_, err = repo.Add(ValidPixKey(), accountID, bankID, check)
+	assert.NoError(t, err)
+	assert.Equal(t, repository.Held{AccountKeys: 2, AccountRandomKeys: 1, OwnerKeys: 3}, held)
+
+	pixKey := ValidPixKey()
+	failing := func(repository.Held) error { return errors.New("cap reached") }
+	_, err = repo.Add(pixKey, accountID, bankID, failing)
+	assert.EqualError(t, err, "cap reached")
+	_, err = repo.AddPending(pixKey, accountID, bankID, verification.Verification{
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, failing)
+	assert.EqualError(t, err, "cap reached")
+	_, _, err = repo.FindByKey(pixKey.Key)
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+	listed, err := repo.List(repository.ListOptions{AccountID: accountID, BankID: bankID})
+	assert.NoError(t, err)
+	assert.Len(t, listed, 3)
+}
+
 func TestFind(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -74,7 +124,8 @@ func TestFind(t *testing.T) {
 
 	persisted, IDs, err := repo.Find(pixKeyIDs.PixKeyID)
 	assert.NoError(t, err)
-	assert.Empty(t, cmp.Diff(pixKey, *persisted))
+	assert.Empty(t, cmp.Diff(pixKey, *persisted, ignoreCreatedAt))
+	assert.False(t, persisted.CreatedAt.IsZero())
 	assert.Empty(t, cmp.Diff(pixKeyIDs, *IDs))
 
 	missingID := uuid.New()
@@ -101,7 +152,8 @@ func TestFindByKey(t *testing.T) {
 
 	persisted, IDs, err := repo.FindByKey(pixKey.Key)
 	assert.NoError(t, err)
-	assert.Empty(t, cmp.Diff(pixKey, *persisted))
+	assert.Empty(t, cmp.Diff(pixKey, *persisted, ignoreCreatedAt))
+	assert.False(t, persisted.CreatedAt.IsZero())
 	assert.Empty(t, cmp.Diff(pixKeyIDs, *IDs))
 
 	missingKey := "123"
@@ -136,11 +188,11 @@ func TestList(t *testing.T) {
 	for i := 0; i < nPixKeys; i++ {
 		pixKey := ValidPixKey()
 		pixKeys = append(pixKeys, pixKey)
-		ID, _ := repo.Add(pixKey, accountID, bankID)
+		ID, _ := repo.Add(pixKey, accountID, bankID, nil)
 		IDs = append(IDs, *ID)
 	}
 	for i := 0; i < nPixKeys; i++ {
-		repo.Add(ValidPixKey(), uuid.New(), uuid.New())
+		repo.Add(ValidPixKey(), uuid.New(), uuid.New(), nil)
 	}
 	options := repository.ListOptions{
 		AccountID: accountID,
@@ -152,9 +204,10 @@ func TestList(t *testing.T) {
 	assert.Len(t, persisted, nPixKeys)
 	for i := 0; i < nPixKeys; i++ {
 		expected := repository.ListItem{
-			ID:   IDs[i],
-			Type: pixKeys[i].Type,
-			Key:  pixKeys[i].Key,
+			ID:     IDs[i],
+			Type:   pixKeys[i].Type,
+			Key:    pixKeys[i].Key,
+			Status: pixkey.Active,
 		}
 		assert.Empty(t, cmp.Diff(persisted[i], expected))
 	}
@@ -177,8 +230,315 @@ func TestList(t *testing.T) {
 	assert.NotNil(t, missing)
 	assert.Empty(t, missing)
 
+	randomKey := pixkey.PixKey{Type: pixkey.RandomKey, Key: uuid.NewString()}
+	randomKeyID, _ := repo.Add(randomKey, accountID, bankID, nil)
+	random, err := repo.List(repository.ListOptions{
+		AccountID: accountID,
+		BankID:    bankID,
+		Type:      pixkey.RandomKey,
+	})
+	assert.NoError(t, err)
+	require.Len(t, random, 1)
+	assert.Equal(t, *randomKeyID, random[0].ID)
+
+	// owners are listed across their accounts, however their document was written
+	owner := ValidPixKey()
+	owner.Owner.Document = "52998224725"
+	ownerKeyIDs := []uuid.UUID{}
+	for _, ownerAccountID := range []uuid.UUID{uuid.New(), uuid.New()} {
+		ID, _ := repo.Add(owner, ownerAccountID, bankID, nil)
+		ownerKeyIDs = append(ownerKeyIDs, *ID)
+		owner.Key = ValidPixKey().Key
+	}
+	owned, err := repo.List(repository.ListOptions{
+		BankID:        bankID,
+		OwnerDocument: "529.982.247-25",
+	})
+	assert.NoError(t, err)
+	require.Len(t, owned, 2)
+	assert.ElementsMatch(t, ownerKeyIDs, []uuid.UUID{owned[0].ID, owned[1].ID})
+
 	repo.(*database.Database).AddError(errors.New("an error"))
 	missing, err = repo.List(options)
 	assert.Nil(t, missing)
 	assert.IsType(t, &repositories.InternalError{}, err)
 }
+
+func TestDelete(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	repo, creator := Repo()
+
+	pixKey := ValidPixKey()
+	pixKeyIDs := creator.PixKeyIDs(pixKey)
+
+	err := repo.Delete(pixKeyIDs.PixKeyID, uuid.New(), "closed account")
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+
+	err = repo.Delete(pixKeyIDs.PixKeyID, pixKeyIDs.BankID, "closed account")
+	assert.NoError(t, err)
+
+	persisted, _, err := repo.Find(pixKeyIDs.PixKeyID)
+	assert.NoError(t, err)
+	assert.Equal(t, pixkey.Deleted, persisted.Status)
+	assert.Equal(t, "closed account", persisted.DeletionReason)
+	assert.WithinDuration(t, time.Now(), persisted.DeletedAt, time.Minute)
+
+	_, _, err = repo.FindByKey(pixKey.Key)
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+	listed, err := repo.List(repository.ListOptions{
+		AccountID: pixKeyIDs.AccountID,
+		BankID:    pixKeyIDs.BankID,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, listed)
+
+	err = repo.Delete(pixKeyIDs.PixKeyID, pixKeyIDs.BankID, "closed account")
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+	err = repo.Block(pixKeyIDs.PixKeyID, pixKeyIDs.BankID)
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+
+	// deleted keys may be registered again
+	ID, err := repo.Add(pixKey, uuid.New(), uuid.New(), nil)
+	assert.NoError(t, err)
+	_, IDs, err := repo.FindByKey(pixKey.Key)
+	assert.NoError(t, err)
+	assert.Equal(t, *ID, IDs.PixKeyID)
+}
+
+func TestDropOldKeyIndex(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	repo, _ := Repo()
+	db := repo.(*database.Database)
+
+	err := db.Exec("create index idx_pix_keys_key on pix_keys (key)").Error
+	require.NoError(t, err)
+	assert.True(t, db.Migrator().HasIndex(&database.PixKey{}, "idx_pix_keys_key"))
+
+	err = db.DropOldKeyIndex()
+	assert.NoError(t, err)
+	assert.False(t, db.Migrator().HasIndex(&database.PixKey{}, "idx_pix_keys_key"))
+	assert.True(t, db.Migrator().HasIndex(&database.PixKey{}, "idx_pix_keys_live_key"))
+
+	err = db.DropOldKeyIndex()
+	assert.NoError(t, err)
+}
+
+func TestBlock(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	repo, creator := Repo()
+
+	pixKey := ValidPixKey()
+	pixKeyIDs := creator.PixKeyIDs(pixKey)
+
+	err := repo.Block(pixKeyIDs.PixKeyID, uuid.New())
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+
+	err = repo.Block(pixKeyIDs.PixKeyID, pixKeyIDs.BankID)
+	assert.NoError(t, err)
+	err = repo.Block(pixKeyIDs.PixKeyID, pixKeyIDs.BankID)
+	assert.NoError(t, err)
+
+	persisted, _, err := repo.Find(pixKeyIDs.PixKeyID)
+	assert.NoError(t, err)
+	assert.Equal(t, pixkey.Blocked, persisted.Status)
+	_, _, err = repo.FindByKey(pixKey.Key)
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+
+	// blocked keys still hold their key
+	_, err = repo.Add(pixKey, uuid.New(), uuid.New(), nil)
+	assert.IsType(t, &repositories.AlreadyExistsError{}, err)
+
+	err = repo.Unblock(pixKeyIDs.PixKeyID, pixKeyIDs.BankID)
+	assert.NoError(t, err)
+	persisted, _, err = repo.FindByKey(pixKey.Key)
+	assert.NoError(t, err)
+	assert.Equal(t, pixkey.Active, persisted.Status)
+	assert.True(t, persisted.DeletedAt.IsZero())
+
+	repo.(*database.Database).AddError(errors.New("an error"))
+	err = repo.Block(pixKeyIDs.PixKeyID, pixKeyIDs.BankID)
+	assert.IsType(t, &repositories.InternalError{}, err)
+}
+
+func TestVerify(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	repo, _ := Repo()
+
+	pixKey := ValidPixKey()
+	accountID, bankID := uuid.New(), uuid.New()
+	pending := verification.Verification{
+		CodeHash:  []byte{1, 2, 3},
+		ExpiresAt: time.Now().Add(time.Minute).Truncate(time.Millisecond),
+	}
+	ID, err := repo.AddPending(pixKey, accountID, bankID, pending, nil)
+	require.NoError(t, err)
+
+	persisted, _, err := repo.Find(*ID)
+	assert.NoError(t, err)
+	assert.Equal(t, pixkey.Pending, persisted.Status)
+	_, _, err = repo.FindByKey(pixKey.Key)
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+	// pending keys still hold their key until they expire
+	_, err = repo.AddPending(pixKey, uuid.New(), uuid.New(), pending, nil)
+	assert.IsType(t, &repositories.AlreadyExistsError{}, err)
+
+	_, err = repo.Attempt(*ID, uuid.New(), 2)
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+	attempted, err := repo.Attempt(*ID, bankID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, pending.CodeHash, attempted.CodeHash)
+	assert.True(t, pending.ExpiresAt.Equal(attempted.ExpiresAt))
+	assert.Equal(t, uint64(1), attempted.Attempts)
+	attempted, err = repo.Attempt(*ID, bankID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), attempted.Attempts)
+	_, err = repo.Attempt(*ID, bankID, 2)
+	assert.ErrorIs(t, err, verification.ErrNoAttemptsLeft)
+
+	err = repo.Verify(*ID, uuid.New())
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+	err = repo.Verify(*ID, bankID)
+	assert.NoError(t, err)
+	persisted, _, err = repo.FindByKey(pixKey.Key)
+	assert.NoError(t, err)
+	assert.Equal(t, pixkey.Active, persisted.Status)
+	err = repo.Verify(*ID, bankID)
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+	_, err = repo.Attempt(*ID, bankID, 2)
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+
+	expiredKey := ValidPixKey()
+	pending.ExpiresAt = time.Now().Add(-time.Minute)
+	expiredID, err := repo.AddPending(expiredKey, accountID, bankID, pending, nil)
+	require.NoError(t, err)
+	_, err = repo.AddPending(expiredKey, uuid.New(), uuid.New(), pending, nil)
+	assert.NoError(t, err)
+	expired, _, err := repo.Find(*expiredID)
+	assert.NoError(t, err)
+	assert.Equal(t, pixkey.Deleted, expired.Status)
+}
+
+func TestTransfer(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	repo, creator := Repo()
+
+	pixKey := ValidPixKey()
+	pixKeyIDs := creator.PixKeyIDs(pixKey)
+	accountID, bankID := uuid.New(), uuid.New()
+	account := pixkey.Account{Branch: "0002", Number: "87654321", Type: pixkey.Savings}
+
+	err := repo.Transfer(pixKeyIDs.PixKeyID, uuid.New(), accountID, bankID, account, nil)
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+
+	err = repo.Transfer(pixKeyIDs.PixKeyID, pixKeyIDs.BankID, accountID, bankID, account, nil)
+	assert.NoError(t, err)
+	// transferring again has no effect
+	err = repo.Transfer(pixKeyIDs.PixKeyID, pixKeyIDs.BankID, accountID, bankID, account, nil)
+	assert.NoError(t, err)
+
+	persisted, IDs, err := repo.FindByKey(pixKey.Key)
+	assert.NoError(t, err)
+	assert.Equal(t, pixkey.Active, persisted.Status)
+	assert.Equal(t, pixKeyIDs.PixKeyID, IDs.PixKeyID)
+	assert.Equal(t, accountID, IDs.AccountID)
+	assert.Equal(t, bankID, IDs.BankID)
+	assert.Equal(t, account, persisted.Account)
+	assert.Equal(t, pixKey.Owner, persisted.Owner)
+
+	// the donor bank no longer owns the key
+	err = repo.Delete(pixKeyIDs.PixKeyID, pixKeyIDs.BankID, "")
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+
+	err = repo.Delete(pixKeyIDs.PixKeyID, bankID, "")
+	assert.NoError(t, err)
+	err = repo.Transfer(pixKeyIDs.PixKeyID, bankID, uuid.New(), uuid.New(), account, nil)
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+
+	// the account receiving the key is checked as if the key was added to it
+	capped := ValidPixKey()
+	cappedIDs := creator.PixKeyIDs(capped)
+	var held repository.Held
+	failing := func(h repository.Held) error {
+		held = h
+		return errors.New("cap reached")
+	}
+	err = repo.Transfer(cappedIDs.PixKeyID, cappedIDs.BankID, accountID, bankID, account,
+		failing)
+	assert.EqualError(t, err, "cap reached")
+	// the key transferred before was deleted, so nothing is held at the receiving bank
+	assert.Equal(t, repository.Held{}, held)
+	_, IDs, err = repo.FindByKey(capped.Key)
+	assert.NoError(t, err)
+	assert.Equal(t, cappedIDs.BankID, IDs.BankID)
+
+	repo.(*database.Database).AddError(errors.New("an error"))
+	err = repo.Transfer(pixKeyIDs.PixKeyID, pixKeyIDs.BankID, accountID, bankID, account, nil)
+	assert.IsType(t, &repositories.InternalError{}, err)
+}
+
+func TestEvents(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	repo, _ := Repo()
+	events := repo.(repository.Events)
+
+	pixKey := ValidPixKey()
+	accountID, bankID := uuid.New(), uuid.New()
+	ID, err := repo.Add(pixKey, accountID, bankID, nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.Block(*ID, bankID))
+	require.NoError(t, repo.Block(*ID, bankID))
+	require.NoError(t, repo.Unblock(*ID, bankID))
+	newAccountID, newBankID := uuid.New(), uuid.New()
+	require.NoError(t, repo.Transfer(*ID, bankID, newAccountID, newBankID, pixKey.Account, nil))
+	require.NoError(t, repo.Transfer(*ID, bankID, newAccountID, newBankID, pixKey.Account, nil))
+	require.NoError(t, repo.Delete(*ID, newBankID, "reason"))
+
+	pending := ValidPixKey()
+	pendingID, err := repo.AddPending(pending, accountID, bankID, verification.Verification{
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.Delete(*pendingID, bankID, "reason"))
+
+	keyEvents := func() []repository.Event {
+		unpublished, err := events.Unpublished(1000)
+		require.NoError(t, err)
+		keyEvents := []repository.Event{}
+		for _, event := range unpublished {
+			if event.Event.AggregateID() == *ID || event.Event.AggregateID() == *pendingID {
+				keyEvents = append(keyEvents, event)
+			}
+		}
+		return keyEvents
+	}
+	unpublished := keyEvents()
+	expected := []any{
+		&pixkey.PixKeyRegistered{KeyType: pixKey.Type, Key: pixKey.Key,
+			AccountID: accountID, BankID: bankID},
+		&pixkey.PixKeyBlocked{Key: pixKey.Key, BankID: bankID},
+		&pixkey.PixKeyUnblocked{Key: pixKey.Key, BankID: bankID},
+		&pixkey.PixKeyTransferred{Key: pixKey.Key, DonorBank: bankID,
+			AccountID: newAccountID, BankID: newBankID},
+		&pixkey.PixKeyDeleted{Key: pixKey.Key, BankID: newBankID, Reason: "reason"},
+	}
+	require.Len(t, unpublished, len(expected), "pending keys are not published")
+	for i, event := range unpublished {
+		assert.Equal(t, pixkey.AggregateType, event.Event.AggregateType())
+		assert.Equal(t, expected[i], event.Event.Data())
+	}
+
+	assert.NoError(t, events.MarkPublished([]uuid.UUID{unpublished[0].ID}))
+	assert.Len(t, keyEvents(), len(expected)-1)
+}
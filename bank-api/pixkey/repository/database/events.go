@@ -0,0 +1,85 @@
+package database
+
+import (
+	"codepix/bank-api/adapters/databaseclient"
+	"codepix/bank-api/pixkey"
+	"codepix/bank-api/pixkey/repository"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"gorm.io/gorm"
+)
+
+var _ repository.Events = Database{}
+
+type event interface {
+	Type() eventhorizon.EventType
+}
+
+func (db Database) Unpublished(max int) ([]repository.Event, error) {
+	var events []PixKeyEvent
+	tx := db.Where("published = ?", false).Order("created_at").Limit(max).Find(&events)
+	if tx.Error != nil {
+		return nil, databaseclient.MapError(tx)
+	}
+	return PixKeyEventsFromDB(events)
+}
+
+func (db Database) MarkPublished(IDs []uuid.UUID) error {
+	tx := db.Model(&PixKeyEvent{}).Where("id in ?", IDs).Update("published", true)
+	return databaseclient.MapError(tx)
+}
+
+func addEvent(db *gorm.DB, pixKeyID uuid.UUID, data event) error {
+	event, err := NewPixKeyEvent(pixKeyID, data)
+	if err != nil {
+		return err
+	}
+	tx := db.Create(event)
+	return databaseclient.MapError(tx)
+}
+
+// PixKeyEvent is an event of a key change, kept until it is published.
+type PixKeyEvent struct {
+	databaseclient.BaseModel
+	Type      eventhorizon.EventType `gorm:"<-:create;"`
+	PixKeyID  uuid.UUID              `gorm:"<-:create;"`
+	Data      string                 `gorm:"<-:create;"`
+	Published bool                   `gorm:"index"`
+}
+
+func NewPixKeyEvent(pixKeyID uuid.UUID, data event) (*PixKeyEvent, error) {
+	dataJson, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("marshal pix key event: %w", err)
+	}
+	return &PixKeyEvent{
+		BaseModel: databaseclient.NewBaseModel(),
+		Type:      data.Type(),
+		PixKeyID:  pixKeyID,
+		Data:      string(dataJson),
+	}, nil
+}
+
+func PixKeyEventsFromDB(dbEvents []PixKeyEvent) ([]repository.Event, error) {
+	events := []repository.Event{}
+	for _, dbEvent := range dbEvents {
+		data, err := eventhorizon.CreateEventData(dbEvent.Type)
+		if err != nil {
+			return nil, fmt.Errorf("create pix key event data: %w", err)
+		}
+		err = json.Unmarshal([]byte(dbEvent.Data), data)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal pix key event: %w", err)
+		}
+		events = append(events, repository.Event{
+			ID: dbEvent.ID,
+			Event: eventhorizon.NewEvent(dbEvent.Type, data, dbEvent.CreatedAt,
+				eventhorizon.ForAggregate(pixkey.AggregateType, dbEvent.PixKeyID, 1),
+			),
+		})
+	}
+	return events, nil
+}
@@ -0,0 +1,69 @@
+package database
+
+import (
+	"codepix/bank-api/adapters/databaseclient"
+	"codepix/bank-api/pixkey"
+	"sort"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Conflict is a group of keys which are the same key once normalized. Only one of them
+// may keep it, so their banks must delete the others.
+type Conflict struct {
+	Key       pixkey.Key
+	PixKeyIDs []uuid.UUID
+}
+
+// NormalizeKeys normalizes the keys stored before keys were normalized. Keys which are
+// the same as others once normalized are left as they are and returned as conflicts, so
+// the migration can be run again once they are resolved. It reads every key, so it is
+// run once by the pixkeys command rather than on every start.
+func (db Database) NormalizeKeys() ([]Conflict, error) {
+	type stored struct {
+		ID  uuid.UUID
+		Key pixkey.Key
+	}
+	groups := map[pixkey.Key][]stored{}
+	var batch []PixKey
+	tx := db.Where("status <> ?", pixkey.Deleted).
+		FindInBatches(&batch, 1000, func(tx *gorm.DB, _ int) error {
+			for _, pixKey := range batch {
+				normalized := pixkey.Normalize(pixKey.Type, pixKey.Key)
+				groups[normalized] = append(groups[normalized], stored{pixKey.ID, pixKey.Key})
+			}
+			return nil
+		})
+	if tx.Error != nil {
+		return nil, databaseclient.MapError(tx)
+	}
+
+	keys := []pixkey.Key{}
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	conflicts := []Conflict{}
+	for _, key := range keys {
+		group := groups[key]
+		if len(group) > 1 {
+			conflict := Conflict{Key: key}
+			for _, pixKey := range group {
+				conflict.PixKeyIDs = append(conflict.PixKeyIDs, pixKey.ID)
+			}
+			conflicts = append(conflicts, conflict)
+			continue
+		}
+		if group[0].Key == key {
+			continue
+		}
+		// keys are only written on creation through the model
+		tx := db.Table("pix_keys").Where("id = ?", group[0].ID).Update("key", key)
+		if tx.Error != nil {
+			return nil, databaseclient.MapError(tx)
+		}
+	}
+	return conflicts, nil
+}
@@ -0,0 +1,64 @@
+package database_test
+
+import (
+	"codepix/bank-api/pixkey"
+	"codepix/bank-api/pixkey/repository/database"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeKeys(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	repo, _ := Repo()
+	db := repo.(*database.Database)
+
+	// keys stored before keys were normalized
+	addRaw := func(keyType pixkey.Type, key pixkey.Key) uuid.UUID {
+		raw := database.NewPixKey(pixkey.PixKey{Type: keyType}, uuid.New(), uuid.New())
+		raw.Key = key
+		require.NoError(t, db.Create(raw).Error)
+		return raw.ID
+	}
+	unique := uuid.NewString()
+	upper := addRaw(pixkey.EmailKey, "Name"+unique+"@Domain.com")
+	lower := addRaw(pixkey.EmailKey, "name"+unique+"@domain.com")
+	formatted := addRaw(pixkey.PhoneKey, "+55 (11) "+unique[:8])
+	alone := addRaw(pixkey.EmailKey, "Alone"+unique+"@Domain.com")
+
+	conflicts, err := db.NormalizeKeys()
+	require.NoError(t, err)
+
+	conflict := database.Conflict{
+		Key:       "name" + unique + "@domain.com",
+		PixKeyIDs: []uuid.UUID{upper, lower},
+	}
+	found := false
+	for _, c := range conflicts {
+		if c.Key == conflict.Key {
+			found = true
+			assert.ElementsMatch(t, conflict.PixKeyIDs, c.PixKeyIDs)
+		}
+	}
+	assert.True(t, found)
+
+	// conflicting keys are left as they are
+	pixKey, _, err := repo.Find(upper)
+	require.NoError(t, err)
+	assert.Equal(t, "Name"+unique+"@Domain.com", pixKey.Key)
+
+	pixKey, _, err = repo.Find(alone)
+	require.NoError(t, err)
+	assert.Equal(t, "alone"+unique+"@domain.com", pixKey.Key)
+	_, IDs, err := repo.FindByKey("ALONE" + unique + "@DOMAIN.COM")
+	require.NoError(t, err)
+	assert.Equal(t, alone, IDs.PixKeyID)
+
+	pixKey, _, err = repo.Find(formatted)
+	require.NoError(t, err)
+	assert.Equal(t, pixkey.Normalize(pixkey.PhoneKey, "+55 (11) "+unique[:8]), pixKey.Key)
+}
@@ -2,15 +2,51 @@ package repository
 
 import (
 	"codepix/bank-api/pixkey"
+	"codepix/bank-api/pixkey/verification"
 
 	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
 )
 
+// Repository stores keys. Keys are stored normalized, and FindByKey normalizes the key it
+// looks up. FindByKey only finds active keys, while Find also finds deleted and blocked
+// ones. Delete, Block and Unblock only change keys of the given bank, and Transfer moves a
+// key of the donor bank to an account of another bank.
+//
+// AddPending adds a key waiting for verification, replacing pending keys with the same
+// key whose verification expired. Attempt counts an attempt to verify a pending key of the
+// bank, failing with verification.ErrNoAttemptsLeft after maxAttempts, and Verify
+// activates it.
+//
+// Add and AddPending run check, when not nil, before adding the key, and return its error
+// without adding the key.
 type Repository interface {
-	Add(pixKey pixkey.PixKey, accountID, bankID uuid.UUID) (*uuid.UUID, error)
+	Add(pixKey pixkey.PixKey, accountID, bankID uuid.UUID, check Check) (*uuid.UUID, error)
+	AddPending(pixKey pixkey.PixKey, accountID, bankID uuid.UUID,
+		verification verification.Verification, check Check) (*uuid.UUID, error)
+	Attempt(ID, bankID uuid.UUID, maxAttempts uint64) (*verification.Verification, error)
+	Verify(ID, bankID uuid.UUID) error
 	Find(ID uuid.UUID) (*pixkey.PixKey, *IDs, error)
 	FindByKey(key pixkey.Key) (*pixkey.PixKey, *IDs, error)
 	List(options ListOptions) ([]ListItem, error)
+	Delete(ID, bankID uuid.UUID, reason string) error
+	Block(ID, bankID uuid.UUID) error
+	Unblock(ID, bankID uuid.UUID) error
+	Transfer(ID, donorBankID, accountID, bankID uuid.UUID, account pixkey.Account,
+		check Check) error
+}
+
+// Check returns an error if a key may not be added, given the keys its account and owner
+// already hold. No other key is added for the account or owner while it runs, so keys
+// counted against a cap cannot be added concurrently.
+type Check func(held Held) error
+
+// Held is how many keys which were not deleted an account and its owner hold at a bank.
+// Owners without a document hold no keys.
+type Held struct {
+	AccountKeys       uint64
+	AccountRandomKeys uint64
+	OwnerKeys         uint64
 }
 
 type IDs struct {
@@ -20,12 +56,29 @@ type IDs struct {
 }
 
 type ListItem struct {
-	ID   uuid.UUID
-	Type pixkey.Type
-	Key  pixkey.Key
+	ID     uuid.UUID
+	Type   pixkey.Type
+	Key    pixkey.Key
+	Status pixkey.Status
 }
 
+// ListOptions lists the keys of a bank which were not deleted. AccountID, OwnerDocument
+// and Type only list keys of that account, owner or type, when set.
 type ListOptions struct {
-	AccountID uuid.UUID
-	BankID    uuid.UUID
+	AccountID     uuid.UUID
+	BankID        uuid.UUID
+	OwnerDocument string
+	Type          pixkey.Type
+}
+
+// Events is the outbox of key events, which are written along with the key changes.
+// Unpublished lists at most max events not yet published, oldest first.
+type Events interface {
+	Unpublished(max int) ([]Event, error)
+	MarkPublished(IDs []uuid.UUID) error
+}
+
+type Event struct {
+	ID    uuid.UUID
+	Event eventhorizon.Event
 }
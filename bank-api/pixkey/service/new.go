@@ -3,9 +3,13 @@ package service
 import (
 	"bytes"
 	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/config"
+	"codepix/bank-api/lib/cnpj"
 	"codepix/bank-api/lib/validation"
 	"codepix/bank-api/pixkey"
+	"codepix/bank-api/pixkey/policy"
 	"codepix/bank-api/pixkey/repository"
+	"codepix/bank-api/pixkey/verification"
 	proto "codepix/bank-api/proto/codepix/pixkey"
 	_ "embed"
 
@@ -16,14 +20,24 @@ import (
 //go:embed translations.json
 var translations []byte
 
-func Register(server *grpc.Server, val *validation.Validator, repository repository.Repository,
+func Register(server *grpc.Server, config config.Config, val *validation.Validator,
+	repository repository.Repository, notifier verification.Notifier,
 ) error {
 	err := SetupValidator(val)
 	if err != nil {
 		return err
 	}
+	policy, err := policy.New(config, val)
+	if err != nil {
+		return err
+	}
 	service := &Service{
 		Repository: repository,
+		Policy:     policy,
+
+		Notifier:                notifier,
+		VerificationExpiry:      config.PixKey.VerificationExpiry,
+		VerificationMaxAttempts: config.PixKey.VerificationMaxAttempts,
 	}
 	proto.RegisterServiceServer(server, service)
 	return nil
@@ -32,6 +46,11 @@ func Register(server *grpc.Server, val *validation.Validator, repository reposit
 func SetupValidator(val *validation.Validator) error {
 	err := validator.LoadTranslationFile(val, bytes.NewReader(translations),
 		proto.RegisterRequest{},
+		proto.VerifyRequest{},
+		proto.LookupRequest{},
+		proto.DeleteRequest{},
+		proto.BlockRequest{},
+		proto.UnblockRequest{},
 	)
 	if err != nil {
 		return err
@@ -61,6 +80,29 @@ func SetupValidator(val *validation.Validator) error {
 					validation.IsValid(val, request.Key, "email")
 			},
 		},
+		validation.StructValidation[proto.RegisterRequest]{
+			Field: "Key",
+			Tag:   "cnpj_key",
+			IsValid: func(request *proto.RegisterRequest) bool {
+				return request.Type != proto.Type(pixkey.CNPJKey) ||
+					cnpj.IsValid(request.Key)
+			},
+		},
+		validation.StructValidation[proto.RegisterRequest]{
+			Field: "Key",
+			Tag:   "random_key",
+			IsValid: func(request *proto.RegisterRequest) bool {
+				return request.Type != proto.Type(pixkey.RandomKey) || request.Key == ""
+			},
+		},
+		validation.StructValidation[proto.RegisterRequest]{
+			Field: "OwnerDocument",
+			Tag:   "owner_document",
+			IsValid: func(request *proto.RegisterRequest) bool {
+				return request.OwnerDocument == "" ||
+					cpf.IsValid(request.OwnerDocument) || cnpj.IsValid(request.OwnerDocument)
+			},
+		},
 	)
 	return err
 }
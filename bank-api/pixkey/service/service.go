@@ -3,47 +3,157 @@ package service
 import (
 	"codepix/bank-api/adapters/rpc"
 	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/lib/validation"
 	"codepix/bank-api/pixkey"
+	"codepix/bank-api/pixkey/policy"
 	"codepix/bank-api/pixkey/repository"
+	"codepix/bank-api/pixkey/verification"
 	proto "codepix/bank-api/proto/codepix/pixkey"
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Service struct {
 	Repository repository.Repository
+	Policy     *policy.Policy
+	// Notifier sends the codes verifying phone and e-mail keys, which expire after
+	// VerificationExpiry and allow VerificationMaxAttempts attempts.
+	Notifier                verification.Notifier
+	VerificationExpiry      time.Duration
+	VerificationMaxAttempts uint64
 	proto.UnimplementedServiceServer
 }
 
 var _ proto.ServiceServer = Service{}
 
+// Register adds a key to an account of the calling bank, normalized so it cannot be
+// registered again written another way. Random keys are generated. Accounts are capped by
+// Policy in how many keys, and random keys, they hold. Phone and e-mail keys are added
+// pending, and a code is sent to them.
 func (s Service) Register(ctx context.Context, req *proto.RegisterRequest,
 ) (*proto.RegisterReply, error) {
 	bankID := auth.GetBankID(ctx)
 	accountID, _ := uuid.FromBytes(req.AccountId)
 
-	ID, err := s.Repository.Add(newPixKey(req), accountID, bankID)
-	return registerReply(ID), rpc.MapError(ctx, err)
+	pixKey := newPixKey(req)
+	if pixKey.Type == pixkey.RandomKey {
+		pixKey.Key = uuid.NewString()
+	}
+	check := s.Policy.Check(pixKey, accountID, bankID)
+	if verification.Required(pixKey.Type) {
+		return s.registerPending(ctx, pixKey, accountID, bankID, check)
+	}
+	ID, err := s.Repository.Add(pixKey, accountID, bankID, check)
+	return registerReply(ID, pixKey, pixkey.Active), s.mapError(ctx, err)
+}
+
+// registerPending deletes the key again if its code cannot be sent, so it can be
+// registered again right away.
+func (s Service) registerPending(ctx context.Context, pixKey pixkey.PixKey,
+	accountID, bankID uuid.UUID, check repository.Check,
+) (*proto.RegisterReply, error) {
+	pending, code, err := verification.New(pixKey.Key, s.VerificationExpiry)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	ID, err := s.Repository.AddPending(pixKey, accountID, bankID, *pending, check)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	err = s.Notifier.Notify(ctx, pixKey, code)
+	if err != nil {
+		deleteErr := s.Repository.Delete(*ID, bankID, "verification code not sent")
+		if deleteErr != nil {
+			return nil, status.Errorf(codes.Internal,
+				"send verification code: %s; delete pending key: %s", err, deleteErr)
+		}
+		return nil, status.Errorf(codes.Unavailable, "send verification code: %s", err)
+	}
+	return registerReply(ID, pixKey, pixkey.Pending), nil
+}
+
+// mapError maps the errors of Policy checks as validation errors.
+func (s Service) mapError(ctx context.Context, err error) error {
+	if validationErr, ok := err.(*validation.Error); ok {
+		return rpc.ValidationError(s.Policy.Validator, ctx, validationErr).Err()
+	}
+	return rpc.MapError(ctx, err)
 }
 
 func newPixKey(req *proto.RegisterRequest) pixkey.PixKey {
 	return pixkey.PixKey{
 		Type: pixkey.Type(req.Type),
-		Key:  req.Key,
+		Key:  pixkey.Normalize(pixkey.Type(req.Type), req.Key),
+		Owner: pixkey.Owner{
+			Name:     req.OwnerName,
+			Document: pixkey.NormalizeDocument(req.OwnerDocument),
+		},
+		Account: pixkey.Account{
+			Branch: req.Branch,
+			Number: req.AccountNumber,
+			Type:   pixkey.AccountType(req.AccountType),
+		},
 	}
 }
-func registerReply(ID *uuid.UUID) *proto.RegisterReply {
+func registerReply(ID *uuid.UUID, pixKey pixkey.PixKey, status pixkey.Status,
+) *proto.RegisterReply {
 	if ID == nil {
 		return nil
 	}
 	return &proto.RegisterReply{
-		Id: ID[:],
+		Id:     ID[:],
+		Key:    pixKey.Key,
+		Status: proto.Status(status),
 	}
 }
 
+// Verify activates a pending key of the calling bank if the code sent to it is right.
+// Every attempt counts, and expired codes are never right, so the key must be registered
+// again once they expire.
+func (s Service) Verify(ctx context.Context, req *proto.VerifyRequest,
+) (*proto.UpdateReply, error) {
+	bankID := auth.GetBankID(ctx)
+	ID, _ := uuid.FromBytes(req.Id)
+
+	pixKey, IDs, err := s.Repository.Find(ID)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	if IDs.BankID != bankID {
+		return nil, status.Error(codes.PermissionDenied, "")
+	}
+	if pixKey.Status != pixkey.Pending {
+		return nil, status.Error(codes.FailedPrecondition, "pix key is not pending")
+	}
+	pending, err := s.Repository.Attempt(ID, bankID, s.VerificationMaxAttempts)
+	if errors.Is(err, verification.ErrNoAttemptsLeft) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	if pending.Expired(time.Now()) {
+		return nil, status.Error(codes.DeadlineExceeded, "verification code expired")
+	}
+	if !pending.Matches(pixKey.Key, req.Code) {
+		return nil, status.Error(codes.PermissionDenied, "wrong verification code")
+	}
+	err = s.Repository.Verify(ID, bankID)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	return &proto.UpdateReply{
+		Id:     ID[:],
+		Status: proto.Status(pixkey.Active),
+	}, nil
+}
+
 func (s Service) Find(ctx context.Context, req *proto.FindRequest) (*proto.FindReply, error) {
 	bankID := auth.GetBankID(ctx)
 	ID, _ := uuid.FromBytes(req.Id)
@@ -59,12 +169,55 @@ func findReply(pixKey *pixkey.PixKey, IDs *repository.IDs) *proto.FindReply {
 	if pixKey == nil {
 		return nil
 	}
-	return &proto.FindReply{
-		Id:        IDs.PixKeyID[:],
-		Type:      proto.Type(pixKey.Type),
-		Key:       pixKey.Key,
-		AccountId: IDs.AccountID[:],
+	reply := &proto.FindReply{
+		Id:             IDs.PixKeyID[:],
+		Type:           proto.Type(pixKey.Type),
+		Key:            pixKey.Key,
+		AccountId:      IDs.AccountID[:],
+		Status:         proto.Status(pixKey.Status),
+		DeletionReason: pixKey.DeletionReason,
+		OwnerName:      pixKey.Owner.Name,
+		OwnerDocument:  pixKey.Owner.Document,
+		Branch:         pixKey.Account.Branch,
+		AccountNumber:  pixKey.Account.Number,
+		AccountType:    proto.AccountType(pixKey.Account.Type),
+	}
+	if !pixKey.CreatedAt.IsZero() {
+		reply.CreatedAt = timestamppb.New(pixKey.CreatedAt)
+	}
+	if !pixKey.DeletedAt.IsZero() {
+		reply.DeletedAt = timestamppb.New(pixKey.DeletedAt)
+	}
+	return reply
+}
+
+// Lookup finds an active key of any bank, so the paying bank can show who it pays before
+// sending a transaction. The owner is masked, as the calling bank may not hold the key.
+func (s Service) Lookup(ctx context.Context, req *proto.LookupRequest,
+) (*proto.LookupReply, error) {
+	pixKey, IDs, err := s.Repository.FindByKey(req.Key)
+	return lookupReply(pixKey, IDs), rpc.MapError(ctx, err)
+}
+
+func lookupReply(pixKey *pixkey.PixKey, IDs *repository.IDs) *proto.LookupReply {
+	if pixKey == nil {
+		return nil
+	}
+	owner := pixKey.Owner.Masked()
+	reply := &proto.LookupReply{
+		Type:          proto.Type(pixKey.Type),
+		Key:           pixKey.Key,
+		OwnerName:     owner.Name,
+		OwnerDocument: owner.Document,
+		BankId:        IDs.BankID[:],
+		Branch:        pixKey.Account.Branch,
+		AccountNumber: pixKey.Account.Number,
+		AccountType:   proto.AccountType(pixKey.Account.Type),
 	}
+	if !pixKey.CreatedAt.IsZero() {
+		reply.CreatedAt = timestamppb.New(pixKey.CreatedAt)
+	}
+	return reply
 }
 
 func (s Service) List(ctx context.Context, req *proto.ListRequest) (*proto.ListReply, error) {
@@ -87,12 +240,62 @@ func ListReply(pixKeys []repository.ListItem) *proto.ListReply {
 	for _, pixKey := range pixKeys {
 		ID := pixKey.ID
 		items = append(items, &proto.ListItem{
-			Id:   ID[:],
-			Type: proto.Type(pixKey.Type),
-			Key:  pixKey.Key,
+			Id:     ID[:],
+			Type:   proto.Type(pixKey.Type),
+			Key:    pixKey.Key,
+			Status: proto.Status(pixKey.Status),
 		})
 	}
 	return &proto.ListReply{
 		Items: items,
 	}
 }
+
+// Delete removes a key of the calling bank, so it no longer receives transactions and may
+// be registered again.
+func (s Service) Delete(ctx context.Context, req *proto.DeleteRequest,
+) (*proto.UpdateReply, error) {
+	ID, _ := uuid.FromBytes(req.Id)
+	return s.update(ctx, ID, pixkey.Deleted, func(bankID uuid.UUID) error {
+		return s.Repository.Delete(ID, bankID, req.Reason)
+	})
+}
+
+func (s Service) Block(ctx context.Context, req *proto.BlockRequest,
+) (*proto.UpdateReply, error) {
+	ID, _ := uuid.FromBytes(req.Id)
+	return s.update(ctx, ID, pixkey.Blocked, func(bankID uuid.UUID) error {
+		return s.Repository.Block(ID, bankID)
+	})
+}
+
+func (s Service) Unblock(ctx context.Context, req *proto.UnblockRequest,
+) (*proto.UpdateReply, error) {
+	ID, _ := uuid.FromBytes(req.Id)
+	return s.update(ctx, ID, pixkey.Active, func(bankID uuid.UUID) error {
+		return s.Repository.Unblock(ID, bankID)
+	})
+}
+
+// update changes a key after checking it belongs to the calling bank.
+func (s Service) update(ctx context.Context, ID uuid.UUID, updated pixkey.Status,
+	change func(bankID uuid.UUID) error,
+) (*proto.UpdateReply, error) {
+	bankID := auth.GetBankID(ctx)
+
+	_, IDs, err := s.Repository.Find(ID)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	if IDs.BankID != bankID {
+		return nil, status.Error(codes.PermissionDenied, "")
+	}
+	err = change(bankID)
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	return &proto.UpdateReply{
+		Id:     ID[:],
+		Status: proto.Status(updated),
+	}, nil
+}
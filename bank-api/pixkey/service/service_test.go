@@ -4,14 +4,17 @@ import (
 	rpc "codepix/bank-api/adapters/rpc"
 	"codepix/bank-api/adapters/validator"
 	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/config"
 	"codepix/bank-api/lib/repositories"
 	"codepix/bank-api/pixkey"
 	"codepix/bank-api/pixkey/pixkeytest"
 	"codepix/bank-api/pixkey/repository"
+	"codepix/bank-api/pixkey/verification"
 	proto "codepix/bank-api/proto/codepix/pixkey"
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
@@ -23,12 +26,15 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var ValidPixKey = pixkeytest.ValidPixKey
 var InvalidPixKey = pixkeytest.InvalidPixKey
 var Service = pixkeytest.Service
 var ServiceWithMocks = pixkeytest.ServiceWithMocks
+var Notifier = pixkeytest.Notifier
+var RegisterRequest = pixkeytest.RegisterRequest
 var AuthenticatedContext = bankapitest.AuthenticatedContext
 
 func TestRegister(t *testing.T) {
@@ -62,7 +68,7 @@ func TestRegister(t *testing.T) {
 	ctx := AuthenticatedContext(context.Background(), bankID)
 	ctxWithLocale := metadata.AppendToOutgoingContext(ctx, "locale", validator.EN_US)
 
-	validRequest := &request{Type: proto.Type(valid.Type), Key: valid.Key, AccountId: accountID[:]}
+	validRequest := RegisterRequest(valid, accountID[:])
 
 	testCases := []testCase{
 		{
@@ -74,7 +80,7 @@ func TestRegister(t *testing.T) {
 			out{
 				&ID,
 				nil,
-				&reply{Id: ID[:]},
+				&reply{Id: ID[:], Key: valid.Key, Status: proto.Status_Pending},
 				status.New(codes.OK, ""),
 			},
 		},
@@ -82,7 +88,7 @@ func TestRegister(t *testing.T) {
 			"invalid",
 			in{
 				ctxWithLocale,
-				&request{Type: proto.Type(invalid.Type), Key: invalid.Key, AccountId: nil},
+				RegisterRequest(invalid, nil),
 			},
 			out{
 				nil,
@@ -92,9 +98,14 @@ func TestRegister(t *testing.T) {
 					status, _ := status.New(codes.InvalidArgument,
 						"validation failed on type (required)").
 						WithDetails(rpc.ValidationErrorMessage(map[string]string{
-							"account_id": "account_id is a required field",
-							"key":        "Key must be a maximum of 100 characters in length",
-							"type":       "Key type is a required field",
+							"account_id":     "account_id is a required field",
+							"key":            "Key must be a maximum of 100 characters in length",
+							"type":           "Key type is a required field",
+							"owner_name":     "Owner name is a required field",
+							"owner_document": "Owner document is a required field",
+							"branch":         "Branch is a required field",
+							"account_number": "Account number is a required field",
+							"account_type":   "Account type is a required field",
 						}))
 					return status
 				}(),
@@ -143,7 +154,7 @@ func TestRegister(t *testing.T) {
 	for i, tc := range testCases {
 		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
 			if !(tc.out.ID == nil && tc.out.err == nil) {
-				repo.On("Add", mock.IsType(pixkey.PixKey{}), accountID).
+				repo.On("AddPending", mock.IsType(pixkey.PixKey{}), accountID).
 					Return(tc.out.ID, tc.out.err).Once()
 			}
 
@@ -197,12 +208,7 @@ func TestRegisterIntegration(t *testing.T) {
 		accountID, bankID := uuid.New(), uuid.New()
 
 		ctx := AuthenticatedContext(context.Background(), bankID)
-		request := &request{
-			Type:      proto.Type(pk.Type),
-			Key:       pk.Key,
-			AccountId: accountID[:],
-		}
-		return ctx, request
+		return ctx, RegisterRequest(pk, accountID[:])
 	}
 	invalidRequest := func() (context.Context, *request) {
 		pk := InvalidPixKey()
@@ -210,25 +216,15 @@ func TestRegisterIntegration(t *testing.T) {
 
 		ctx := AuthenticatedContext(context.Background(), bankID)
 		ctx = metadata.AppendToOutgoingContext(ctx, "locale", validator.EN_US)
-		request := &request{
-			Type:      proto.Type(pk.Type),
-			Key:       pk.Key,
-			AccountId: nil,
-		}
-		return ctx, request
+		return ctx, RegisterRequest(pk, nil)
 	}
 	alreadyExistsRequest := func() (context.Context, *request) {
 		pk := ValidPixKey()
 		accountID, bankID := uuid.New(), uuid.New()
-		repo.Add(pk, accountID, bankID)
+		repo.Add(pk, accountID, bankID, nil)
 
 		ctx := AuthenticatedContext(context.Background(), bankID)
-		request := &request{
-			Type:      proto.Type(pk.Type),
-			Key:       pk.Key,
-			AccountId: accountID[:],
-		}
-		return ctx, request
+		return ctx, RegisterRequest(pk, accountID[:])
 	}
 	unauthenticatedRequest := func() (context.Context, *request) {
 		return context.Background(), &request{}
@@ -248,9 +244,14 @@ func TestRegisterIntegration(t *testing.T) {
 				status, _ := status.New(codes.InvalidArgument,
 					"validation failed on type (required)").
 					WithDetails(rpc.ValidationErrorMessage(map[string]string{
-						"account_id": "account_id is a required field",
-						"key":        "Key must be a maximum of 100 characters in length",
-						"type":       "Key type is a required field",
+						"account_id":     "account_id is a required field",
+						"key":            "Key must be a maximum of 100 characters in length",
+						"type":           "Key type is a required field",
+						"owner_name":     "Owner name is a required field",
+						"owner_document": "Owner document is a required field",
+						"branch":         "Branch is a required field",
+						"account_number": "Account number is a required field",
+						"account_type":   "Account type is a required field",
 					}))
 				return status
 			}(),
@@ -289,6 +290,158 @@ func TestRegisterIntegration(t *testing.T) {
 	}
 }
 
+func TestRegisterNormalized(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	client, repo, _ := Service()
+
+	accountID, bankID := uuid.New(), uuid.New()
+	ctx := AuthenticatedContext(context.Background(), bankID)
+	unique := uuid.NewString()
+
+	register := func(keyType pixkey.Type, key pixkey.Key) (*proto.RegisterReply, error) {
+		pixKey := ValidPixKey()
+		pixKey.Type, pixKey.Key = keyType, key
+		return client.Register(ctx, RegisterRequest(pixKey, accountID[:]))
+	}
+
+	reply, err := register(pixkey.EmailKey, "Name"+unique+"@Domain.com")
+	require.NoError(t, err)
+	assert.Equal(t, "name"+unique+"@domain.com", reply.Key)
+	_, err = client.Verify(ctx, &proto.VerifyRequest{Id: reply.Id, Code: Notifier.Code(reply.Key)})
+	require.NoError(t, err)
+
+	// the same key written another way is the same key
+	_, err = register(pixkey.EmailKey, "NAME"+unique+"@DOMAIN.COM")
+	assert.Equal(t, codes.AlreadyExists.String(), status.Code(err).String())
+
+	_, IDs, err := repo.FindByKey("nAmE" + unique + "@dOmAiN.cOm")
+	require.NoError(t, err)
+	assert.Equal(t, reply.Id, IDs.PixKeyID[:])
+
+	reply, err = register(pixkey.CPFKey, "285.563.700-71")
+	require.NoError(t, err)
+	assert.Equal(t, "28556370071", reply.Key)
+	_, IDs, err = repo.FindByKey("285.563.700-71")
+	require.NoError(t, err)
+	assert.Equal(t, reply.Id, IDs.PixKeyID[:])
+	require.NoError(t, repo.Delete(IDs.PixKeyID, bankID, ""))
+}
+
+func TestRegisterRandom(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	client, repo, _ := Service()
+
+	accountID, bankID := uuid.New(), uuid.New()
+	ctx := AuthenticatedContext(context.Background(), bankID)
+	randomKey := ValidPixKey()
+	randomKey.Type, randomKey.Key = pixkey.RandomKey, ""
+	// companies may hold more keys than random keys
+	randomKey.Owner.Document = "11222333000181"
+	request := RegisterRequest(randomKey, accountID[:])
+
+	IDs := []uuid.UUID{}
+	for i := uint64(0); i < bankapitest.Config.PixKey.MaxRandomKeys; i++ {
+		reply, err := client.Register(ctx, request)
+		require.NoError(t, err)
+		_, err = uuid.Parse(reply.Key)
+		assert.NoError(t, err)
+
+		ID, _ := uuid.FromBytes(reply.Id)
+		pixKey, _, err := repo.Find(ID)
+		require.NoError(t, err)
+		assert.Equal(t, reply.Key, pixKey.Key)
+		IDs = append(IDs, ID)
+	}
+	ctxWithLocale := metadata.AppendToOutgoingContext(ctx, "locale", validator.EN_US)
+	_, err := client.Register(ctxWithLocale, request)
+	expected, _ := status.New(codes.InvalidArgument,
+		"validation failed on account_id (max_random_keys)").
+		WithDetails(rpc.ValidationErrorMessage(map[string]string{
+			"account_id": "Account already holds the most random Pix keys allowed",
+		}))
+	assert.Empty(t, cmp.Diff(expected.Proto(), status.Convert(err).Proto(), protocmp.Transform()))
+
+	// other accounts and deleted keys do not count
+	otherAccountID := uuid.New()
+	randomKey.Owner.Document = "52998224725"
+	_, err = client.Register(ctx, RegisterRequest(randomKey, otherAccountID[:]))
+	assert.NoError(t, err)
+
+	err = repo.Delete(IDs[0], bankID, "")
+	require.NoError(t, err)
+	_, err = client.Register(ctx, request)
+	assert.NoError(t, err)
+}
+
+func TestRegisterMaxKeys(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	client, _, _ := Service()
+
+	var bankID uuid.UUID
+	var maxKeys config.MaxKeys
+	for bankID, maxKeys = range bankapitest.Config.PixKey.BankMaxKeys {
+		break
+	}
+	require.Less(t, maxKeys.Individual, maxKeys.Company)
+
+	ctx := AuthenticatedContext(context.Background(), bankID)
+	ctxWithLocale := metadata.AppendToOutgoingContext(ctx, "locale", validator.EN_US)
+	register := func(ctx context.Context, accountID uuid.UUID, document string,
+	) (*proto.RegisterReply, error) {
+		pixKey := ValidPixKey()
+		pixKey.Owner.Document = document
+		return client.Register(ctx, RegisterRequest(pixKey, accountID[:]))
+	}
+	maxKeysError := func(fields ...string) *status.Status {
+		errorMap := map[string]string{}
+		for _, field := range fields {
+			errorMap[field] = map[string]string{
+				"account_id":     "Account already holds the most Pix keys allowed",
+				"owner_document": "Owner already holds the most Pix keys allowed",
+			}[field]
+		}
+		status, _ := status.New(codes.InvalidArgument,
+			"validation failed on "+fields[0]+" (max_keys)").
+			WithDetails(rpc.ValidationErrorMessage(errorMap))
+		return status
+	}
+
+	individual, company := "28556370071", "11222333000181"
+	accountID := uuid.New()
+	for i := uint64(0); i < maxKeys.Individual; i++ {
+		_, err := register(ctx, accountID, individual)
+		require.NoError(t, err)
+	}
+	_, err := register(ctxWithLocale, accountID, individual)
+	expected := maxKeysError("account_id", "owner_document")
+	assert.Empty(t, cmp.Diff(expected.Proto(), status.Convert(err).Proto(), protocmp.Transform()))
+
+	// owners are capped across their accounts
+	_, err = register(ctxWithLocale, uuid.New(), individual)
+	expected = maxKeysError("owner_document")
+	assert.Empty(t, cmp.Diff(expected.Proto(), status.Convert(err).Proto(), protocmp.Transform()))
+
+	// owners identified by a CNPJ are companies
+	accountID = uuid.New()
+	for i := uint64(0); i < maxKeys.Company; i++ {
+		_, err := register(ctx, accountID, company)
+		require.NoError(t, err)
+	}
+	_, err = register(ctx, accountID, company)
+	assert.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+
+	// other banks have the default caps
+	_, err = register(AuthenticatedContext(context.Background(), uuid.New()), uuid.New(),
+		individual)
+	assert.NoError(t, err)
+}
+
 func TestFind(t *testing.T) {
 	type request = proto.FindRequest
 	type reply = proto.FindReply
@@ -334,10 +487,16 @@ func TestFind(t *testing.T) {
 				&repository.IDs{PixKeyID: ID, AccountID: accountID, BankID: bankID},
 				nil,
 				&reply{
-					Id:        ID[:],
-					Type:      proto.Type(valid.Type),
-					Key:       valid.Key,
-					AccountId: accountID[:],
+					Id:            ID[:],
+					Type:          proto.Type(valid.Type),
+					Key:           valid.Key,
+					AccountId:     accountID[:],
+					Status:        proto.Status_Active,
+					OwnerName:     valid.Owner.Name,
+					OwnerDocument: valid.Owner.Document,
+					Branch:        valid.Account.Branch,
+					AccountNumber: valid.Account.Number,
+					AccountType:   proto.AccountType(valid.Account.Type),
 				},
 				codes.OK,
 			},
@@ -444,6 +603,17 @@ func TestFindIntegration(t *testing.T) {
 		expected := &pixkey.PixKey{
 			Type: pixkey.Type(reply.Type),
 			Key:  reply.Key,
+			Owner: pixkey.Owner{
+				Name:     reply.OwnerName,
+				Document: reply.OwnerDocument,
+			},
+			Account: pixkey.Account{
+				Branch: reply.Branch,
+				Number: reply.AccountNumber,
+				Type:   pixkey.AccountType(reply.AccountType),
+			},
+			Status:    pixkey.Status(reply.Status),
+			CreatedAt: reply.CreatedAt.AsTime(),
 		}
 		assert.Empty(t, cmp.Diff(expected, persisted))
 	}
@@ -517,6 +687,99 @@ func TestFindIntegration(t *testing.T) {
 	}
 }
 
+func TestLookup(t *testing.T) {
+	type request = proto.LookupRequest
+	type reply = proto.LookupReply
+
+	type testCase struct {
+		description string
+		ctx         context.Context
+		output      *pixkey.PixKey
+		outputIDs   *repository.IDs
+		err         error
+		reply       *reply
+		status      codes.Code
+	}
+
+	client, repo := ServiceWithMocks()
+
+	ID := uuid.New()
+	accountID, bankID := uuid.New(), uuid.New()
+	createdAt := time.Now()
+
+	// any bank looks up keys of other banks
+	ctx := AuthenticatedContext(context.Background(), uuid.New())
+
+	valid := ValidPixKey()
+	valid.CreatedAt = createdAt
+	validRequest := &request{Key: valid.Key}
+
+	testCases := []testCase{
+		{
+			"valid",
+			ctx,
+			&valid,
+			&repository.IDs{PixKeyID: ID, AccountID: accountID, BankID: bankID},
+			nil,
+			&reply{
+				Type:          proto.Type(valid.Type),
+				Key:           valid.Key,
+				OwnerName:     "João S.",
+				OwnerDocument: "***.563.700-**",
+				BankId:        bankID[:],
+				Branch:        valid.Account.Branch,
+				AccountNumber: valid.Account.Number,
+				AccountType:   proto.AccountType(valid.Account.Type),
+				CreatedAt:     timestamppb.New(createdAt),
+			},
+			codes.OK,
+		},
+		{
+			"not found",
+			ctx,
+			nil,
+			nil,
+			&repositories.NotFoundError{},
+			nil,
+			codes.NotFound,
+		},
+		{
+			"unauthenticated",
+			context.Background(),
+			nil,
+			nil,
+			nil,
+			nil,
+			codes.Unauthenticated,
+		},
+		{
+			"internal error",
+			ctx,
+			nil,
+			nil,
+			&repositories.InternalError{},
+			nil,
+			codes.Internal,
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			if !(tc.output == nil && tc.err == nil) {
+				repo.On("FindByKey", valid.Key).Return(tc.output, tc.outputIDs, tc.err).Once()
+			}
+
+			reply, err := client.Lookup(tc.ctx, validRequest)
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.status.String(), status.Code().String())
+
+			if tc.status == codes.OK {
+				assert.Empty(t, cmp.Diff(tc.reply, reply, protocmp.Transform()))
+			}
+		})
+	}
+}
+
 func TestList(t *testing.T) {
 	type request = proto.ListRequest
 	type reply = proto.ListReply
@@ -676,9 +939,10 @@ func TestListIntegration(t *testing.T) {
 			for i := 0; i < nPixKeys; i++ {
 				replyItem := reply.Items[i]
 				received := repository.ListItem{
-					ID:   *(*uuid.UUID)(replyItem.Id),
-					Type: pixkey.Type(replyItem.Type),
-					Key:  replyItem.Key,
+					ID:     *(*uuid.UUID)(replyItem.Id),
+					Type:   pixkey.Type(replyItem.Type),
+					Key:    replyItem.Key,
+					Status: pixkey.Status(replyItem.Status),
 				}
 				assert.Empty(t, cmp.Diff(pixKeys[i], received))
 			}
@@ -686,7 +950,7 @@ func TestListIntegration(t *testing.T) {
 	}
 
 	for i := 0; i < 10; i++ {
-		repo.Add(ValidPixKey(), uuid.New(), uuid.New())
+		repo.Add(ValidPixKey(), uuid.New(), uuid.New(), nil)
 	}
 	testCases := []testCase{
 		{
@@ -694,7 +958,7 @@ func TestListIntegration(t *testing.T) {
 			func() (context.Context, *request, uuid.UUID) {
 				accountID, bankID := uuid.New(), uuid.New()
 				for i := 0; i < 10; i++ {
-					repo.Add(ValidPixKey(), accountID, bankID)
+					repo.Add(ValidPixKey(), accountID, bankID, nil)
 				}
 				ctx := AuthenticatedContext(context.Background(), bankID)
 				return ctx, &request{AccountId: accountID[:]}, bankID
@@ -738,3 +1002,141 @@ func TestListIntegration(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdate(t *testing.T) {
+	client, repo := ServiceWithMocks()
+
+	ID, accountID, bankID := uuid.New(), uuid.New(), uuid.New()
+	ctx := AuthenticatedContext(context.Background(), bankID)
+	ctxWithLocale := metadata.AppendToOutgoingContext(ctx, "locale", validator.EN_US)
+	pixKey := ValidPixKey()
+	owned := &repository.IDs{PixKeyID: ID, AccountID: accountID, BankID: bankID}
+	notOwned := &repository.IDs{PixKeyID: ID, AccountID: accountID, BankID: uuid.New()}
+
+	del := func(ctx context.Context, reason string) (*proto.UpdateReply, error) {
+		return client.Delete(ctx, &proto.DeleteRequest{Id: ID[:], Reason: reason})
+	}
+	testCases := []struct {
+		description string
+		call        func() (*proto.UpdateReply, error)
+		findIDs     *repository.IDs
+		findErr     error
+		method      string
+		args        []any
+		err         error
+		code        codes.Code
+		status      proto.Status
+	}{
+		{"delete", func() (*proto.UpdateReply, error) { return del(ctx, "closed account") },
+			owned, nil, "Delete", []any{ID, bankID, "closed account"}, nil,
+			codes.OK, proto.Status_Deleted},
+		{"block", func() (*proto.UpdateReply, error) {
+			return client.Block(ctx, &proto.BlockRequest{Id: ID[:]})
+		}, owned, nil, "Block", []any{ID, bankID}, nil, codes.OK, proto.Status_Blocked},
+		{"unblock", func() (*proto.UpdateReply, error) {
+			return client.Unblock(ctx, &proto.UnblockRequest{Id: ID[:]})
+		}, owned, nil, "Unblock", []any{ID, bankID}, nil, codes.OK, proto.Status_Active},
+		{"already deleted", func() (*proto.UpdateReply, error) { return del(ctx, "again") },
+			owned, nil, "Delete", []any{ID, bankID, "again"}, &repositories.NotFoundError{},
+			codes.NotFound, 0},
+		{"not found", func() (*proto.UpdateReply, error) { return del(ctx, "closed account") },
+			nil, &repositories.NotFoundError{}, "", nil, nil, codes.NotFound, 0},
+		{"permission denied", func() (*proto.UpdateReply, error) {
+			return del(ctx, "closed account")
+		}, notOwned, nil, "", nil, nil, codes.PermissionDenied, 0},
+		{"invalid", func() (*proto.UpdateReply, error) { return del(ctxWithLocale, "") },
+			nil, nil, "", nil, nil, codes.InvalidArgument, 0},
+		{"unauthenticated", func() (*proto.UpdateReply, error) {
+			return del(context.Background(), "closed account")
+		}, nil, nil, "", nil, nil, codes.Unauthenticated, 0},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			if tc.findIDs != nil || tc.findErr != nil {
+				repo.On("Find", ID).Return(&pixKey, tc.findIDs, tc.findErr).Once()
+			}
+			if tc.method != "" {
+				repo.On(tc.method, tc.args...).Return(tc.err).Once()
+			}
+
+			reply, err := tc.call()
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.code.String(), status.Code().String())
+			if tc.code == codes.OK {
+				require.NotNil(t, reply)
+				assert.Equal(t, ID[:], reply.Id)
+				assert.Equal(t, tc.status, reply.Status)
+			}
+		})
+	}
+	repo.AssertExpectations(t)
+}
+
+func TestVerifyIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	client, repo, _ := Service()
+
+	accountID, bankID := uuid.New(), uuid.New()
+	ctx := AuthenticatedContext(context.Background(), bankID)
+	otherBankCtx := AuthenticatedContext(context.Background(), uuid.New())
+
+	register := func() (uuid.UUID, pixkey.Key) {
+		reply, err := client.Register(ctx, RegisterRequest(ValidPixKey(), accountID[:]))
+		require.NoError(t, err)
+		assert.Equal(t, proto.Status_Pending, reply.Status)
+		ID, _ := uuid.FromBytes(reply.Id)
+		return ID, reply.Key
+	}
+	verify := func(ctx context.Context, ID uuid.UUID, code string) codes.Code {
+		_, err := client.Verify(ctx, &proto.VerifyRequest{Id: ID[:], Code: code})
+		return status.Code(err)
+	}
+	wrong := func(code string) string {
+		if code == "000000" {
+			return "000001"
+		}
+		return "000000"
+	}
+
+	ID, key := register()
+	code := Notifier.Code(key)
+	_, _, err := repo.FindByKey(key)
+	assert.IsType(t, &repositories.NotFoundError{}, err, "pending keys are not found by key")
+	_, err = client.Unblock(ctx, &proto.UnblockRequest{Id: ID[:]})
+	assert.Equal(t, codes.NotFound.String(), status.Code(err).String(),
+		"pending keys are not activated by unblocking them")
+
+	assert.Equal(t, codes.InvalidArgument, verify(ctx, ID, "12ab"))
+	assert.Equal(t, codes.PermissionDenied, verify(otherBankCtx, ID, code))
+	assert.Equal(t, codes.PermissionDenied, verify(ctx, ID, wrong(code)))
+	assert.Equal(t, codes.OK, verify(ctx, ID, code))
+	pixKey, _, err := repo.FindByKey(key)
+	require.NoError(t, err)
+	assert.Equal(t, pixkey.Active, pixKey.Status)
+	assert.Equal(t, codes.FailedPrecondition, verify(ctx, ID, code))
+
+	ID, key = register()
+	code = Notifier.Code(key)
+	for i := uint64(0); i < bankapitest.Config.PixKey.VerificationMaxAttempts; i++ {
+		assert.Equal(t, codes.PermissionDenied, verify(ctx, ID, wrong(code)))
+	}
+	assert.Equal(t, codes.ResourceExhausted, verify(ctx, ID, code))
+
+	expired := ValidPixKey()
+	pending, code, err := verification.New(expired.Key, -time.Minute)
+	require.NoError(t, err)
+	expiredID, err := repo.AddPending(expired, accountID, bankID, *pending, nil)
+	require.NoError(t, err)
+	assert.Equal(t, codes.DeadlineExceeded, verify(ctx, *expiredID, code))
+
+	// expired keys are registered again, even by another bank
+	reply, err := client.Register(otherBankCtx, RegisterRequest(expired, accountID[:]))
+	require.NoError(t, err)
+	assert.Equal(t, proto.Status_Pending, reply.Status)
+	pixKey, _, err = repo.Find(*expiredID)
+	require.NoError(t, err)
+	assert.Equal(t, pixkey.Deleted, pixKey.Status)
+}
@@ -21,6 +21,17 @@ var Validator = func() *validation.Validator {
 	return val
 }()
 
+// withOwner fills the owner and account of a request, which are validated apart from the
+// key.
+func withOwner(request *proto.RegisterRequest) *proto.RegisterRequest {
+	request.OwnerName = "Name"
+	request.OwnerDocument = "28556370071"
+	request.Branch = "0001"
+	request.AccountNumber = "12345678"
+	request.AccountType = proto.AccountType(pixkey.Checking)
+	return request
+}
+
 func TestRegisterValidCases(t *testing.T) {
 	ID := uuid.New()
 	aID := ID[:]
@@ -43,9 +54,17 @@ func TestRegisterValidCases(t *testing.T) {
 		{Type: proto.Type(pixkey.EmailKey), Key: "name@domain.com", AccountId: aID},
 		{Type: proto.Type(pixkey.EmailKey), Key: "name@subdomain.domain.com", AccountId: aID},
 		{Type: proto.Type(pixkey.EmailKey), Key: strings.Repeat("a", 89) + "@domain.com", AccountId: aID},
+
+		{Type: proto.Type(pixkey.CNPJKey), Key: " 11222333000181", AccountId: aID},
+		{Type: proto.Type(pixkey.CNPJKey), Key: "11222333000181", AccountId: aID},
+		{Type: proto.Type(pixkey.CNPJKey), Key: "11.222.333/0001-81", AccountId: aID},
+
+		{Type: proto.Type(pixkey.RandomKey), Key: "", AccountId: aID},
+		{Type: proto.Type(pixkey.RandomKey), Key: "   ", AccountId: aID},
 	}
 	for i, tc := range validCases {
 		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			withOwner(tc)
 			trimmedKey := strings.TrimSpace(tc.Key)
 
 			modifier.Mold(tc)
@@ -65,6 +84,7 @@ func TestRegisterInvalidCases(t *testing.T) {
 		{},
 		{Type: proto.Type(0), Key: "name@domain.com", AccountId: aID},
 		{Type: proto.Type(4), Key: "name@domain.com", AccountId: aID},
+		{Type: proto.Type(6), Key: "name@domain.com", AccountId: aID},
 
 		{Type: proto.Type(pixkey.CPFKey), Key: "", AccountId: aID},
 		{Type: proto.Type(pixkey.CPFKey), Key: "   ", AccountId: aID},
@@ -92,6 +112,15 @@ func TestRegisterInvalidCases(t *testing.T) {
 		{Type: proto.Type(pixkey.EmailKey), Key: strings.Repeat("a", 90) + "@domain.com", AccountId: aID},
 		{Type: proto.Type(pixkey.EmailKey), Key: "name", AccountId: aID},
 
+		{Type: proto.Type(pixkey.CNPJKey), Key: "", AccountId: aID},
+		{Type: proto.Type(pixkey.CNPJKey), Key: "11222333000182", AccountId: aID},
+		{Type: proto.Type(pixkey.CNPJKey), Key: "11.222.333/0001", AccountId: aID},
+		{Type: proto.Type(pixkey.CNPJKey), Key: "28556370071", AccountId: aID},
+		{Type: proto.Type(pixkey.CNPJKey), Key: "11.111.111/1111-11", AccountId: aID},
+
+		{Type: proto.Type(pixkey.RandomKey), Key: uuid.NewString(), AccountId: aID},
+		{Type: proto.Type(pixkey.RandomKey), Key: "name@domain.com", AccountId: aID},
+
 		{Type: proto.Type(pixkey.EmailKey), Key: "name@domain.com", AccountId: nil},
 		{Type: proto.Type(pixkey.EmailKey), Key: "name@domain.com", AccountId: []byte{}},
 		{Type: proto.Type(pixkey.EmailKey), Key: "name@domain.com", AccountId: aID[:15]},
@@ -99,6 +128,7 @@ func TestRegisterInvalidCases(t *testing.T) {
 	}
 	for i, tc := range testCases {
 		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			withOwner(tc)
 			trimmedKey := strings.TrimSpace(tc.Key)
 
 			modifier.Mold(tc)
@@ -109,3 +139,51 @@ func TestRegisterInvalidCases(t *testing.T) {
 		})
 	}
 }
+
+func TestRegisterOwnerCases(t *testing.T) {
+	ID := uuid.New()
+	request := func(change func(request *proto.RegisterRequest)) *proto.RegisterRequest {
+		request := withOwner(&proto.RegisterRequest{
+			Type:      proto.Type(pixkey.EmailKey),
+			Key:       "name@domain.com",
+			AccountId: ID[:],
+		})
+		change(request)
+		return request
+	}
+	validCases := []*proto.RegisterRequest{
+		request(func(r *proto.RegisterRequest) { r.OwnerDocument = "285.563.700-71" }),
+		request(func(r *proto.RegisterRequest) { r.OwnerDocument = "11222333000181" }),
+		request(func(r *proto.RegisterRequest) { r.OwnerDocument = "11.222.333/0001-81" }),
+		request(func(r *proto.RegisterRequest) { r.OwnerName = strings.Repeat("a", 140) }),
+		request(func(r *proto.RegisterRequest) { r.AccountType = proto.AccountType(pixkey.Salary) }),
+	}
+	for i, tc := range validCases {
+		t.Run(fmt.Sprint("valid_", i), func(t *testing.T) {
+			modifier.Mold(tc)
+			assert.NoError(t, validation.Validate(Validator, tc))
+		})
+	}
+	invalidCases := []*proto.RegisterRequest{
+		request(func(r *proto.RegisterRequest) { r.OwnerName = "" }),
+		request(func(r *proto.RegisterRequest) { r.OwnerName = "   " }),
+		request(func(r *proto.RegisterRequest) { r.OwnerName = strings.Repeat("a", 141) }),
+		request(func(r *proto.RegisterRequest) { r.OwnerDocument = "" }),
+		request(func(r *proto.RegisterRequest) { r.OwnerDocument = "28556370072" }),
+		request(func(r *proto.RegisterRequest) { r.OwnerDocument = "11222333000182" }),
+		request(func(r *proto.RegisterRequest) { r.OwnerDocument = "name@domain.com" }),
+		request(func(r *proto.RegisterRequest) { r.Branch = "" }),
+		request(func(r *proto.RegisterRequest) { r.Branch = "001" }),
+		request(func(r *proto.RegisterRequest) { r.Branch = "000A" }),
+		request(func(r *proto.RegisterRequest) { r.AccountNumber = "" }),
+		request(func(r *proto.RegisterRequest) { r.AccountNumber = strings.Repeat("1", 21) }),
+		request(func(r *proto.RegisterRequest) { r.AccountType = 0 }),
+		request(func(r *proto.RegisterRequest) { r.AccountType = 5 }),
+	}
+	for i, tc := range invalidCases {
+		t.Run(fmt.Sprint("invalid_", i), func(t *testing.T) {
+			modifier.Mold(tc)
+			assert.IsType(t, &validation.Error{}, validation.Validate(Validator, tc))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package stream
+
+import (
+	"codepix/bank-api/adapters/eventbus"
+	"codepix/bank-api/config"
+	"codepix/bank-api/pixkey"
+	proto "codepix/bank-api/proto/codepix/pixkey"
+	txstream "codepix/bank-api/transaction/read/stream"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+	"google.golang.org/grpc"
+)
+
+func Register(server *grpc.Server, config config.Config, logger logr.Logger,
+	eventBus *eventbus.EventBus) error {
+	cfg := config.Transaction
+
+	busReader, err := eventBus.CreateReader(cfg.BusBlockDuration, cfg.BusMaxPendingAge)
+	if err != nil {
+		return err
+	}
+	stream := &Stream{
+		Consumer: txstream.Stream{
+			Logger:    logger.WithName("pixkeystream"),
+			BusReader: busReader,
+		},
+	}
+	proto.RegisterStreamServer(server, stream)
+	return nil
+}
+
+func SetupWriters(eventBus *eventbus.EventBus) error {
+	err := eventBus.SetupWriter(pixkey.RegisteredEvent, func(event eventhorizon.Event) []string {
+		registered := event.Data().(*pixkey.PixKeyRegistered)
+		return []string{
+			pixkey.RegisteredStream(registered.BankID),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = eventBus.SetupWriter(pixkey.DeletedEvent, func(event eventhorizon.Event) []string {
+		deleted := event.Data().(*pixkey.PixKeyDeleted)
+		return []string{
+			pixkey.DeletedStream(deleted.BankID),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = eventBus.SetupWriter(pixkey.BlockedEvent, func(event eventhorizon.Event) []string {
+		blocked := event.Data().(*pixkey.PixKeyBlocked)
+		return []string{
+			pixkey.BlockedStream(blocked.BankID),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = eventBus.SetupWriter(pixkey.UnblockedEvent, func(event eventhorizon.Event) []string {
+		unblocked := event.Data().(*pixkey.PixKeyUnblocked)
+		return []string{
+			pixkey.UnblockedStream(unblocked.BankID),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = eventBus.SetupWriter(pixkey.TransferredEvent, func(event eventhorizon.Event) []string {
+		transferred := event.Data().(*pixkey.PixKeyTransferred)
+		return []string{
+			pixkey.TransferredStream(transferred.DonorBank),
+			pixkey.TransferredStream(transferred.BankID),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
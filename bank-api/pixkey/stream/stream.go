@@ -0,0 +1,191 @@
+package stream
+
+import (
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/pixkey"
+	proto "codepix/bank-api/proto/codepix/pixkey"
+	txproto "codepix/bank-api/proto/codepix/transaction/read"
+	txstream "codepix/bank-api/transaction/read/stream"
+
+	"github.com/looplab/eventhorizon"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Stream sends key changes to the bank which holds the key, and transfers to the donor
+// bank too, consuming them the same way the transaction stream does.
+type Stream struct {
+	Consumer txstream.Stream
+	proto.UnimplementedStreamServer
+}
+
+var _ proto.StreamServer = Stream{}
+
+type ackReceiver interface {
+	Recv() (*proto.Ack, error)
+}
+
+func receiveAck(stream ackReceiver) func() (*txproto.Ack, error) {
+	return func() (*txproto.Ack, error) {
+		ack, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return &txproto.Ack{Nacks: ack.Nacks}, nil
+	}
+}
+
+func (s Stream) Registered(stream proto.Stream_RegisteredServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.RegisteredKey{}
+		for _, event := range events {
+			p := registeredMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.RegisteredKeys{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consumer.Consume(stream.Context(),
+		sender,
+		receiveAck(stream),
+		pixkey.RegisteredEvent,
+		pixkey.RegisteredStream(bankID),
+		bankID.String(),
+	)
+}
+func registeredMapper(event eventhorizon.Event) *proto.RegisteredKey {
+	ID := event.AggregateID()
+	registered := event.Data().(*pixkey.PixKeyRegistered)
+	return &proto.RegisteredKey{
+		Id:        ID[:],
+		Timestamp: timestamppb.New(event.Timestamp()),
+		Type:      proto.Type(registered.KeyType),
+		Key:       registered.Key,
+		AccountId: registered.AccountID[:],
+	}
+}
+
+func (s Stream) Deleted(stream proto.Stream_DeletedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.DeletedKey{}
+		for _, event := range events {
+			p := deletedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.DeletedKeys{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consumer.Consume(stream.Context(),
+		sender,
+		receiveAck(stream),
+		pixkey.DeletedEvent,
+		pixkey.DeletedStream(bankID),
+		bankID.String(),
+	)
+}
+func deletedMapper(event eventhorizon.Event) *proto.DeletedKey {
+	ID := event.AggregateID()
+	deleted := event.Data().(*pixkey.PixKeyDeleted)
+	return &proto.DeletedKey{
+		Id:        ID[:],
+		Timestamp: timestamppb.New(event.Timestamp()),
+		Key:       deleted.Key,
+		Reason:    deleted.Reason,
+	}
+}
+
+func (s Stream) Blocked(stream proto.Stream_BlockedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.BlockedKey{}
+		for _, event := range events {
+			p := blockedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.BlockedKeys{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consumer.Consume(stream.Context(),
+		sender,
+		receiveAck(stream),
+		pixkey.BlockedEvent,
+		pixkey.BlockedStream(bankID),
+		bankID.String(),
+	)
+}
+func blockedMapper(event eventhorizon.Event) *proto.BlockedKey {
+	ID := event.AggregateID()
+	blocked := event.Data().(*pixkey.PixKeyBlocked)
+	return &proto.BlockedKey{
+		Id:        ID[:],
+		Timestamp: timestamppb.New(event.Timestamp()),
+		Key:       blocked.Key,
+	}
+}
+
+func (s Stream) Unblocked(stream proto.Stream_UnblockedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.UnblockedKey{}
+		for _, event := range events {
+			p := unblockedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.UnblockedKeys{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consumer.Consume(stream.Context(),
+		sender,
+		receiveAck(stream),
+		pixkey.UnblockedEvent,
+		pixkey.UnblockedStream(bankID),
+		bankID.String(),
+	)
+}
+func unblockedMapper(event eventhorizon.Event) *proto.UnblockedKey {
+	ID := event.AggregateID()
+	unblocked := event.Data().(*pixkey.PixKeyUnblocked)
+	return &proto.UnblockedKey{
+		Id:        ID[:],
+		Timestamp: timestamppb.New(event.Timestamp()),
+		Key:       unblocked.Key,
+	}
+}
+
+func (s Stream) Transferred(stream proto.Stream_TransferredServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.TransferredKey{}
+		for _, event := range events {
+			p := transferredMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.TransferredKeys{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consumer.Consume(stream.Context(),
+		sender,
+		receiveAck(stream),
+		pixkey.TransferredEvent,
+		pixkey.TransferredStream(bankID),
+		bankID.String(),
+	)
+}
+func transferredMapper(event eventhorizon.Event) *proto.TransferredKey {
+	ID := event.AggregateID()
+	transferred := event.Data().(*pixkey.PixKeyTransferred)
+	return &proto.TransferredKey{
+		Id:        ID[:],
+		Timestamp: timestamppb.New(event.Timestamp()),
+		Key:       transferred.Key,
+		DonorBank: transferred.DonorBank[:],
+		AccountId: transferred.AccountID[:],
+		Bank:      transferred.BankID[:],
+	}
+}
@@ -0,0 +1,15 @@
+package pixkey
+
+import "github.com/google/uuid"
+
+const registeredStream = string(RegisteredEvent) + "_"
+const deletedStream = string(DeletedEvent) + "_"
+const blockedStream = string(BlockedEvent) + "_"
+const unblockedStream = string(UnblockedEvent) + "_"
+const transferredStream = string(TransferredEvent) + "_"
+
+func RegisteredStream(bankID uuid.UUID) string  { return registeredStream + bankID.String() }
+func DeletedStream(bankID uuid.UUID) string     { return deletedStream + bankID.String() }
+func BlockedStream(bankID uuid.UUID) string     { return blockedStream + bankID.String() }
+func UnblockedStream(bankID uuid.UUID) string   { return unblockedStream + bankID.String() }
+func TransferredStream(bankID uuid.UUID) string { return transferredStream + bankID.String() }
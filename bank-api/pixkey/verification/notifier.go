@@ -0,0 +1,98 @@
+package verification
+
+import (
+	"bytes"
+	"codepix/bank-api/config"
+	"codepix/bank-api/pixkey"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+)
+
+// Notifier sends verification codes to the phone or e-mail of a key.
+type Notifier interface {
+	Notify(ctx context.Context, pixKey pixkey.PixKey, code string) error
+}
+
+// NewNotifier returns the notifier selected by the config.
+func NewNotifier(config config.Config, logger logr.Logger) (Notifier, error) {
+	switch config.PixKey.Notifier {
+	case "webhook":
+		return WebhookNotifier{
+			URL:    config.PixKey.NotifierURL,
+			Token:  config.PixKey.NotifierToken,
+			Client: &http.Client{Timeout: config.PixKey.NotifierTimeout},
+		}, nil
+	case "log":
+		return LogNotifier{Logger: logger}, nil
+	default:
+		return nil, fmt.Errorf("unknown notifier %q", config.PixKey.Notifier)
+	}
+}
+
+// WebhookNotifier posts codes to the service delivering them by SMS or e-mail.
+type WebhookNotifier struct {
+	URL    string
+	Token  string
+	Client *http.Client
+}
+
+var _ Notifier = WebhookNotifier{}
+
+type notification struct {
+	Channel string `json:"channel"`
+	To      string `json:"to"`
+	Code    string `json:"code"`
+}
+
+func (n WebhookNotifier) Notify(ctx context.Context, pixKey pixkey.PixKey, code string) error {
+	var channel string
+	switch pixKey.Type {
+	case pixkey.PhoneKey:
+		channel = "sms"
+	case pixkey.EmailKey:
+		channel = "email"
+	default:
+		return fmt.Errorf("keys of type %d are not verified", pixKey.Type)
+	}
+	body, err := json.Marshal(notification{
+		Channel: channel,
+		To:      pixKey.Key,
+		Code:    code,
+	})
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, n.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if n.Token != "" {
+		request.Header.Set("Authorization", "Bearer "+n.Token)
+	}
+	response, err := n.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("notifier replied %s", response.Status)
+	}
+	return nil
+}
+
+// LogNotifier logs codes instead of sending them, for tests and local runs only.
+type LogNotifier struct {
+	Logger logr.Logger
+}
+
+var _ Notifier = LogNotifier{}
+
+func (n LogNotifier) Notify(ctx context.Context, pixKey pixkey.PixKey, code string) error {
+	n.Logger.Info("verification code", "type", pixKey.Type, "key", pixKey.Key, "code", code)
+	return nil
+}
@@ -0,0 +1,54 @@
+package verification_test
+
+import (
+	"codepix/bank-api/pixkey"
+	"codepix/bank-api/pixkey/verification"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	var received map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+	notifier := verification.WebhookNotifier{
+		URL:    server.URL,
+		Token:  "token",
+		Client: server.Client(),
+	}
+	ctx := context.Background()
+
+	err := notifier.Notify(ctx, pixkey.PixKey{Type: pixkey.EmailKey, Key: "name@domain.com"}, "123456")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"channel": "email",
+		"to":      "name@domain.com",
+		"code":    "123456",
+	}, received)
+
+	err = notifier.Notify(ctx, pixkey.PixKey{Type: pixkey.PhoneKey, Key: "+5511987654321"}, "654321")
+	require.NoError(t, err)
+	assert.Equal(t, "sms", received["channel"])
+
+	err = notifier.Notify(ctx, pixkey.PixKey{Type: pixkey.CPFKey, Key: "12345678909"}, "123456")
+	assert.Error(t, err)
+
+	notifier.Token = "other"
+	err = notifier.Notify(ctx, pixkey.PixKey{Type: pixkey.EmailKey, Key: "name@domain.com"}, "123456")
+	assert.ErrorContains(t, err, "401")
+}
@@ -0,0 +1,61 @@
+// Package verification proves customers hold the phone or e-mail they register as a key.
+// Such keys are pending until the one-time code sent to them is verified.
+package verification
+
+import (
+	"codepix/bank-api/pixkey"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// CodeLength is how many digits codes have.
+const CodeLength = 6
+
+var ErrNoAttemptsLeft = errors.New("no verification attempts left")
+
+// Verification of a pending key. Only the hash of the code is kept.
+type Verification struct {
+	CodeHash  []byte
+	ExpiresAt time.Time
+	Attempts  uint64
+}
+
+// Required tells whether keys of the type must be verified before they are active.
+func Required(keyType pixkey.Type) bool {
+	return keyType == pixkey.PhoneKey || keyType == pixkey.EmailKey
+}
+
+// New generates a code for the key, which expires after expiry.
+func New(key pixkey.Key, expiry time.Duration) (*Verification, string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < CodeLength; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return nil, "", fmt.Errorf("generate verification code: %w", err)
+	}
+	code := fmt.Sprintf("%0*d", CodeLength, n)
+	return &Verification{
+		CodeHash:  hash(key, code),
+		ExpiresAt: time.Now().Add(expiry),
+	}, code, nil
+}
+
+func (v Verification) Expired(now time.Time) bool {
+	return !now.Before(v.ExpiresAt)
+}
+
+func (v Verification) Matches(key pixkey.Key, code string) bool {
+	return subtle.ConstantTimeCompare(v.CodeHash, hash(key, code)) == 1
+}
+
+func hash(key pixkey.Key, code string) []byte {
+	sum := sha256.Sum256([]byte(key + ":" + code))
+	return sum[:]
+}
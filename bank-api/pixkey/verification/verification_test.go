@@ -0,0 +1,33 @@
+package verification_test
+
+import (
+	"codepix/bank-api/pixkey"
+	"codepix/bank-api/pixkey/verification"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	v, code, err := verification.New("name@domain.com", time.Minute)
+	require.NoError(t, err)
+	assert.Regexp(t, "^[0-9]{6}$", code)
+	assert.Zero(t, v.Attempts)
+
+	assert.True(t, v.Matches("name@domain.com", code))
+	assert.False(t, v.Matches("other@domain.com", code))
+	assert.False(t, v.Matches("name@domain.com", code+"0"))
+
+	assert.False(t, v.Expired(time.Now()))
+	assert.True(t, v.Expired(v.ExpiresAt))
+}
+
+func TestRequired(t *testing.T) {
+	assert.True(t, verification.Required(pixkey.PhoneKey))
+	assert.True(t, verification.Required(pixkey.EmailKey))
+	assert.False(t, verification.Required(pixkey.CPFKey))
+	assert.False(t, verification.Required(pixkey.CNPJKey))
+	assert.False(t, verification.Required(pixkey.RandomKey))
+}
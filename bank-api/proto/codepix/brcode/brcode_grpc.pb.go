@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.1
+// source: proto/codepix/brcode/brcode.proto
+
+package brcode
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceClient interface {
+	EncodeStatic(ctx context.Context, in *EncodeStaticRequest, opts ...grpc.CallOption) (*Encoded, error)
+	EncodeCharge(ctx context.Context, in *EncodeChargeRequest, opts ...grpc.CallOption) (*Encoded, error)
+	Decode(ctx context.Context, in *DecodeRequest, opts ...grpc.CallOption) (*DecodeReply, error)
+}
+
+type serviceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceClient(cc grpc.ClientConnInterface) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) EncodeStatic(ctx context.Context, in *EncodeStaticRequest, opts ...grpc.CallOption) (*Encoded, error) {
+	out := new(Encoded)
+	err := c.cc.Invoke(ctx, "/codepix.brcode.Service/EncodeStatic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) EncodeCharge(ctx context.Context, in *EncodeChargeRequest, opts ...grpc.CallOption) (*Encoded, error) {
+	out := new(Encoded)
+	err := c.cc.Invoke(ctx, "/codepix.brcode.Service/EncodeCharge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Decode(ctx context.Context, in *DecodeRequest, opts ...grpc.CallOption) (*DecodeReply, error) {
+	out := new(DecodeReply)
+	err := c.cc.Invoke(ctx, "/codepix.brcode.Service/Decode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+// All implementations must embed UnimplementedServiceServer
+// for forward compatibility
+type ServiceServer interface {
+	EncodeStatic(context.Context, *EncodeStaticRequest) (*Encoded, error)
+	EncodeCharge(context.Context, *EncodeChargeRequest) (*Encoded, error)
+	Decode(context.Context, *DecodeRequest) (*DecodeReply, error)
+	mustEmbedUnimplementedServiceServer()
+}
+
+// UnimplementedServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (UnimplementedServiceServer) EncodeStatic(context.Context, *EncodeStaticRequest) (*Encoded, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncodeStatic not implemented")
+}
+func (UnimplementedServiceServer) EncodeCharge(context.Context, *EncodeChargeRequest) (*Encoded, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncodeCharge not implemented")
+}
+func (UnimplementedServiceServer) Decode(context.Context, *DecodeRequest) (*DecodeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decode not implemented")
+}
+func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
+
+// UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceServer will
+// result in compilation errors.
+type UnsafeServiceServer interface {
+	mustEmbedUnimplementedServiceServer()
+}
+
+func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
+	s.RegisterService(&Service_ServiceDesc, srv)
+}
+
+func _Service_EncodeStatic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncodeStaticRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).EncodeStatic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/codepix.brcode.Service/EncodeStatic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).EncodeStatic(ctx, req.(*EncodeStaticRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_EncodeCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncodeChargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).EncodeCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/codepix.brcode.Service/EncodeCharge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).EncodeCharge(ctx, req.(*EncodeChargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Decode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Decode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/codepix.brcode.Service/Decode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Decode(ctx, req.(*DecodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Service_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "codepix.brcode.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EncodeStatic",
+			Handler:    _Service_EncodeStatic_Handler,
+		},
+		{
+			MethodName: "EncodeCharge",
+			Handler:    _Service_EncodeCharge_Handler,
+		},
+		{
+			MethodName: "Decode",
+			Handler:    _Service_Decode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/codepix/brcode/brcode.proto",
+}
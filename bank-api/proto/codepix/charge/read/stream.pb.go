@@ -0,0 +1,643 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.20.1
+// source: proto/codepix/charge/read/stream.proto
+
+package read
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Ack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nacks []bool `protobuf:"varint,1,rep,packed,name=nacks,proto3" json:"nacks,omitempty"`
+}
+
+func (x *Ack) Reset() {
+	*x = Ack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_charge_read_stream_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ack) ProtoMessage() {}
+
+func (x *Ack) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_charge_read_stream_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ack.ProtoReflect.Descriptor instead.
+func (*Ack) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_charge_read_stream_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Ack) GetNacks() []bool {
+	if x != nil {
+		return x.Nacks
+	}
+	return nil
+}
+
+type ReservedCharge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TransactionId []byte                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Payer         []byte                 `protobuf:"bytes,4,opt,name=payer,proto3" json:"payer,omitempty"`
+	PayerBank     []byte                 `protobuf:"bytes,5,opt,name=payer_bank,json=payerBank,proto3" json:"payer_bank,omitempty"`
+}
+
+func (x *ReservedCharge) Reset() {
+	*x = ReservedCharge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_charge_read_stream_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservedCharge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservedCharge) ProtoMessage() {}
+
+func (x *ReservedCharge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_charge_read_stream_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservedCharge.ProtoReflect.Descriptor instead.
+func (*ReservedCharge) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_charge_read_stream_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReservedCharge) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ReservedCharge) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ReservedCharge) GetTransactionId() []byte {
+	if x != nil {
+		return x.TransactionId
+	}
+	return nil
+}
+
+func (x *ReservedCharge) GetPayer() []byte {
+	if x != nil {
+		return x.Payer
+	}
+	return nil
+}
+
+func (x *ReservedCharge) GetPayerBank() []byte {
+	if x != nil {
+		return x.PayerBank
+	}
+	return nil
+}
+
+type ReservedCharges struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*ReservedCharge `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ReservedCharges) Reset() {
+	*x = ReservedCharges{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_charge_read_stream_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservedCharges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservedCharges) ProtoMessage() {}
+
+func (x *ReservedCharges) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_charge_read_stream_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservedCharges.ProtoReflect.Descriptor instead.
+func (*ReservedCharges) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_charge_read_stream_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReservedCharges) GetEvents() []*ReservedCharge {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ReleasedCharge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TransactionId []byte                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+}
+
+func (x *ReleasedCharge) Reset() {
+	*x = ReleasedCharge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_charge_read_stream_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleasedCharge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleasedCharge) ProtoMessage() {}
+
+func (x *ReleasedCharge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_charge_read_stream_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleasedCharge.ProtoReflect.Descriptor instead.
+func (*ReleasedCharge) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_charge_read_stream_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReleasedCharge) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ReleasedCharge) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ReleasedCharge) GetTransactionId() []byte {
+	if x != nil {
+		return x.TransactionId
+	}
+	return nil
+}
+
+type ReleasedCharges struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*ReleasedCharge `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ReleasedCharges) Reset() {
+	*x = ReleasedCharges{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_charge_read_stream_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleasedCharges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleasedCharges) ProtoMessage() {}
+
+func (x *ReleasedCharges) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_charge_read_stream_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleasedCharges.ProtoReflect.Descriptor instead.
+func (*ReleasedCharges) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_charge_read_stream_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReleasedCharges) GetEvents() []*ReleasedCharge {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type PaidCharge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TransactionId []byte                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+}
+
+func (x *PaidCharge) Reset() {
+	*x = PaidCharge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_charge_read_stream_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaidCharge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaidCharge) ProtoMessage() {}
+
+func (x *PaidCharge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_charge_read_stream_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaidCharge.ProtoReflect.Descriptor instead.
+func (*PaidCharge) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_charge_read_stream_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PaidCharge) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *PaidCharge) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *PaidCharge) GetTransactionId() []byte {
+	if x != nil {
+		return x.TransactionId
+	}
+	return nil
+}
+
+type PaidCharges struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*PaidCharge `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *PaidCharges) Reset() {
+	*x = PaidCharges{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_charge_read_stream_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaidCharges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaidCharges) ProtoMessage() {}
+
+func (x *PaidCharges) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_charge_read_stream_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaidCharges.ProtoReflect.Descriptor instead.
+func (*PaidCharges) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_charge_read_stream_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PaidCharges) GetEvents() []*PaidCharge {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_proto_codepix_charge_read_stream_proto protoreflect.FileDescriptor
+
+var file_proto_codepix_charge_read_stream_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f,
+	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x2f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69,
+	0x78, 0x2e, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1b,
+	0x0a, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x6e, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x0e,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x70, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x62,
+	0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x61, 0x79, 0x65, 0x72,
+	0x42, 0x61, 0x6e, 0x6b, 0x22, 0x4e, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69,
+	0x78, 0x2e, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x64, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f,
+	0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2e, 0x72, 0x65, 0x61,
+	0x64, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x7d, 0x0a, 0x0a, 0x50, 0x61, 0x69, 0x64,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x0b, 0x50, 0x61, 0x69, 0x64, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78,
+	0x2e, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x50, 0x61, 0x69,
+	0x64, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x32,
+	0xf6, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x50, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78,
+	0x2e, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x41, 0x63, 0x6b,
+	0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x08,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70,
+	0x69, 0x78, 0x2e, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x41,
+	0x63, 0x6b, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x48,
+	0x0a, 0x04, 0x50, 0x61, 0x69, 0x64, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78,
+	0x2e, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x41, 0x63, 0x6b,
+	0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x50, 0x61, 0x69, 0x64, 0x43, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x73, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x2c, 0x5a, 0x2a, 0x63, 0x6f, 0x64, 0x65,
+	0x70, 0x69, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_codepix_charge_read_stream_proto_rawDescOnce sync.Once
+	file_proto_codepix_charge_read_stream_proto_rawDescData = file_proto_codepix_charge_read_stream_proto_rawDesc
+)
+
+func file_proto_codepix_charge_read_stream_proto_rawDescGZIP() []byte {
+	file_proto_codepix_charge_read_stream_proto_rawDescOnce.Do(func() {
+		file_proto_codepix_charge_read_stream_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_codepix_charge_read_stream_proto_rawDescData)
+	})
+	return file_proto_codepix_charge_read_stream_proto_rawDescData
+}
+
+var file_proto_codepix_charge_read_stream_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_codepix_charge_read_stream_proto_goTypes = []interface{}{
+	(*Ack)(nil),                   // 0: codepix.charge.read.Ack
+	(*ReservedCharge)(nil),        // 1: codepix.charge.read.ReservedCharge
+	(*ReservedCharges)(nil),       // 2: codepix.charge.read.ReservedCharges
+	(*ReleasedCharge)(nil),        // 3: codepix.charge.read.ReleasedCharge
+	(*ReleasedCharges)(nil),       // 4: codepix.charge.read.ReleasedCharges
+	(*PaidCharge)(nil),            // 5: codepix.charge.read.PaidCharge
+	(*PaidCharges)(nil),           // 6: codepix.charge.read.PaidCharges
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_proto_codepix_charge_read_stream_proto_depIdxs = []int32{
+	7, // 0: codepix.charge.read.ReservedCharge.timestamp:type_name -> google.protobuf.Timestamp
+	1, // 1: codepix.charge.read.ReservedCharges.events:type_name -> codepix.charge.read.ReservedCharge
+	7, // 2: codepix.charge.read.ReleasedCharge.timestamp:type_name -> google.protobuf.Timestamp
+	3, // 3: codepix.charge.read.ReleasedCharges.events:type_name -> codepix.charge.read.ReleasedCharge
+	7, // 4: codepix.charge.read.PaidCharge.timestamp:type_name -> google.protobuf.Timestamp
+	5, // 5: codepix.charge.read.PaidCharges.events:type_name -> codepix.charge.read.PaidCharge
+	0, // 6: codepix.charge.read.Stream.Reserved:input_type -> codepix.charge.read.Ack
+	0, // 7: codepix.charge.read.Stream.Released:input_type -> codepix.charge.read.Ack
+	0, // 8: codepix.charge.read.Stream.Paid:input_type -> codepix.charge.read.Ack
+	2, // 9: codepix.charge.read.Stream.Reserved:output_type -> codepix.charge.read.ReservedCharges
+	4, // 10: codepix.charge.read.Stream.Released:output_type -> codepix.charge.read.ReleasedCharges
+	6, // 11: codepix.charge.read.Stream.Paid:output_type -> codepix.charge.read.PaidCharges
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_proto_codepix_charge_read_stream_proto_init() }
+func file_proto_codepix_charge_read_stream_proto_init() {
+	if File_proto_codepix_charge_read_stream_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_codepix_charge_read_stream_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_charge_read_stream_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservedCharge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_charge_read_stream_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservedCharges); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_charge_read_stream_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleasedCharge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_charge_read_stream_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleasedCharges); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_charge_read_stream_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaidCharge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_charge_read_stream_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaidCharges); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_codepix_charge_read_stream_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_codepix_charge_read_stream_proto_goTypes,
+		DependencyIndexes: file_proto_codepix_charge_read_stream_proto_depIdxs,
+		MessageInfos:      file_proto_codepix_charge_read_stream_proto_msgTypes,
+	}.Build()
+	File_proto_codepix_charge_read_stream_proto = out.File
+	file_proto_codepix_charge_read_stream_proto_rawDesc = nil
+	file_proto_codepix_charge_read_stream_proto_goTypes = nil
+	file_proto_codepix_charge_read_stream_proto_depIdxs = nil
+}
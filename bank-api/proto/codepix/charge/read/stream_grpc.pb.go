@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.1
+// source: proto/codepix/charge/read/stream.proto
+
+package read
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StreamClient is the client API for Stream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StreamClient interface {
+	Reserved(ctx context.Context, opts ...grpc.CallOption) (Stream_ReservedClient, error)
+	Released(ctx context.Context, opts ...grpc.CallOption) (Stream_ReleasedClient, error)
+	Paid(ctx context.Context, opts ...grpc.CallOption) (Stream_PaidClient, error)
+}
+
+type streamClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStreamClient(cc grpc.ClientConnInterface) StreamClient {
+	return &streamClient{cc}
+}
+
+func (c *streamClient) Reserved(ctx context.Context, opts ...grpc.CallOption) (Stream_ReservedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[0], "/codepix.charge.read.Stream/Reserved", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamReservedClient{stream}
+	return x, nil
+}
+
+type Stream_ReservedClient interface {
+	Send(*Ack) error
+	Recv() (*ReservedCharges, error)
+	grpc.ClientStream
+}
+
+type streamReservedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamReservedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamReservedClient) Recv() (*ReservedCharges, error) {
+	m := new(ReservedCharges)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) Released(ctx context.Context, opts ...grpc.CallOption) (Stream_ReleasedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[1], "/codepix.charge.read.Stream/Released", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamReleasedClient{stream}
+	return x, nil
+}
+
+type Stream_ReleasedClient interface {
+	Send(*Ack) error
+	Recv() (*ReleasedCharges, error)
+	grpc.ClientStream
+}
+
+type streamReleasedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamReleasedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamReleasedClient) Recv() (*ReleasedCharges, error) {
+	m := new(ReleasedCharges)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) Paid(ctx context.Context, opts ...grpc.CallOption) (Stream_PaidClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[2], "/codepix.charge.read.Stream/Paid", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamPaidClient{stream}
+	return x, nil
+}
+
+type Stream_PaidClient interface {
+	Send(*Ack) error
+	Recv() (*PaidCharges, error)
+	grpc.ClientStream
+}
+
+type streamPaidClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamPaidClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamPaidClient) Recv() (*PaidCharges, error) {
+	m := new(PaidCharges)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamServer is the server API for Stream service.
+// All implementations must embed UnimplementedStreamServer
+// for forward compatibility
+type StreamServer interface {
+	Reserved(Stream_ReservedServer) error
+	Released(Stream_ReleasedServer) error
+	Paid(Stream_PaidServer) error
+	mustEmbedUnimplementedStreamServer()
+}
+
+// UnimplementedStreamServer must be embedded to have forward compatible implementations.
+type UnimplementedStreamServer struct {
+}
+
+func (UnimplementedStreamServer) Reserved(Stream_ReservedServer) error {
+	return status.Errorf(codes.Unimplemented, "method Reserved not implemented")
+}
+func (UnimplementedStreamServer) Released(Stream_ReleasedServer) error {
+	return status.Errorf(codes.Unimplemented, "method Released not implemented")
+}
+func (UnimplementedStreamServer) Paid(Stream_PaidServer) error {
+	return status.Errorf(codes.Unimplemented, "method Paid not implemented")
+}
+func (UnimplementedStreamServer) mustEmbedUnimplementedStreamServer() {}
+
+// UnsafeStreamServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StreamServer will
+// result in compilation errors.
+type UnsafeStreamServer interface {
+	mustEmbedUnimplementedStreamServer()
+}
+
+func RegisterStreamServer(s grpc.ServiceRegistrar, srv StreamServer) {
+	s.RegisterService(&Stream_ServiceDesc, srv)
+}
+
+func _Stream_Reserved_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Reserved(&streamReservedServer{stream})
+}
+
+type Stream_ReservedServer interface {
+	Send(*ReservedCharges) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamReservedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamReservedServer) Send(m *ReservedCharges) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamReservedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_Released_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Released(&streamReleasedServer{stream})
+}
+
+type Stream_ReleasedServer interface {
+	Send(*ReleasedCharges) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamReleasedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamReleasedServer) Send(m *ReleasedCharges) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamReleasedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_Paid_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Paid(&streamPaidServer{stream})
+}
+
+type Stream_PaidServer interface {
+	Send(*PaidCharges) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamPaidServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamPaidServer) Send(m *PaidCharges) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamPaidServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Stream_ServiceDesc is the grpc.ServiceDesc for Stream service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Stream_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "codepix.charge.read.Stream",
+	HandlerType: (*StreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Reserved",
+			Handler:       _Stream_Reserved_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Released",
+			Handler:       _Stream_Released_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Paid",
+			Handler:       _Stream_Paid_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/codepix/charge/read/stream.proto",
+}
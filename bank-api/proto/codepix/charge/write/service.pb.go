@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.20.1
+// source: proto/codepix/charge/write/service.proto
+
+package write
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReceiverKey string                 `protobuf:"bytes,1,opt,name=receiver_key,json=receiverKey,proto3" json:"receiver_key,omitempty" validate:"required"` // @gotags: validate:"required"
+	Amount      uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty" validate:"required"`                             // @gotags: validate:"required"
+	ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty" validate:"required"`       // @gotags: validate:"required"
+	Txid        string                 `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty" validate:"required,alphanum,min=26,max=35"`                                  // @gotags: validate:"required,alphanum,min=26,max=35"
+	PayerId     []byte                 `protobuf:"bytes,5,opt,name=payer_id,json=payerId,proto3" json:"payer_id,omitempty" validate:"omitempty,len=16"`             // @gotags: validate:"omitempty,len=16"
+	PayerBank   []byte                 `protobuf:"bytes,6,opt,name=payer_bank,json=payerBank,proto3" json:"payer_bank,omitempty" validate:"omitempty,len=16"`       // @gotags: validate:"omitempty,len=16"
+	Description string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty" validate:"max=100" mod:"trim"`                    // @gotags: validate:"max=100" mod:"trim"
+}
+
+func (x *CreateRequest) Reset() {
+	*x = CreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_charge_write_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRequest) ProtoMessage() {}
+
+func (x *CreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_charge_write_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
+func (*CreateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_charge_write_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateRequest) GetReceiverKey() string {
+	if x != nil {
+		return x.ReceiverKey
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetPayerId() []byte {
+	if x != nil {
+		return x.PayerId
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetPayerBank() []byte {
+	if x != nil {
+		return x.PayerBank
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type Created struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Created) Reset() {
+	*x = Created{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_charge_write_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Created) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Created) ProtoMessage() {}
+
+func (x *Created) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_charge_write_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Created.ProtoReflect.Descriptor instead.
+func (*Created) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_charge_write_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Created) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+var File_proto_codepix_charge_write_service_proto protoreflect.FileDescriptor
+
+var file_proto_codepix_charge_write_service_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f,
+	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x63, 0x6f, 0x64, 0x65,
+	0x70, 0x69, 0x78, 0x2e, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xf5, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x70, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x61,
+	0x79, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x07, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x02, 0x69, 0x64, 0x32, 0x59, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x4e, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x64, 0x65,
+	0x70, 0x69, 0x78, 0x2e, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2e,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x00, 0x42,
+	0x2d, 0x5a, 0x2b, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2d,
+	0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69,
+	0x78, 0x2f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_codepix_charge_write_service_proto_rawDescOnce sync.Once
+	file_proto_codepix_charge_write_service_proto_rawDescData = file_proto_codepix_charge_write_service_proto_rawDesc
+)
+
+func file_proto_codepix_charge_write_service_proto_rawDescGZIP() []byte {
+	file_proto_codepix_charge_write_service_proto_rawDescOnce.Do(func() {
+		file_proto_codepix_charge_write_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_codepix_charge_write_service_proto_rawDescData)
+	})
+	return file_proto_codepix_charge_write_service_proto_rawDescData
+}
+
+var file_proto_codepix_charge_write_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_codepix_charge_write_service_proto_goTypes = []interface{}{
+	(*CreateRequest)(nil),         // 0: codepix.charge.write.CreateRequest
+	(*Created)(nil),               // 1: codepix.charge.write.Created
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_proto_codepix_charge_write_service_proto_depIdxs = []int32{
+	2, // 0: codepix.charge.write.CreateRequest.expires_at:type_name -> google.protobuf.Timestamp
+	0, // 1: codepix.charge.write.Service.Create:input_type -> codepix.charge.write.CreateRequest
+	1, // 2: codepix.charge.write.Service.Create:output_type -> codepix.charge.write.Created
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_proto_codepix_charge_write_service_proto_init() }
+func file_proto_codepix_charge_write_service_proto_init() {
+	if File_proto_codepix_charge_write_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_codepix_charge_write_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_charge_write_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Created); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_codepix_charge_write_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_codepix_charge_write_service_proto_goTypes,
+		DependencyIndexes: file_proto_codepix_charge_write_service_proto_depIdxs,
+		MessageInfos:      file_proto_codepix_charge_write_service_proto_msgTypes,
+	}.Build()
+	File_proto_codepix_charge_write_service_proto = out.File
+	file_proto_codepix_charge_write_service_proto_rawDesc = nil
+	file_proto_codepix_charge_write_service_proto_goTypes = nil
+	file_proto_codepix_charge_write_service_proto_depIdxs = nil
+}
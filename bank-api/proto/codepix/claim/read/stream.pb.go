@@ -0,0 +1,674 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.20.1
+// source: proto/codepix/claim/read/stream.proto
+
+package read
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Ack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nacks []bool `protobuf:"varint,1,rep,packed,name=nacks,proto3" json:"nacks,omitempty"`
+}
+
+func (x *Ack) Reset() {
+	*x = Ack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_claim_read_stream_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ack) ProtoMessage() {}
+
+func (x *Ack) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_claim_read_stream_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ack.ProtoReflect.Descriptor instead.
+func (*Ack) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_claim_read_stream_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Ack) GetNacks() []bool {
+	if x != nil {
+		return x.Nacks
+	}
+	return nil
+}
+
+type OpenedClaim struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	PixKeyId    []byte                 `protobuf:"bytes,3,opt,name=pix_key_id,json=pixKeyId,proto3" json:"pix_key_id,omitempty"`
+	Key         string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	ClaimerBank []byte                 `protobuf:"bytes,5,opt,name=claimer_bank,json=claimerBank,proto3" json:"claimer_bank,omitempty"`
+	ResolveBy   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=resolve_by,json=resolveBy,proto3" json:"resolve_by,omitempty"`
+}
+
+func (x *OpenedClaim) Reset() {
+	*x = OpenedClaim{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_claim_read_stream_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenedClaim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenedClaim) ProtoMessage() {}
+
+func (x *OpenedClaim) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_claim_read_stream_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenedClaim.ProtoReflect.Descriptor instead.
+func (*OpenedClaim) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_claim_read_stream_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OpenedClaim) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *OpenedClaim) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *OpenedClaim) GetPixKeyId() []byte {
+	if x != nil {
+		return x.PixKeyId
+	}
+	return nil
+}
+
+func (x *OpenedClaim) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *OpenedClaim) GetClaimerBank() []byte {
+	if x != nil {
+		return x.ClaimerBank
+	}
+	return nil
+}
+
+func (x *OpenedClaim) GetResolveBy() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolveBy
+	}
+	return nil
+}
+
+type OpenedClaims struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*OpenedClaim `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *OpenedClaims) Reset() {
+	*x = OpenedClaims{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_claim_read_stream_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenedClaims) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenedClaims) ProtoMessage() {}
+
+func (x *OpenedClaims) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_claim_read_stream_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenedClaims.ProtoReflect.Descriptor instead.
+func (*OpenedClaims) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_claim_read_stream_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OpenedClaims) GetEvents() []*OpenedClaim {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CompletedClaim struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	PixKeyId  []byte                 `protobuf:"bytes,3,opt,name=pix_key_id,json=pixKeyId,proto3" json:"pix_key_id,omitempty"`
+	Expired   bool                   `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (x *CompletedClaim) Reset() {
+	*x = CompletedClaim{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_claim_read_stream_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletedClaim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletedClaim) ProtoMessage() {}
+
+func (x *CompletedClaim) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_claim_read_stream_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletedClaim.ProtoReflect.Descriptor instead.
+func (*CompletedClaim) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_claim_read_stream_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CompletedClaim) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *CompletedClaim) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *CompletedClaim) GetPixKeyId() []byte {
+	if x != nil {
+		return x.PixKeyId
+	}
+	return nil
+}
+
+func (x *CompletedClaim) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+type CompletedClaims struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*CompletedClaim `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *CompletedClaims) Reset() {
+	*x = CompletedClaims{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_claim_read_stream_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletedClaims) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletedClaims) ProtoMessage() {}
+
+func (x *CompletedClaims) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_claim_read_stream_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletedClaims.ProtoReflect.Descriptor instead.
+func (*CompletedClaims) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_claim_read_stream_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CompletedClaims) GetEvents() []*CompletedClaim {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CancelledClaim struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CancelledBy []byte                 `protobuf:"bytes,3,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	Reason      string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CancelledClaim) Reset() {
+	*x = CancelledClaim{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_claim_read_stream_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelledClaim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelledClaim) ProtoMessage() {}
+
+func (x *CancelledClaim) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_claim_read_stream_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelledClaim.ProtoReflect.Descriptor instead.
+func (*CancelledClaim) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_claim_read_stream_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelledClaim) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *CancelledClaim) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *CancelledClaim) GetCancelledBy() []byte {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return nil
+}
+
+func (x *CancelledClaim) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelledClaims struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*CancelledClaim `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *CancelledClaims) Reset() {
+	*x = CancelledClaims{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_claim_read_stream_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelledClaims) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelledClaims) ProtoMessage() {}
+
+func (x *CancelledClaims) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_claim_read_stream_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelledClaims.ProtoReflect.Descriptor instead.
+func (*CancelledClaims) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_claim_read_stream_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CancelledClaims) GetEvents() []*CancelledClaim {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_proto_codepix_claim_read_stream_proto protoreflect.FileDescriptor
+
+var file_proto_codepix_claim_read_stream_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78,
+	0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1b, 0x0a, 0x03,
+	0x41, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x08, 0x52, 0x05, 0x6e, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x0b, 0x4f, 0x70,
+	0x65, 0x6e, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x0a, 0x70, 0x69, 0x78, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x69, 0x78, 0x4b, 0x65, 0x79, 0x49,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x62,
+	0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x65, 0x72, 0x42, 0x61, 0x6e, 0x6b, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x42,
+	0x79, 0x22, 0x47, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x73, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x0a, 0x70, 0x69, 0x78, 0x5f, 0x6b,
+	0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x69, 0x78,
+	0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22,
+	0x4d, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x95,
+	0x01, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x64, 0x65,
+	0x70, 0x69, 0x78, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x32, 0xf5, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x49, 0x0a, 0x06, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x63, 0x6f, 0x64,
+	0x65, 0x70, 0x69, 0x78, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e,
+	0x41, 0x63, 0x6b, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x73, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x09, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70,
+	0x69, 0x78, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x41, 0x63,
+	0x6b, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x09,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x63, 0x6f, 0x64, 0x65,
+	0x70, 0x69, 0x78, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x41,
+	0x63, 0x6b, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
+	0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x2b, 0x5a,
+	0x29, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2d, 0x61, 0x70,
+	0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_proto_codepix_claim_read_stream_proto_rawDescOnce sync.Once
+	file_proto_codepix_claim_read_stream_proto_rawDescData = file_proto_codepix_claim_read_stream_proto_rawDesc
+)
+
+func file_proto_codepix_claim_read_stream_proto_rawDescGZIP() []byte {
+	file_proto_codepix_claim_read_stream_proto_rawDescOnce.Do(func() {
+		file_proto_codepix_claim_read_stream_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_codepix_claim_read_stream_proto_rawDescData)
+	})
+	return file_proto_codepix_claim_read_stream_proto_rawDescData
+}
+
+var file_proto_codepix_claim_read_stream_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_codepix_claim_read_stream_proto_goTypes = []interface{}{
+	(*Ack)(nil),                   // 0: codepix.claim.read.Ack
+	(*OpenedClaim)(nil),           // 1: codepix.claim.read.OpenedClaim
+	(*OpenedClaims)(nil),          // 2: codepix.claim.read.OpenedClaims
+	(*CompletedClaim)(nil),        // 3: codepix.claim.read.CompletedClaim
+	(*CompletedClaims)(nil),       // 4: codepix.claim.read.CompletedClaims
+	(*CancelledClaim)(nil),        // 5: codepix.claim.read.CancelledClaim
+	(*CancelledClaims)(nil),       // 6: codepix.claim.read.CancelledClaims
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_proto_codepix_claim_read_stream_proto_depIdxs = []int32{
+	7,  // 0: codepix.claim.read.OpenedClaim.timestamp:type_name -> google.protobuf.Timestamp
+	7,  // 1: codepix.claim.read.OpenedClaim.resolve_by:type_name -> google.protobuf.Timestamp
+	1,  // 2: codepix.claim.read.OpenedClaims.events:type_name -> codepix.claim.read.OpenedClaim
+	7,  // 3: codepix.claim.read.CompletedClaim.timestamp:type_name -> google.protobuf.Timestamp
+	3,  // 4: codepix.claim.read.CompletedClaims.events:type_name -> codepix.claim.read.CompletedClaim
+	7,  // 5: codepix.claim.read.CancelledClaim.timestamp:type_name -> google.protobuf.Timestamp
+	5,  // 6: codepix.claim.read.CancelledClaims.events:type_name -> codepix.claim.read.CancelledClaim
+	0,  // 7: codepix.claim.read.Stream.Opened:input_type -> codepix.claim.read.Ack
+	0,  // 8: codepix.claim.read.Stream.Completed:input_type -> codepix.claim.read.Ack
+	0,  // 9: codepix.claim.read.Stream.Cancelled:input_type -> codepix.claim.read.Ack
+	2,  // 10: codepix.claim.read.Stream.Opened:output_type -> codepix.claim.read.OpenedClaims
+	4,  // 11: codepix.claim.read.Stream.Completed:output_type -> codepix.claim.read.CompletedClaims
+	6,  // 12: codepix.claim.read.Stream.Cancelled:output_type -> codepix.claim.read.CancelledClaims
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_proto_codepix_claim_read_stream_proto_init() }
+func file_proto_codepix_claim_read_stream_proto_init() {
+	if File_proto_codepix_claim_read_stream_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_codepix_claim_read_stream_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_claim_read_stream_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenedClaim); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_claim_read_stream_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenedClaims); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_claim_read_stream_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletedClaim); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_claim_read_stream_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletedClaims); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_claim_read_stream_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelledClaim); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_claim_read_stream_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelledClaims); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_codepix_claim_read_stream_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_codepix_claim_read_stream_proto_goTypes,
+		DependencyIndexes: file_proto_codepix_claim_read_stream_proto_depIdxs,
+		MessageInfos:      file_proto_codepix_claim_read_stream_proto_msgTypes,
+	}.Build()
+	File_proto_codepix_claim_read_stream_proto = out.File
+	file_proto_codepix_claim_read_stream_proto_rawDesc = nil
+	file_proto_codepix_claim_read_stream_proto_goTypes = nil
+	file_proto_codepix_claim_read_stream_proto_depIdxs = nil
+}
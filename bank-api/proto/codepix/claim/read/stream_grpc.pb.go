@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.1
+// source: proto/codepix/claim/read/stream.proto
+
+package read
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StreamClient is the client API for Stream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StreamClient interface {
+	Opened(ctx context.Context, opts ...grpc.CallOption) (Stream_OpenedClient, error)
+	Completed(ctx context.Context, opts ...grpc.CallOption) (Stream_CompletedClient, error)
+	Cancelled(ctx context.Context, opts ...grpc.CallOption) (Stream_CancelledClient, error)
+}
+
+type streamClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStreamClient(cc grpc.ClientConnInterface) StreamClient {
+	return &streamClient{cc}
+}
+
+func (c *streamClient) Opened(ctx context.Context, opts ...grpc.CallOption) (Stream_OpenedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[0], "/codepix.claim.read.Stream/Opened", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamOpenedClient{stream}
+	return x, nil
+}
+
+type Stream_OpenedClient interface {
+	Send(*Ack) error
+	Recv() (*OpenedClaims, error)
+	grpc.ClientStream
+}
+
+type streamOpenedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamOpenedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamOpenedClient) Recv() (*OpenedClaims, error) {
+	m := new(OpenedClaims)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) Completed(ctx context.Context, opts ...grpc.CallOption) (Stream_CompletedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[1], "/codepix.claim.read.Stream/Completed", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamCompletedClient{stream}
+	return x, nil
+}
+
+type Stream_CompletedClient interface {
+	Send(*Ack) error
+	Recv() (*CompletedClaims, error)
+	grpc.ClientStream
+}
+
+type streamCompletedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamCompletedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamCompletedClient) Recv() (*CompletedClaims, error) {
+	m := new(CompletedClaims)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) Cancelled(ctx context.Context, opts ...grpc.CallOption) (Stream_CancelledClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[2], "/codepix.claim.read.Stream/Cancelled", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamCancelledClient{stream}
+	return x, nil
+}
+
+type Stream_CancelledClient interface {
+	Send(*Ack) error
+	Recv() (*CancelledClaims, error)
+	grpc.ClientStream
+}
+
+type streamCancelledClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamCancelledClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamCancelledClient) Recv() (*CancelledClaims, error) {
+	m := new(CancelledClaims)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamServer is the server API for Stream service.
+// All implementations must embed UnimplementedStreamServer
+// for forward compatibility
+type StreamServer interface {
+	Opened(Stream_OpenedServer) error
+	Completed(Stream_CompletedServer) error
+	Cancelled(Stream_CancelledServer) error
+	mustEmbedUnimplementedStreamServer()
+}
+
+// UnimplementedStreamServer must be embedded to have forward compatible implementations.
+type UnimplementedStreamServer struct {
+}
+
+func (UnimplementedStreamServer) Opened(Stream_OpenedServer) error {
+	return status.Errorf(codes.Unimplemented, "method Opened not implemented")
+}
+func (UnimplementedStreamServer) Completed(Stream_CompletedServer) error {
+	return status.Errorf(codes.Unimplemented, "method Completed not implemented")
+}
+func (UnimplementedStreamServer) Cancelled(Stream_CancelledServer) error {
+	return status.Errorf(codes.Unimplemented, "method Cancelled not implemented")
+}
+func (UnimplementedStreamServer) mustEmbedUnimplementedStreamServer() {}
+
+// UnsafeStreamServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StreamServer will
+// result in compilation errors.
+type UnsafeStreamServer interface {
+	mustEmbedUnimplementedStreamServer()
+}
+
+func RegisterStreamServer(s grpc.ServiceRegistrar, srv StreamServer) {
+	s.RegisterService(&Stream_ServiceDesc, srv)
+}
+
+func _Stream_Opened_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Opened(&streamOpenedServer{stream})
+}
+
+type Stream_OpenedServer interface {
+	Send(*OpenedClaims) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamOpenedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamOpenedServer) Send(m *OpenedClaims) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamOpenedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_Completed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Completed(&streamCompletedServer{stream})
+}
+
+type Stream_CompletedServer interface {
+	Send(*CompletedClaims) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamCompletedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamCompletedServer) Send(m *CompletedClaims) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamCompletedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_Cancelled_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Cancelled(&streamCancelledServer{stream})
+}
+
+type Stream_CancelledServer interface {
+	Send(*CancelledClaims) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamCancelledServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamCancelledServer) Send(m *CancelledClaims) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamCancelledServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Stream_ServiceDesc is the grpc.ServiceDesc for Stream service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Stream_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "codepix.claim.read.Stream",
+	HandlerType: (*StreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Opened",
+			Handler:       _Stream_Opened_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Completed",
+			Handler:       _Stream_Completed_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Cancelled",
+			Handler:       _Stream_Cancelled_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/codepix/claim/read/stream.proto",
+}
@@ -0,0 +1,533 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.20.1
+// source: proto/codepix/claim/write/service.proto
+
+package write
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AccountType mirrors codepix.pixkey.AccountType.
+type AccountType int32
+
+const (
+	AccountType__AccountType AccountType = 0
+	AccountType_Checking     AccountType = 1
+	AccountType_Savings      AccountType = 2
+	AccountType_Payment      AccountType = 3
+	AccountType_Salary       AccountType = 4
+)
+
+// Enum value maps for AccountType.
+var (
+	AccountType_name = map[int32]string{
+		0: "_AccountType",
+		1: "Checking",
+		2: "Savings",
+		3: "Payment",
+		4: "Salary",
+	}
+	AccountType_value = map[string]int32{
+		"_AccountType": 0,
+		"Checking":     1,
+		"Savings":      2,
+		"Payment":      3,
+		"Salary":       4,
+	}
+)
+
+func (x AccountType) Enum() *AccountType {
+	p := new(AccountType)
+	*p = x
+	return p
+}
+
+func (x AccountType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_codepix_claim_write_service_proto_enumTypes[0].Descriptor()
+}
+
+func (AccountType) Type() protoreflect.EnumType {
+	return &file_proto_codepix_claim_write_service_proto_enumTypes[0]
+}
+
+func (x AccountType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountType.Descriptor instead.
+func (AccountType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_codepix_claim_write_service_proto_rawDescGZIP(), []int{0}
+}
+
+// The key moves to the account once the claim completes.
+type OpenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key           string      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty" validate:"required,max=100" mod:"trim"`                                                                          // @gotags: validate:"required,max=100" mod:"trim"
+	AccountId     []byte      `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty" validate:"required,len=16"`                                             // @gotags: validate:"required,len=16"
+	Branch        string      `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty" validate:"required,numeric,len=4" mod:"trim"`                                                                    // @gotags: validate:"required,numeric,len=4" mod:"trim"
+	AccountNumber string      `protobuf:"bytes,4,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty" validate:"required,max=20" mod:"trim"`                                 // @gotags: validate:"required,max=20" mod:"trim"
+	AccountType   AccountType `protobuf:"varint,5,opt,name=account_type,json=accountType,proto3,enum=codepix.claim.write.AccountType" json:"account_type,omitempty" validate:"required,oneof=1 2 3 4"` // @gotags: validate:"required,oneof=1 2 3 4"
+}
+
+func (x *OpenRequest) Reset() {
+	*x = OpenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_claim_write_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenRequest) ProtoMessage() {}
+
+func (x *OpenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_claim_write_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenRequest.ProtoReflect.Descriptor instead.
+func (*OpenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_claim_write_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OpenRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *OpenRequest) GetAccountId() []byte {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *OpenRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *OpenRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *OpenRequest) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType__AccountType
+}
+
+type Opened struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Opened) Reset() {
+	*x = Opened{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_claim_write_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Opened) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Opened) ProtoMessage() {}
+
+func (x *Opened) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_claim_write_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Opened.ProtoReflect.Descriptor instead.
+func (*Opened) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_claim_write_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Opened) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type ConfirmRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,len=16"` // @gotags: validate:"required,len=16"
+}
+
+func (x *ConfirmRequest) Reset() {
+	*x = ConfirmRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_claim_write_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmRequest) ProtoMessage() {}
+
+func (x *ConfirmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_claim_write_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmRequest) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_claim_write_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConfirmRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type CancelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,len=16"`         // @gotags: validate:"required,len=16"
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty" validate:"max=280" mod:"trim"` // @gotags: validate:"max=280" mod:"trim"
+}
+
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_claim_write_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_claim_write_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_claim_write_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CancelRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *CancelRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type Updated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Updated) Reset() {
+	*x = Updated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_claim_write_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Updated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Updated) ProtoMessage() {}
+
+func (x *Updated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_claim_write_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Updated.ProtoReflect.Descriptor instead.
+func (*Updated) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_claim_write_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Updated) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+var File_proto_codepix_claim_write_service_proto protoreflect.FileDescriptor
+
+var file_proto_codepix_claim_write_service_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x63, 0x6f, 0x64, 0x65, 0x70,
+	0x69, 0x78, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0xc2,
+	0x01, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x43,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x18, 0x0a, 0x06, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x20, 0x0a,
+	0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x37, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x69, 0x64, 0x2a, 0x53, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x5f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x10, 0x02, 0x12,
+	0x0b, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06,
+	0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x10, 0x04, 0x32, 0xf0, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x04, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x20, 0x2e, 0x63,
+	0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x22, 0x00, 0x12, 0x4e, 0x0a,
+	0x07, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70,
+	0x69, 0x78, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x00, 0x12, 0x4c, 0x0a,
+	0x06, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69,
+	0x78, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x6f,
+	0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x00, 0x42, 0x2c, 0x5a, 0x2a, 0x63,
+	0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2d, 0x61, 0x70, 0x69, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_proto_codepix_claim_write_service_proto_rawDescOnce sync.Once
+	file_proto_codepix_claim_write_service_proto_rawDescData = file_proto_codepix_claim_write_service_proto_rawDesc
+)
+
+func file_proto_codepix_claim_write_service_proto_rawDescGZIP() []byte {
+	file_proto_codepix_claim_write_service_proto_rawDescOnce.Do(func() {
+		file_proto_codepix_claim_write_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_codepix_claim_write_service_proto_rawDescData)
+	})
+	return file_proto_codepix_claim_write_service_proto_rawDescData
+}
+
+var file_proto_codepix_claim_write_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_codepix_claim_write_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_codepix_claim_write_service_proto_goTypes = []interface{}{
+	(AccountType)(0),       // 0: codepix.claim.write.AccountType
+	(*OpenRequest)(nil),    // 1: codepix.claim.write.OpenRequest
+	(*Opened)(nil),         // 2: codepix.claim.write.Opened
+	(*ConfirmRequest)(nil), // 3: codepix.claim.write.ConfirmRequest
+	(*CancelRequest)(nil),  // 4: codepix.claim.write.CancelRequest
+	(*Updated)(nil),        // 5: codepix.claim.write.Updated
+}
+var file_proto_codepix_claim_write_service_proto_depIdxs = []int32{
+	0, // 0: codepix.claim.write.OpenRequest.account_type:type_name -> codepix.claim.write.AccountType
+	1, // 1: codepix.claim.write.Service.Open:input_type -> codepix.claim.write.OpenRequest
+	3, // 2: codepix.claim.write.Service.Confirm:input_type -> codepix.claim.write.ConfirmRequest
+	4, // 3: codepix.claim.write.Service.Cancel:input_type -> codepix.claim.write.CancelRequest
+	2, // 4: codepix.claim.write.Service.Open:output_type -> codepix.claim.write.Opened
+	5, // 5: codepix.claim.write.Service.Confirm:output_type -> codepix.claim.write.Updated
+	5, // 6: codepix.claim.write.Service.Cancel:output_type -> codepix.claim.write.Updated
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_proto_codepix_claim_write_service_proto_init() }
+func file_proto_codepix_claim_write_service_proto_init() {
+	if File_proto_codepix_claim_write_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_codepix_claim_write_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_claim_write_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Opened); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_claim_write_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_claim_write_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_claim_write_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Updated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_codepix_claim_write_service_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_codepix_claim_write_service_proto_goTypes,
+		DependencyIndexes: file_proto_codepix_claim_write_service_proto_depIdxs,
+		EnumInfos:         file_proto_codepix_claim_write_service_proto_enumTypes,
+		MessageInfos:      file_proto_codepix_claim_write_service_proto_msgTypes,
+	}.Build()
+	File_proto_codepix_claim_write_service_proto = out.File
+	file_proto_codepix_claim_write_service_proto_rawDesc = nil
+	file_proto_codepix_claim_write_service_proto_goTypes = nil
+	file_proto_codepix_claim_write_service_proto_depIdxs = nil
+}
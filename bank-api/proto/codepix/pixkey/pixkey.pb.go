@@ -9,6 +9,7 @@ package pixkey
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -23,10 +24,12 @@ const (
 type Type int32
 
 const (
-	Type__     Type = 0
-	Type_CPF   Type = 1
-	Type_Phone Type = 2
-	Type_Email Type = 3
+	Type__      Type = 0
+	Type_CPF    Type = 1
+	Type_Phone  Type = 2
+	Type_Email  Type = 3
+	Type_CNPJ   Type = 4
+	Type_Random Type = 5
 )
 
 // Enum value maps for Type.
@@ -36,12 +39,16 @@ var (
 		1: "CPF",
 		2: "Phone",
 		3: "Email",
+		4: "CNPJ",
+		5: "Random",
 	}
 	Type_value = map[string]int32{
-		"_":     0,
-		"CPF":   1,
-		"Phone": 2,
-		"Email": 3,
+		"_":      0,
+		"CPF":    1,
+		"Phone":  2,
+		"Email":  3,
+		"CNPJ":   4,
+		"Random": 5,
 	}
 )
 
@@ -72,14 +79,132 @@ func (Type) EnumDescriptor() ([]byte, []int) {
 	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{0}
 }
 
+type AccountType int32
+
+const (
+	AccountType__AccountType AccountType = 0
+	AccountType_Checking     AccountType = 1
+	AccountType_Savings      AccountType = 2
+	AccountType_Payment      AccountType = 3
+	AccountType_Salary       AccountType = 4
+)
+
+// Enum value maps for AccountType.
+var (
+	AccountType_name = map[int32]string{
+		0: "_AccountType",
+		1: "Checking",
+		2: "Savings",
+		3: "Payment",
+		4: "Salary",
+	}
+	AccountType_value = map[string]int32{
+		"_AccountType": 0,
+		"Checking":     1,
+		"Savings":      2,
+		"Payment":      3,
+		"Salary":       4,
+	}
+)
+
+func (x AccountType) Enum() *AccountType {
+	p := new(AccountType)
+	*p = x
+	return p
+}
+
+func (x AccountType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_codepix_pixkey_pixkey_proto_enumTypes[1].Descriptor()
+}
+
+func (AccountType) Type() protoreflect.EnumType {
+	return &file_proto_codepix_pixkey_pixkey_proto_enumTypes[1]
+}
+
+func (x AccountType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountType.Descriptor instead.
+func (AccountType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{1}
+}
+
+type Status int32
+
+const (
+	Status__Status Status = 0
+	Status_Active  Status = 1
+	Status_Deleted Status = 2
+	Status_Blocked Status = 3
+	Status_Pending Status = 4
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "_Status",
+		1: "Active",
+		2: "Deleted",
+		3: "Blocked",
+		4: "Pending",
+	}
+	Status_value = map[string]int32{
+		"_Status": 0,
+		"Active":  1,
+		"Deleted": 2,
+		"Blocked": 3,
+		"Pending": 4,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_codepix_pixkey_pixkey_proto_enumTypes[2].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_proto_codepix_pixkey_pixkey_proto_enumTypes[2]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{2}
+}
+
+// Random keys are generated by the server, so their key must be empty. The owner document
+// is the CPF of individuals or the CNPJ of companies. Phone and e-mail keys are registered
+// pending, and a code is sent to them which must be verified to activate them.
 type RegisterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type      Type   `protobuf:"varint,1,opt,name=type,proto3,enum=codepix.pixkey.Type" json:"type,omitempty" validate:"required,oneof=1 2 3"`  // @gotags: validate:"required,oneof=1 2 3"
-	Key       string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty" validate:"required,max=100" mod:"trim"`                              // @gotags: validate:"required,max=100" mod:"trim"
-	AccountId []byte `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty" validate:"required,len=16"` // @gotags: validate:"required,len=16"
+	Type          Type        `protobuf:"varint,1,opt,name=type,proto3,enum=codepix.pixkey.Type" json:"type,omitempty" validate:"required,oneof=1 2 3 4 5"`                                         // @gotags: validate:"required,oneof=1 2 3 4 5"
+	Key           string      `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty" validate:"required_unless=Type 5,max=100" mod:"trim"`                                                                     // @gotags: validate:"required_unless=Type 5,max=100" mod:"trim"
+	AccountId     []byte      `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty" validate:"required,len=16"`                                        // @gotags: validate:"required,len=16"
+	OwnerName     string      `protobuf:"bytes,4,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty" validate:"required,max=140" mod:"trim"`                                        // @gotags: validate:"required,max=140" mod:"trim"
+	OwnerDocument string      `protobuf:"bytes,5,opt,name=owner_document,json=ownerDocument,proto3" json:"owner_document,omitempty" validate:"required,max=18" mod:"trim"`                            // @gotags: validate:"required,max=18" mod:"trim"
+	Branch        string      `protobuf:"bytes,6,opt,name=branch,proto3" json:"branch,omitempty" validate:"required,numeric,len=4" mod:"trim"`                                                               // @gotags: validate:"required,numeric,len=4" mod:"trim"
+	AccountNumber string      `protobuf:"bytes,7,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty" validate:"required,max=20" mod:"trim"`                            // @gotags: validate:"required,max=20" mod:"trim"
+	AccountType   AccountType `protobuf:"varint,8,opt,name=account_type,json=accountType,proto3,enum=codepix.pixkey.AccountType" json:"account_type,omitempty" validate:"required,oneof=1 2 3 4"` // @gotags: validate:"required,oneof=1 2 3 4"
 }
 
 func (x *RegisterRequest) Reset() {
@@ -109,57 +234,610 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
-func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *RegisterRequest) GetType() Type {
-	if x != nil {
-		return x.Type
-	}
-	return Type__
-}
-
-func (x *RegisterRequest) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RegisterRequest) GetType() Type {
+	if x != nil {
+		return x.Type
+	}
+	return Type__
+}
+
+func (x *RegisterRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetAccountId() []byte {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *RegisterRequest) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetOwnerDocument() string {
+	if x != nil {
+		return x.OwnerDocument
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType__AccountType
+}
+
+type RegisterReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key    string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Status Status `protobuf:"varint,3,opt,name=status,proto3,enum=codepix.pixkey.Status" json:"status,omitempty"`
+}
+
+func (x *RegisterReply) Reset() {
+	*x = RegisterReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterReply) ProtoMessage() {}
+
+func (x *RegisterReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterReply.ProtoReflect.Descriptor instead.
+func (*RegisterReply) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterReply) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *RegisterReply) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RegisterReply) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status__Status
+}
+
+type VerifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,len=16"`     // @gotags: validate:"required,len=16"
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty" validate:"required,numeric,len=6" mod:"trim"` // @gotags: validate:"required,numeric,len=6" mod:"trim"
+}
+
+func (x *VerifyRequest) Reset() {
+	*x = VerifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyRequest) ProtoMessage() {}
+
+func (x *VerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyRequest.ProtoReflect.Descriptor instead.
+func (*VerifyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VerifyRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *VerifyRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type FindRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required"` // @gotags: validate:"required"
+}
+
+func (x *FindRequest) Reset() {
+	*x = FindRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindRequest) ProtoMessage() {}
+
+func (x *FindRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindRequest.ProtoReflect.Descriptor instead.
+func (*FindRequest) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FindRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type FindReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type           Type                   `protobuf:"varint,2,opt,name=type,proto3,enum=codepix.pixkey.Type" json:"type,omitempty"`
+	Key            string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	AccountId      []byte                 `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Status         Status                 `protobuf:"varint,5,opt,name=status,proto3,enum=codepix.pixkey.Status" json:"status,omitempty"`
+	DeletedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletionReason string                 `protobuf:"bytes,7,opt,name=deletion_reason,json=deletionReason,proto3" json:"deletion_reason,omitempty"`
+	OwnerName      string                 `protobuf:"bytes,8,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	OwnerDocument  string                 `protobuf:"bytes,9,opt,name=owner_document,json=ownerDocument,proto3" json:"owner_document,omitempty"`
+	Branch         string                 `protobuf:"bytes,10,opt,name=branch,proto3" json:"branch,omitempty"`
+	AccountNumber  string                 `protobuf:"bytes,11,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	AccountType    AccountType            `protobuf:"varint,12,opt,name=account_type,json=accountType,proto3,enum=codepix.pixkey.AccountType" json:"account_type,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *FindReply) Reset() {
+	*x = FindReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindReply) ProtoMessage() {}
+
+func (x *FindReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindReply.ProtoReflect.Descriptor instead.
+func (*FindReply) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FindReply) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *FindReply) GetType() Type {
+	if x != nil {
+		return x.Type
+	}
+	return Type__
+}
+
+func (x *FindReply) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FindReply) GetAccountId() []byte {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *FindReply) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status__Status
+}
+
+func (x *FindReply) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *FindReply) GetDeletionReason() string {
+	if x != nil {
+		return x.DeletionReason
+	}
+	return ""
+}
+
+func (x *FindReply) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *FindReply) GetOwnerDocument() string {
+	if x != nil {
+		return x.OwnerDocument
+	}
+	return ""
+}
+
+func (x *FindReply) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *FindReply) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *FindReply) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType__AccountType
+}
+
+func (x *FindReply) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Lookup finds an active key of any bank, so the paying bank can show who it pays. The
+// owner is masked and the owner document is never shown in full.
+type LookupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty" validate:"required,max=100" mod:"trim"` // @gotags: validate:"required,max=100" mod:"trim"
+}
+
+func (x *LookupRequest) Reset() {
+	*x = LookupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupRequest) ProtoMessage() {}
+
+func (x *LookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupRequest.ProtoReflect.Descriptor instead.
+func (*LookupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LookupRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type LookupReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type          Type                   `protobuf:"varint,1,opt,name=type,proto3,enum=codepix.pixkey.Type" json:"type,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	OwnerName     string                 `protobuf:"bytes,3,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	OwnerDocument string                 `protobuf:"bytes,4,opt,name=owner_document,json=ownerDocument,proto3" json:"owner_document,omitempty"`
+	BankId        []byte                 `protobuf:"bytes,5,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
+	Branch        string                 `protobuf:"bytes,6,opt,name=branch,proto3" json:"branch,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,7,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	AccountType   AccountType            `protobuf:"varint,8,opt,name=account_type,json=accountType,proto3,enum=codepix.pixkey.AccountType" json:"account_type,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *LookupReply) Reset() {
+	*x = LookupReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupReply) ProtoMessage() {}
+
+func (x *LookupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupReply.ProtoReflect.Descriptor instead.
+func (*LookupReply) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LookupReply) GetType() Type {
+	if x != nil {
+		return x.Type
+	}
+	return Type__
+}
+
+func (x *LookupReply) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LookupReply) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *LookupReply) GetOwnerDocument() string {
+	if x != nil {
+		return x.OwnerDocument
+	}
+	return ""
+}
+
+func (x *LookupReply) GetBankId() []byte {
+	if x != nil {
+		return x.BankId
+	}
+	return nil
+}
+
+func (x *LookupReply) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *LookupReply) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *LookupReply) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType__AccountType
+}
+
+func (x *LookupReply) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId []byte `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty" validate:"required,len=16"` // @gotags: validate:"required,len=16"
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *RegisterRequest) GetAccountId() []byte {
+func (x *ListRequest) GetAccountId() []byte {
 	if x != nil {
 		return x.AccountId
 	}
 	return nil
 }
 
-type RegisterReply struct {
+type ListItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id     []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type   Type   `protobuf:"varint,2,opt,name=type,proto3,enum=codepix.pixkey.Type" json:"type,omitempty"`
+	Key    string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Status Status `protobuf:"varint,4,opt,name=status,proto3,enum=codepix.pixkey.Status" json:"status,omitempty"`
 }
 
-func (x *RegisterReply) Reset() {
-	*x = RegisterReply{}
+func (x *ListItem) Reset() {
+	*x = ListItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[1]
+		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisterReply) String() string {
+func (x *ListItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterReply) ProtoMessage() {}
+func (*ListItem) ProtoMessage() {}
 
-func (x *RegisterReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[1]
+func (x *ListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -170,43 +848,64 @@ func (x *RegisterReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterReply.ProtoReflect.Descriptor instead.
-func (*RegisterReply) Descriptor() ([]byte, []int) {
-	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ListItem.ProtoReflect.Descriptor instead.
+func (*ListItem) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RegisterReply) GetId() []byte {
+func (x *ListItem) GetId() []byte {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-type FindRequest struct {
+func (x *ListItem) GetType() Type {
+	if x != nil {
+		return x.Type
+	}
+	return Type__
+}
+
+func (x *ListItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ListItem) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status__Status
+}
+
+type ListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required"` // @gotags: validate:"required"
+	Items []*ListItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *FindRequest) Reset() {
-	*x = FindRequest{}
+func (x *ListReply) Reset() {
+	*x = ListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[2]
+		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindRequest) String() string {
+func (x *ListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindRequest) ProtoMessage() {}
+func (*ListReply) ProtoMessage() {}
 
-func (x *FindRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[2]
+func (x *ListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -217,46 +916,44 @@ func (x *FindRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindRequest.ProtoReflect.Descriptor instead.
-func (*FindRequest) Descriptor() ([]byte, []int) {
-	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListReply.ProtoReflect.Descriptor instead.
+func (*ListReply) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *FindRequest) GetId() []byte {
+func (x *ListReply) GetItems() []*ListItem {
 	if x != nil {
-		return x.Id
+		return x.Items
 	}
 	return nil
 }
 
-type FindReply struct {
+type DeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type      Type   `protobuf:"varint,2,opt,name=type,proto3,enum=codepix.pixkey.Type" json:"type,omitempty"`
-	Key       string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
-	AccountId []byte `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Id     []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,len=16"`         // @gotags: validate:"required,len=16"
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty" validate:"required,max=100" mod:"trim"` // @gotags: validate:"required,max=100" mod:"trim"
 }
 
-func (x *FindReply) Reset() {
-	*x = FindReply{}
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[3]
+		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindReply) String() string {
+func (x *DeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindReply) ProtoMessage() {}
+func (*DeleteRequest) ProtoMessage() {}
 
-func (x *FindReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[3]
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,64 +964,50 @@ func (x *FindReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindReply.ProtoReflect.Descriptor instead.
-func (*FindReply) Descriptor() ([]byte, []int) {
-	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *FindReply) GetId() []byte {
+func (x *DeleteRequest) GetId() []byte {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *FindReply) GetType() Type {
-	if x != nil {
-		return x.Type
-	}
-	return Type__
-}
-
-func (x *FindReply) GetKey() string {
+func (x *DeleteRequest) GetReason() string {
 	if x != nil {
-		return x.Key
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *FindReply) GetAccountId() []byte {
-	if x != nil {
-		return x.AccountId
-	}
-	return nil
-}
-
-type ListRequest struct {
+type BlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountId []byte `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty" validate:"required"` // @gotags: validate:"required"
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,len=16"` // @gotags: validate:"required,len=16"
 }
 
-func (x *ListRequest) Reset() {
-	*x = ListRequest{}
+func (x *BlockRequest) Reset() {
+	*x = BlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[4]
+		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListRequest) String() string {
+func (x *BlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRequest) ProtoMessage() {}
+func (*BlockRequest) ProtoMessage() {}
 
-func (x *ListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[4]
+func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,45 +1018,43 @@ func (x *ListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
-func (*ListRequest) Descriptor() ([]byte, []int) {
-	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListRequest) GetAccountId() []byte {
+func (x *BlockRequest) GetId() []byte {
 	if x != nil {
-		return x.AccountId
+		return x.Id
 	}
 	return nil
 }
 
-type ListItem struct {
+type UnblockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type Type   `protobuf:"varint,2,opt,name=type,proto3,enum=codepix.pixkey.Type" json:"type,omitempty"`
-	Key  string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,len=16"` // @gotags: validate:"required,len=16"
 }
 
-func (x *ListItem) Reset() {
-	*x = ListItem{}
+func (x *UnblockRequest) Reset() {
+	*x = UnblockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[5]
+		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListItem) String() string {
+func (x *UnblockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListItem) ProtoMessage() {}
+func (*UnblockRequest) ProtoMessage() {}
 
-func (x *ListItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[5]
+func (x *UnblockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,57 +1065,44 @@ func (x *ListItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListItem.ProtoReflect.Descriptor instead.
-func (*ListItem) Descriptor() ([]byte, []int) {
-	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use UnblockRequest.ProtoReflect.Descriptor instead.
+func (*UnblockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListItem) GetId() []byte {
+func (x *UnblockRequest) GetId() []byte {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *ListItem) GetType() Type {
-	if x != nil {
-		return x.Type
-	}
-	return Type__
-}
-
-func (x *ListItem) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-type ListReply struct {
+type UpdateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*ListItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Id     []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status Status `protobuf:"varint,2,opt,name=status,proto3,enum=codepix.pixkey.Status" json:"status,omitempty"`
 }
 
-func (x *ListReply) Reset() {
-	*x = ListReply{}
+func (x *UpdateReply) Reset() {
+	*x = UpdateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[6]
+		mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListReply) String() string {
+func (x *UpdateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReply) ProtoMessage() {}
+func (*UpdateReply) ProtoMessage() {}
 
-func (x *ListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[6]
+func (x *UpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_pixkey_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,75 +1113,203 @@ func (x *ListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReply.ProtoReflect.Descriptor instead.
-func (*ListReply) Descriptor() ([]byte, []int) {
-	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use UpdateReply.ProtoReflect.Descriptor instead.
+func (*UpdateReply) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListReply) GetItems() []*ListItem {
+func (x *UpdateReply) GetId() []byte {
 	if x != nil {
-		return x.Items
+		return x.Id
 	}
 	return nil
 }
 
+func (x *UpdateReply) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status__Status
+}
+
 var File_proto_codepix_pixkey_pixkey_proto protoreflect.FileDescriptor
 
 var file_proto_codepix_pixkey_pixkey_proto_rawDesc = []byte{
 	0x0a, 0x21, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f,
 	0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2f, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78,
-	0x6b, 0x65, 0x79, 0x22, 0x6c, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70,
-	0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x64, 0x22, 0x1f, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x1d, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x6b, 0x65, 0x79, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb1, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78,
+	0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x61, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6f,
+	0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x33, 0x0a, 0x0d, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x22, 0x1d, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x8a, 0x04, 0x0a, 0x09, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x6f,
+	0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70,
+	0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x3e, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69,
+	0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x21, 0x0a, 0x0d,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22,
+	0xe2, 0x02, 0x0a, 0x0b, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0c, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x2c, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6f,
+	0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3b, 0x0a, 0x09, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69,
+	0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x37, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x1e, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x76, 0x0a, 0x09, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63,
-	0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b,
-	0x65, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22,
-	0x3b, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f,
-	0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2a, 0x2c, 0x0a, 0x04,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x05, 0x0a, 0x01, 0x5f, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x43,
-	0x50, 0x46, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x10, 0x02, 0x12,
-	0x09, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x10, 0x03, 0x32, 0xdb, 0x01, 0x0a, 0x07, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78,
-	0x6b, 0x65, 0x79, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69,
-	0x78, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x04, 0x46, 0x69, 0x6e, 0x64, 0x12, 0x1b, 0x2e, 0x63,
-	0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x46, 0x69,
-	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x6f, 0x64, 0x65,
-	0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b,
+	0x64, 0x22, 0x20, 0x0a, 0x0e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78,
+	0x6b, 0x65, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2a, 0x42, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x05, 0x0a, 0x01, 0x5f, 0x10,
+	0x00, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x50, 0x46, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x68,
+	0x6f, 0x6e, 0x65, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x10, 0x03,
+	0x12, 0x08, 0x0a, 0x04, 0x43, 0x4e, 0x50, 0x4a, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x10, 0x05, 0x2a, 0x53, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x5f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73,
+	0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x10, 0x03, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x10, 0x04, 0x2a, 0x48, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x5f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x10, 0x04, 0x32, 0xc3, 0x04, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x4c, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x2e,
+	0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
 	0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x6f,
-	0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x27, 0x5a, 0x25, 0x63, 0x6f, 0x64, 0x65,
-	0x70, 0x69, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f, 0x70, 0x69, 0x78, 0x6b, 0x65,
-	0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x06, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x1d, 0x2e, 0x63, 0x6f, 0x64, 0x65,
+	0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70,
+	0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x04, 0x46, 0x69, 0x6e, 0x64, 0x12,
+	0x1b, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63,
+	0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x06, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x12, 0x1d, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69,
+	0x78, 0x6b, 0x65, 0x79, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78,
+	0x6b, 0x65, 0x79, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x64, 0x65,
+	0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78,
+	0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1d, 0x2e,
+	0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63,
+	0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x05, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70,
+	0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78,
+	0x6b, 0x65, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x48, 0x0a, 0x07, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1e, 0x2e, 0x63,
+	0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x55, 0x6e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63,
+	0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x27, 0x5a, 0x25, 0x63,
+	0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2d, 0x61, 0x70, 0x69, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f, 0x70, 0x69,
+	0x78, 0x6b, 0x65, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -528,34 +1324,65 @@ func file_proto_codepix_pixkey_pixkey_proto_rawDescGZIP() []byte {
 	return file_proto_codepix_pixkey_pixkey_proto_rawDescData
 }
 
-var file_proto_codepix_pixkey_pixkey_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_codepix_pixkey_pixkey_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_codepix_pixkey_pixkey_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_codepix_pixkey_pixkey_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_proto_codepix_pixkey_pixkey_proto_goTypes = []interface{}{
-	(Type)(0),               // 0: codepix.pixkey.Type
-	(*RegisterRequest)(nil), // 1: codepix.pixkey.RegisterRequest
-	(*RegisterReply)(nil),   // 2: codepix.pixkey.RegisterReply
-	(*FindRequest)(nil),     // 3: codepix.pixkey.FindRequest
-	(*FindReply)(nil),       // 4: codepix.pixkey.FindReply
-	(*ListRequest)(nil),     // 5: codepix.pixkey.ListRequest
-	(*ListItem)(nil),        // 6: codepix.pixkey.ListItem
-	(*ListReply)(nil),       // 7: codepix.pixkey.ListReply
+	(Type)(0),                     // 0: codepix.pixkey.Type
+	(AccountType)(0),              // 1: codepix.pixkey.AccountType
+	(Status)(0),                   // 2: codepix.pixkey.Status
+	(*RegisterRequest)(nil),       // 3: codepix.pixkey.RegisterRequest
+	(*RegisterReply)(nil),         // 4: codepix.pixkey.RegisterReply
+	(*VerifyRequest)(nil),         // 5: codepix.pixkey.VerifyRequest
+	(*FindRequest)(nil),           // 6: codepix.pixkey.FindRequest
+	(*FindReply)(nil),             // 7: codepix.pixkey.FindReply
+	(*LookupRequest)(nil),         // 8: codepix.pixkey.LookupRequest
+	(*LookupReply)(nil),           // 9: codepix.pixkey.LookupReply
+	(*ListRequest)(nil),           // 10: codepix.pixkey.ListRequest
+	(*ListItem)(nil),              // 11: codepix.pixkey.ListItem
+	(*ListReply)(nil),             // 12: codepix.pixkey.ListReply
+	(*DeleteRequest)(nil),         // 13: codepix.pixkey.DeleteRequest
+	(*BlockRequest)(nil),          // 14: codepix.pixkey.BlockRequest
+	(*UnblockRequest)(nil),        // 15: codepix.pixkey.UnblockRequest
+	(*UpdateReply)(nil),           // 16: codepix.pixkey.UpdateReply
+	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
 }
 var file_proto_codepix_pixkey_pixkey_proto_depIdxs = []int32{
-	0, // 0: codepix.pixkey.RegisterRequest.type:type_name -> codepix.pixkey.Type
-	0, // 1: codepix.pixkey.FindReply.type:type_name -> codepix.pixkey.Type
-	0, // 2: codepix.pixkey.ListItem.type:type_name -> codepix.pixkey.Type
-	6, // 3: codepix.pixkey.ListReply.items:type_name -> codepix.pixkey.ListItem
-	1, // 4: codepix.pixkey.Service.Register:input_type -> codepix.pixkey.RegisterRequest
-	3, // 5: codepix.pixkey.Service.Find:input_type -> codepix.pixkey.FindRequest
-	5, // 6: codepix.pixkey.Service.List:input_type -> codepix.pixkey.ListRequest
-	2, // 7: codepix.pixkey.Service.Register:output_type -> codepix.pixkey.RegisterReply
-	4, // 8: codepix.pixkey.Service.Find:output_type -> codepix.pixkey.FindReply
-	7, // 9: codepix.pixkey.Service.List:output_type -> codepix.pixkey.ListReply
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: codepix.pixkey.RegisterRequest.type:type_name -> codepix.pixkey.Type
+	1,  // 1: codepix.pixkey.RegisterRequest.account_type:type_name -> codepix.pixkey.AccountType
+	2,  // 2: codepix.pixkey.RegisterReply.status:type_name -> codepix.pixkey.Status
+	0,  // 3: codepix.pixkey.FindReply.type:type_name -> codepix.pixkey.Type
+	2,  // 4: codepix.pixkey.FindReply.status:type_name -> codepix.pixkey.Status
+	17, // 5: codepix.pixkey.FindReply.deleted_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: codepix.pixkey.FindReply.account_type:type_name -> codepix.pixkey.AccountType
+	17, // 7: codepix.pixkey.FindReply.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: codepix.pixkey.LookupReply.type:type_name -> codepix.pixkey.Type
+	1,  // 9: codepix.pixkey.LookupReply.account_type:type_name -> codepix.pixkey.AccountType
+	17, // 10: codepix.pixkey.LookupReply.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 11: codepix.pixkey.ListItem.type:type_name -> codepix.pixkey.Type
+	2,  // 12: codepix.pixkey.ListItem.status:type_name -> codepix.pixkey.Status
+	11, // 13: codepix.pixkey.ListReply.items:type_name -> codepix.pixkey.ListItem
+	2,  // 14: codepix.pixkey.UpdateReply.status:type_name -> codepix.pixkey.Status
+	3,  // 15: codepix.pixkey.Service.Register:input_type -> codepix.pixkey.RegisterRequest
+	5,  // 16: codepix.pixkey.Service.Verify:input_type -> codepix.pixkey.VerifyRequest
+	6,  // 17: codepix.pixkey.Service.Find:input_type -> codepix.pixkey.FindRequest
+	8,  // 18: codepix.pixkey.Service.Lookup:input_type -> codepix.pixkey.LookupRequest
+	10, // 19: codepix.pixkey.Service.List:input_type -> codepix.pixkey.ListRequest
+	13, // 20: codepix.pixkey.Service.Delete:input_type -> codepix.pixkey.DeleteRequest
+	14, // 21: codepix.pixkey.Service.Block:input_type -> codepix.pixkey.BlockRequest
+	15, // 22: codepix.pixkey.Service.Unblock:input_type -> codepix.pixkey.UnblockRequest
+	4,  // 23: codepix.pixkey.Service.Register:output_type -> codepix.pixkey.RegisterReply
+	16, // 24: codepix.pixkey.Service.Verify:output_type -> codepix.pixkey.UpdateReply
+	7,  // 25: codepix.pixkey.Service.Find:output_type -> codepix.pixkey.FindReply
+	9,  // 26: codepix.pixkey.Service.Lookup:output_type -> codepix.pixkey.LookupReply
+	12, // 27: codepix.pixkey.Service.List:output_type -> codepix.pixkey.ListReply
+	16, // 28: codepix.pixkey.Service.Delete:output_type -> codepix.pixkey.UpdateReply
+	16, // 29: codepix.pixkey.Service.Block:output_type -> codepix.pixkey.UpdateReply
+	16, // 30: codepix.pixkey.Service.Unblock:output_type -> codepix.pixkey.UpdateReply
+	23, // [23:31] is the sub-list for method output_type
+	15, // [15:23] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_proto_codepix_pixkey_pixkey_proto_init() }
@@ -589,7 +1416,7 @@ func file_proto_codepix_pixkey_pixkey_proto_init() {
 			}
 		}
 		file_proto_codepix_pixkey_pixkey_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindRequest); i {
+			switch v := v.(*VerifyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -601,7 +1428,7 @@ func file_proto_codepix_pixkey_pixkey_proto_init() {
 			}
 		}
 		file_proto_codepix_pixkey_pixkey_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindReply); i {
+			switch v := v.(*FindRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -613,7 +1440,7 @@ func file_proto_codepix_pixkey_pixkey_proto_init() {
 			}
 		}
 		file_proto_codepix_pixkey_pixkey_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRequest); i {
+			switch v := v.(*FindReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -625,7 +1452,7 @@ func file_proto_codepix_pixkey_pixkey_proto_init() {
 			}
 		}
 		file_proto_codepix_pixkey_pixkey_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListItem); i {
+			switch v := v.(*LookupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -637,6 +1464,42 @@ func file_proto_codepix_pixkey_pixkey_proto_init() {
 			}
 		}
 		file_proto_codepix_pixkey_pixkey_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_pixkey_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_pixkey_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_pixkey_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListReply); i {
 			case 0:
 				return &v.state
@@ -648,14 +1511,62 @@ func file_proto_codepix_pixkey_pixkey_proto_init() {
 				return nil
 			}
 		}
+		file_proto_codepix_pixkey_pixkey_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_pixkey_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_pixkey_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_pixkey_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_codepix_pixkey_pixkey_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   7,
+			NumEnums:      3,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
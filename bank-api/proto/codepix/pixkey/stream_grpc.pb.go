@@ -0,0 +1,409 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.1
+// source: proto/codepix/pixkey/stream.proto
+
+package pixkey
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StreamClient is the client API for Stream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StreamClient interface {
+	Registered(ctx context.Context, opts ...grpc.CallOption) (Stream_RegisteredClient, error)
+	Deleted(ctx context.Context, opts ...grpc.CallOption) (Stream_DeletedClient, error)
+	Blocked(ctx context.Context, opts ...grpc.CallOption) (Stream_BlockedClient, error)
+	Unblocked(ctx context.Context, opts ...grpc.CallOption) (Stream_UnblockedClient, error)
+	Transferred(ctx context.Context, opts ...grpc.CallOption) (Stream_TransferredClient, error)
+}
+
+type streamClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStreamClient(cc grpc.ClientConnInterface) StreamClient {
+	return &streamClient{cc}
+}
+
+func (c *streamClient) Registered(ctx context.Context, opts ...grpc.CallOption) (Stream_RegisteredClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[0], "/codepix.pixkey.Stream/Registered", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamRegisteredClient{stream}
+	return x, nil
+}
+
+type Stream_RegisteredClient interface {
+	Send(*Ack) error
+	Recv() (*RegisteredKeys, error)
+	grpc.ClientStream
+}
+
+type streamRegisteredClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamRegisteredClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamRegisteredClient) Recv() (*RegisteredKeys, error) {
+	m := new(RegisteredKeys)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) Deleted(ctx context.Context, opts ...grpc.CallOption) (Stream_DeletedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[1], "/codepix.pixkey.Stream/Deleted", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamDeletedClient{stream}
+	return x, nil
+}
+
+type Stream_DeletedClient interface {
+	Send(*Ack) error
+	Recv() (*DeletedKeys, error)
+	grpc.ClientStream
+}
+
+type streamDeletedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamDeletedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamDeletedClient) Recv() (*DeletedKeys, error) {
+	m := new(DeletedKeys)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) Blocked(ctx context.Context, opts ...grpc.CallOption) (Stream_BlockedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[2], "/codepix.pixkey.Stream/Blocked", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamBlockedClient{stream}
+	return x, nil
+}
+
+type Stream_BlockedClient interface {
+	Send(*Ack) error
+	Recv() (*BlockedKeys, error)
+	grpc.ClientStream
+}
+
+type streamBlockedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamBlockedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamBlockedClient) Recv() (*BlockedKeys, error) {
+	m := new(BlockedKeys)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) Unblocked(ctx context.Context, opts ...grpc.CallOption) (Stream_UnblockedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[3], "/codepix.pixkey.Stream/Unblocked", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamUnblockedClient{stream}
+	return x, nil
+}
+
+type Stream_UnblockedClient interface {
+	Send(*Ack) error
+	Recv() (*UnblockedKeys, error)
+	grpc.ClientStream
+}
+
+type streamUnblockedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamUnblockedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamUnblockedClient) Recv() (*UnblockedKeys, error) {
+	m := new(UnblockedKeys)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) Transferred(ctx context.Context, opts ...grpc.CallOption) (Stream_TransferredClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[4], "/codepix.pixkey.Stream/Transferred", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamTransferredClient{stream}
+	return x, nil
+}
+
+type Stream_TransferredClient interface {
+	Send(*Ack) error
+	Recv() (*TransferredKeys, error)
+	grpc.ClientStream
+}
+
+type streamTransferredClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamTransferredClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamTransferredClient) Recv() (*TransferredKeys, error) {
+	m := new(TransferredKeys)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamServer is the server API for Stream service.
+// All implementations must embed UnimplementedStreamServer
+// for forward compatibility
+type StreamServer interface {
+	Registered(Stream_RegisteredServer) error
+	Deleted(Stream_DeletedServer) error
+	Blocked(Stream_BlockedServer) error
+	Unblocked(Stream_UnblockedServer) error
+	Transferred(Stream_TransferredServer) error
+	mustEmbedUnimplementedStreamServer()
+}
+
+// UnimplementedStreamServer must be embedded to have forward compatible implementations.
+type UnimplementedStreamServer struct {
+}
+
+func (UnimplementedStreamServer) Registered(Stream_RegisteredServer) error {
+	return status.Errorf(codes.Unimplemented, "method Registered not implemented")
+}
+func (UnimplementedStreamServer) Deleted(Stream_DeletedServer) error {
+	return status.Errorf(codes.Unimplemented, "method Deleted not implemented")
+}
+func (UnimplementedStreamServer) Blocked(Stream_BlockedServer) error {
+	return status.Errorf(codes.Unimplemented, "method Blocked not implemented")
+}
+func (UnimplementedStreamServer) Unblocked(Stream_UnblockedServer) error {
+	return status.Errorf(codes.Unimplemented, "method Unblocked not implemented")
+}
+func (UnimplementedStreamServer) Transferred(Stream_TransferredServer) error {
+	return status.Errorf(codes.Unimplemented, "method Transferred not implemented")
+}
+func (UnimplementedStreamServer) mustEmbedUnimplementedStreamServer() {}
+
+// UnsafeStreamServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StreamServer will
+// result in compilation errors.
+type UnsafeStreamServer interface {
+	mustEmbedUnimplementedStreamServer()
+}
+
+func RegisterStreamServer(s grpc.ServiceRegistrar, srv StreamServer) {
+	s.RegisterService(&Stream_ServiceDesc, srv)
+}
+
+func _Stream_Registered_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Registered(&streamRegisteredServer{stream})
+}
+
+type Stream_RegisteredServer interface {
+	Send(*RegisteredKeys) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamRegisteredServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamRegisteredServer) Send(m *RegisteredKeys) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamRegisteredServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_Deleted_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Deleted(&streamDeletedServer{stream})
+}
+
+type Stream_DeletedServer interface {
+	Send(*DeletedKeys) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamDeletedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamDeletedServer) Send(m *DeletedKeys) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamDeletedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_Blocked_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Blocked(&streamBlockedServer{stream})
+}
+
+type Stream_BlockedServer interface {
+	Send(*BlockedKeys) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamBlockedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamBlockedServer) Send(m *BlockedKeys) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamBlockedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_Unblocked_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Unblocked(&streamUnblockedServer{stream})
+}
+
+type Stream_UnblockedServer interface {
+	Send(*UnblockedKeys) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamUnblockedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamUnblockedServer) Send(m *UnblockedKeys) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamUnblockedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_Transferred_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Transferred(&streamTransferredServer{stream})
+}
+
+type Stream_TransferredServer interface {
+	Send(*TransferredKeys) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamTransferredServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamTransferredServer) Send(m *TransferredKeys) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamTransferredServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Stream_ServiceDesc is the grpc.ServiceDesc for Stream service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Stream_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "codepix.pixkey.Stream",
+	HandlerType: (*StreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Registered",
+			Handler:       _Stream_Registered_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Deleted",
+			Handler:       _Stream_Deleted_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Blocked",
+			Handler:       _Stream_Blocked_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Unblocked",
+			Handler:       _Stream_Unblocked_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Transferred",
+			Handler:       _Stream_Transferred_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/codepix/pixkey/stream.proto",
+}
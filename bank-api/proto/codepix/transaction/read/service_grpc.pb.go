@@ -24,6 +24,8 @@ const _ = grpc.SupportPackageIsVersion7
 type ServiceClient interface {
 	Find(ctx context.Context, in *FindRequest, opts ...grpc.CallOption) (*FindReply, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListReply, error)
+	FindBatch(ctx context.Context, in *FindBatchRequest, opts ...grpc.CallOption) (*BatchReply, error)
+	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryReply, error)
 }
 
 type serviceClient struct {
@@ -52,12 +54,32 @@ func (c *serviceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.
 	return out, nil
 }
 
+func (c *serviceClient) FindBatch(ctx context.Context, in *FindBatchRequest, opts ...grpc.CallOption) (*BatchReply, error) {
+	out := new(BatchReply)
+	err := c.cc.Invoke(ctx, "/codepix.transaction.read.Service/FindBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryReply, error) {
+	out := new(HistoryReply)
+	err := c.cc.Invoke(ctx, "/codepix.transaction.read.Service/History", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
 type ServiceServer interface {
 	Find(context.Context, *FindRequest) (*FindReply, error)
 	List(context.Context, *ListRequest) (*ListReply, error)
+	FindBatch(context.Context, *FindBatchRequest) (*BatchReply, error)
+	History(context.Context, *HistoryRequest) (*HistoryReply, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -71,6 +93,12 @@ func (UnimplementedServiceServer) Find(context.Context, *FindRequest) (*FindRepl
 func (UnimplementedServiceServer) List(context.Context, *ListRequest) (*ListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedServiceServer) FindBatch(context.Context, *FindBatchRequest) (*BatchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindBatch not implemented")
+}
+func (UnimplementedServiceServer) History(context.Context, *HistoryRequest) (*HistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +148,42 @@ func _Service_List_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_FindBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).FindBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/codepix.transaction.read.Service/FindBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).FindBatch(ctx, req.(*FindBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/codepix.transaction.read.Service/History",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).History(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +199,14 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Service_List_Handler,
 		},
+		{
+			MethodName: "FindBatch",
+			Handler:    _Service_FindBatch_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _Service_History_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/codepix/transaction/read/service.proto",
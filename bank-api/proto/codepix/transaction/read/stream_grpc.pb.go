@@ -26,6 +26,11 @@ type StreamClient interface {
 	Confirmed(ctx context.Context, opts ...grpc.CallOption) (Stream_ConfirmedClient, error)
 	Completed(ctx context.Context, opts ...grpc.CallOption) (Stream_CompletedClient, error)
 	Failed(ctx context.Context, opts ...grpc.CallOption) (Stream_FailedClient, error)
+	Released(ctx context.Context, opts ...grpc.CallOption) (Stream_ReleasedClient, error)
+	RefundRequested(ctx context.Context, opts ...grpc.CallOption) (Stream_RefundRequestedClient, error)
+	RefundConfirmed(ctx context.Context, opts ...grpc.CallOption) (Stream_RefundConfirmedClient, error)
+	RefundCompleted(ctx context.Context, opts ...grpc.CallOption) (Stream_RefundCompletedClient, error)
+	RefundFailed(ctx context.Context, opts ...grpc.CallOption) (Stream_RefundFailedClient, error)
 }
 
 type streamClient struct {
@@ -160,6 +165,161 @@ func (x *streamFailedClient) Recv() (*FailedTransactions, error) {
 	return m, nil
 }
 
+func (c *streamClient) Released(ctx context.Context, opts ...grpc.CallOption) (Stream_ReleasedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[4], "/codepix.transaction.read.Stream/Released", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamReleasedClient{stream}
+	return x, nil
+}
+
+type Stream_ReleasedClient interface {
+	Send(*Ack) error
+	Recv() (*ReleasedTransactions, error)
+	grpc.ClientStream
+}
+
+type streamReleasedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamReleasedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamReleasedClient) Recv() (*ReleasedTransactions, error) {
+	m := new(ReleasedTransactions)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) RefundRequested(ctx context.Context, opts ...grpc.CallOption) (Stream_RefundRequestedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[5], "/codepix.transaction.read.Stream/RefundRequested", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamRefundRequestedClient{stream}
+	return x, nil
+}
+
+type Stream_RefundRequestedClient interface {
+	Send(*Ack) error
+	Recv() (*RequestedRefunds, error)
+	grpc.ClientStream
+}
+
+type streamRefundRequestedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamRefundRequestedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamRefundRequestedClient) Recv() (*RequestedRefunds, error) {
+	m := new(RequestedRefunds)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) RefundConfirmed(ctx context.Context, opts ...grpc.CallOption) (Stream_RefundConfirmedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[6], "/codepix.transaction.read.Stream/RefundConfirmed", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamRefundConfirmedClient{stream}
+	return x, nil
+}
+
+type Stream_RefundConfirmedClient interface {
+	Send(*Ack) error
+	Recv() (*ConfirmedRefunds, error)
+	grpc.ClientStream
+}
+
+type streamRefundConfirmedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamRefundConfirmedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamRefundConfirmedClient) Recv() (*ConfirmedRefunds, error) {
+	m := new(ConfirmedRefunds)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) RefundCompleted(ctx context.Context, opts ...grpc.CallOption) (Stream_RefundCompletedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[7], "/codepix.transaction.read.Stream/RefundCompleted", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamRefundCompletedClient{stream}
+	return x, nil
+}
+
+type Stream_RefundCompletedClient interface {
+	Send(*Ack) error
+	Recv() (*CompletedRefunds, error)
+	grpc.ClientStream
+}
+
+type streamRefundCompletedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamRefundCompletedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamRefundCompletedClient) Recv() (*CompletedRefunds, error) {
+	m := new(CompletedRefunds)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) RefundFailed(ctx context.Context, opts ...grpc.CallOption) (Stream_RefundFailedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[8], "/codepix.transaction.read.Stream/RefundFailed", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamRefundFailedClient{stream}
+	return x, nil
+}
+
+type Stream_RefundFailedClient interface {
+	Send(*Ack) error
+	Recv() (*FailedRefunds, error)
+	grpc.ClientStream
+}
+
+type streamRefundFailedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamRefundFailedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamRefundFailedClient) Recv() (*FailedRefunds, error) {
+	m := new(FailedRefunds)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // StreamServer is the server API for Stream service.
 // All implementations must embed UnimplementedStreamServer
 // for forward compatibility
@@ -168,6 +328,11 @@ type StreamServer interface {
 	Confirmed(Stream_ConfirmedServer) error
 	Completed(Stream_CompletedServer) error
 	Failed(Stream_FailedServer) error
+	Released(Stream_ReleasedServer) error
+	RefundRequested(Stream_RefundRequestedServer) error
+	RefundConfirmed(Stream_RefundConfirmedServer) error
+	RefundCompleted(Stream_RefundCompletedServer) error
+	RefundFailed(Stream_RefundFailedServer) error
 	mustEmbedUnimplementedStreamServer()
 }
 
@@ -187,6 +352,21 @@ func (UnimplementedStreamServer) Completed(Stream_CompletedServer) error {
 func (UnimplementedStreamServer) Failed(Stream_FailedServer) error {
 	return status.Errorf(codes.Unimplemented, "method Failed not implemented")
 }
+func (UnimplementedStreamServer) Released(Stream_ReleasedServer) error {
+	return status.Errorf(codes.Unimplemented, "method Released not implemented")
+}
+func (UnimplementedStreamServer) RefundRequested(Stream_RefundRequestedServer) error {
+	return status.Errorf(codes.Unimplemented, "method RefundRequested not implemented")
+}
+func (UnimplementedStreamServer) RefundConfirmed(Stream_RefundConfirmedServer) error {
+	return status.Errorf(codes.Unimplemented, "method RefundConfirmed not implemented")
+}
+func (UnimplementedStreamServer) RefundCompleted(Stream_RefundCompletedServer) error {
+	return status.Errorf(codes.Unimplemented, "method RefundCompleted not implemented")
+}
+func (UnimplementedStreamServer) RefundFailed(Stream_RefundFailedServer) error {
+	return status.Errorf(codes.Unimplemented, "method RefundFailed not implemented")
+}
 func (UnimplementedStreamServer) mustEmbedUnimplementedStreamServer() {}
 
 // UnsafeStreamServer may be embedded to opt out of forward compatibility for this service.
@@ -304,6 +484,136 @@ func (x *streamFailedServer) Recv() (*Ack, error) {
 	return m, nil
 }
 
+func _Stream_Released_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Released(&streamReleasedServer{stream})
+}
+
+type Stream_ReleasedServer interface {
+	Send(*ReleasedTransactions) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamReleasedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamReleasedServer) Send(m *ReleasedTransactions) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamReleasedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_RefundRequested_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).RefundRequested(&streamRefundRequestedServer{stream})
+}
+
+type Stream_RefundRequestedServer interface {
+	Send(*RequestedRefunds) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamRefundRequestedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamRefundRequestedServer) Send(m *RequestedRefunds) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamRefundRequestedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_RefundConfirmed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).RefundConfirmed(&streamRefundConfirmedServer{stream})
+}
+
+type Stream_RefundConfirmedServer interface {
+	Send(*ConfirmedRefunds) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamRefundConfirmedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamRefundConfirmedServer) Send(m *ConfirmedRefunds) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamRefundConfirmedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_RefundCompleted_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).RefundCompleted(&streamRefundCompletedServer{stream})
+}
+
+type Stream_RefundCompletedServer interface {
+	Send(*CompletedRefunds) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamRefundCompletedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamRefundCompletedServer) Send(m *CompletedRefunds) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamRefundCompletedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_RefundFailed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).RefundFailed(&streamRefundFailedServer{stream})
+}
+
+type Stream_RefundFailedServer interface {
+	Send(*FailedRefunds) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamRefundFailedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamRefundFailedServer) Send(m *FailedRefunds) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamRefundFailedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Stream_ServiceDesc is the grpc.ServiceDesc for Stream service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -336,6 +646,36 @@ var Stream_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "Released",
+			Handler:       _Stream_Released_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "RefundRequested",
+			Handler:       _Stream_RefundRequested_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "RefundConfirmed",
+			Handler:       _Stream_RefundConfirmed_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "RefundCompleted",
+			Handler:       _Stream_RefundCompleted_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "RefundFailed",
+			Handler:       _Stream_RefundFailed_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "proto/codepix/transaction/read/stream.proto",
 }
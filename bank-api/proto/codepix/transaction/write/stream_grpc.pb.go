@@ -26,6 +26,11 @@ type StreamClient interface {
 	Confirm(ctx context.Context, opts ...grpc.CallOption) (Stream_ConfirmClient, error)
 	Complete(ctx context.Context, opts ...grpc.CallOption) (Stream_CompleteClient, error)
 	Fail(ctx context.Context, opts ...grpc.CallOption) (Stream_FailClient, error)
+	Release(ctx context.Context, opts ...grpc.CallOption) (Stream_ReleaseClient, error)
+	RequestRefund(ctx context.Context, opts ...grpc.CallOption) (Stream_RequestRefundClient, error)
+	ConfirmRefund(ctx context.Context, opts ...grpc.CallOption) (Stream_ConfirmRefundClient, error)
+	CompleteRefund(ctx context.Context, opts ...grpc.CallOption) (Stream_CompleteRefundClient, error)
+	FailRefund(ctx context.Context, opts ...grpc.CallOption) (Stream_FailRefundClient, error)
 }
 
 type streamClient struct {
@@ -160,6 +165,161 @@ func (x *streamFailClient) Recv() (*FailReply, error) {
 	return m, nil
 }
 
+func (c *streamClient) Release(ctx context.Context, opts ...grpc.CallOption) (Stream_ReleaseClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[4], "/codepix.transaction.write.Stream/Release", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamReleaseClient{stream}
+	return x, nil
+}
+
+type Stream_ReleaseClient interface {
+	Send(*ReleaseRequest) error
+	Recv() (*ReleaseReply, error)
+	grpc.ClientStream
+}
+
+type streamReleaseClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamReleaseClient) Send(m *ReleaseRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamReleaseClient) Recv() (*ReleaseReply, error) {
+	m := new(ReleaseReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) RequestRefund(ctx context.Context, opts ...grpc.CallOption) (Stream_RequestRefundClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[5], "/codepix.transaction.write.Stream/RequestRefund", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamRequestRefundClient{stream}
+	return x, nil
+}
+
+type Stream_RequestRefundClient interface {
+	Send(*RequestRefundRequest) error
+	Recv() (*RequestRefundReply, error)
+	grpc.ClientStream
+}
+
+type streamRequestRefundClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamRequestRefundClient) Send(m *RequestRefundRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamRequestRefundClient) Recv() (*RequestRefundReply, error) {
+	m := new(RequestRefundReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) ConfirmRefund(ctx context.Context, opts ...grpc.CallOption) (Stream_ConfirmRefundClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[6], "/codepix.transaction.write.Stream/ConfirmRefund", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamConfirmRefundClient{stream}
+	return x, nil
+}
+
+type Stream_ConfirmRefundClient interface {
+	Send(*ConfirmRefundRequest) error
+	Recv() (*ConfirmRefundReply, error)
+	grpc.ClientStream
+}
+
+type streamConfirmRefundClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamConfirmRefundClient) Send(m *ConfirmRefundRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamConfirmRefundClient) Recv() (*ConfirmRefundReply, error) {
+	m := new(ConfirmRefundReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) CompleteRefund(ctx context.Context, opts ...grpc.CallOption) (Stream_CompleteRefundClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[7], "/codepix.transaction.write.Stream/CompleteRefund", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamCompleteRefundClient{stream}
+	return x, nil
+}
+
+type Stream_CompleteRefundClient interface {
+	Send(*CompleteRefundRequest) error
+	Recv() (*CompleteRefundReply, error)
+	grpc.ClientStream
+}
+
+type streamCompleteRefundClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamCompleteRefundClient) Send(m *CompleteRefundRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamCompleteRefundClient) Recv() (*CompleteRefundReply, error) {
+	m := new(CompleteRefundReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) FailRefund(ctx context.Context, opts ...grpc.CallOption) (Stream_FailRefundClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[8], "/codepix.transaction.write.Stream/FailRefund", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamFailRefundClient{stream}
+	return x, nil
+}
+
+type Stream_FailRefundClient interface {
+	Send(*FailRefundRequest) error
+	Recv() (*FailRefundReply, error)
+	grpc.ClientStream
+}
+
+type streamFailRefundClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamFailRefundClient) Send(m *FailRefundRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamFailRefundClient) Recv() (*FailRefundReply, error) {
+	m := new(FailRefundReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // StreamServer is the server API for Stream service.
 // All implementations must embed UnimplementedStreamServer
 // for forward compatibility
@@ -168,6 +328,11 @@ type StreamServer interface {
 	Confirm(Stream_ConfirmServer) error
 	Complete(Stream_CompleteServer) error
 	Fail(Stream_FailServer) error
+	Release(Stream_ReleaseServer) error
+	RequestRefund(Stream_RequestRefundServer) error
+	ConfirmRefund(Stream_ConfirmRefundServer) error
+	CompleteRefund(Stream_CompleteRefundServer) error
+	FailRefund(Stream_FailRefundServer) error
 	mustEmbedUnimplementedStreamServer()
 }
 
@@ -187,6 +352,21 @@ func (UnimplementedStreamServer) Complete(Stream_CompleteServer) error {
 func (UnimplementedStreamServer) Fail(Stream_FailServer) error {
 	return status.Errorf(codes.Unimplemented, "method Fail not implemented")
 }
+func (UnimplementedStreamServer) Release(Stream_ReleaseServer) error {
+	return status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
+func (UnimplementedStreamServer) RequestRefund(Stream_RequestRefundServer) error {
+	return status.Errorf(codes.Unimplemented, "method RequestRefund not implemented")
+}
+func (UnimplementedStreamServer) ConfirmRefund(Stream_ConfirmRefundServer) error {
+	return status.Errorf(codes.Unimplemented, "method ConfirmRefund not implemented")
+}
+func (UnimplementedStreamServer) CompleteRefund(Stream_CompleteRefundServer) error {
+	return status.Errorf(codes.Unimplemented, "method CompleteRefund not implemented")
+}
+func (UnimplementedStreamServer) FailRefund(Stream_FailRefundServer) error {
+	return status.Errorf(codes.Unimplemented, "method FailRefund not implemented")
+}
 func (UnimplementedStreamServer) mustEmbedUnimplementedStreamServer() {}
 
 // UnsafeStreamServer may be embedded to opt out of forward compatibility for this service.
@@ -304,6 +484,136 @@ func (x *streamFailServer) Recv() (*FailRequest, error) {
 	return m, nil
 }
 
+func _Stream_Release_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Release(&streamReleaseServer{stream})
+}
+
+type Stream_ReleaseServer interface {
+	Send(*ReleaseReply) error
+	Recv() (*ReleaseRequest, error)
+	grpc.ServerStream
+}
+
+type streamReleaseServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamReleaseServer) Send(m *ReleaseReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamReleaseServer) Recv() (*ReleaseRequest, error) {
+	m := new(ReleaseRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_RequestRefund_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).RequestRefund(&streamRequestRefundServer{stream})
+}
+
+type Stream_RequestRefundServer interface {
+	Send(*RequestRefundReply) error
+	Recv() (*RequestRefundRequest, error)
+	grpc.ServerStream
+}
+
+type streamRequestRefundServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamRequestRefundServer) Send(m *RequestRefundReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamRequestRefundServer) Recv() (*RequestRefundRequest, error) {
+	m := new(RequestRefundRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_ConfirmRefund_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).ConfirmRefund(&streamConfirmRefundServer{stream})
+}
+
+type Stream_ConfirmRefundServer interface {
+	Send(*ConfirmRefundReply) error
+	Recv() (*ConfirmRefundRequest, error)
+	grpc.ServerStream
+}
+
+type streamConfirmRefundServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamConfirmRefundServer) Send(m *ConfirmRefundReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamConfirmRefundServer) Recv() (*ConfirmRefundRequest, error) {
+	m := new(ConfirmRefundRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_CompleteRefund_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).CompleteRefund(&streamCompleteRefundServer{stream})
+}
+
+type Stream_CompleteRefundServer interface {
+	Send(*CompleteRefundReply) error
+	Recv() (*CompleteRefundRequest, error)
+	grpc.ServerStream
+}
+
+type streamCompleteRefundServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamCompleteRefundServer) Send(m *CompleteRefundReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamCompleteRefundServer) Recv() (*CompleteRefundRequest, error) {
+	m := new(CompleteRefundRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_FailRefund_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).FailRefund(&streamFailRefundServer{stream})
+}
+
+type Stream_FailRefundServer interface {
+	Send(*FailRefundReply) error
+	Recv() (*FailRefundRequest, error)
+	grpc.ServerStream
+}
+
+type streamFailRefundServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamFailRefundServer) Send(m *FailRefundReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamFailRefundServer) Recv() (*FailRefundRequest, error) {
+	m := new(FailRefundRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Stream_ServiceDesc is the grpc.ServiceDesc for Stream service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -336,6 +646,36 @@ var Stream_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "Release",
+			Handler:       _Stream_Release_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "RequestRefund",
+			Handler:       _Stream_RequestRefund_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ConfirmRefund",
+			Handler:       _Stream_ConfirmRefund_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CompleteRefund",
+			Handler:       _Stream_CompleteRefund_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "FailRefund",
+			Handler:       _Stream_FailRefund_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "proto/codepix/transaction/write/stream.proto",
 }
@@ -0,0 +1,82 @@
+package reserve
+
+import (
+	"codepix/bank-api/lib/repositories"
+	"codepix/bank-api/transaction"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+// Holds is the part of the reserves repository the holder needs.
+type Holds interface {
+	Hold(bankID, transferID uuid.UUID, amount transaction.Amount) error
+	Release(transferID uuid.UUID) error
+}
+
+// Transactions loads the transactions refunds are requested for, as refund commands do
+// not carry the banks of the transaction.
+type Transactions interface {
+	Load(ctx context.Context, aggregateType eventhorizon.AggregateType, ID uuid.UUID,
+	) (eventhorizon.Aggregate, error)
+}
+
+// Holder holds the reserve of the paying bank before a transaction or refund is started,
+// and releases it if the command fails. Transactions and refunds within the same bank are
+// not held.
+func Holder(logger logr.Logger, holds Holds, transactions Transactions,
+) eventhorizon.CommandHandlerMiddleware {
+	return func(h eventhorizon.CommandHandler) eventhorizon.CommandHandler {
+		return eventhorizon.CommandHandlerFunc(func(ctx context.Context,
+			cmd eventhorizon.Command,
+		) error {
+			var payer, transferID uuid.UUID
+			var amount transaction.Amount
+			switch cmd := cmd.(type) {
+			case transaction.Start:
+				if cmd.SenderBank == cmd.ReceiverBank {
+					return h.HandleCommand(ctx, cmd)
+				}
+				payer, transferID, amount = cmd.SenderBank, cmd.ID, cmd.Amount
+			case transaction.RequestRefund:
+				ag, err := transactions.Load(ctx, transaction.AggregateType, cmd.ID)
+				if err != nil {
+					return fmt.Errorf("load transaction: %w", err)
+				}
+				refunded, ok := ag.(*transaction.Aggregate)
+				if !ok {
+					return fmt.Errorf("load transaction: unexpected aggregate %T", ag)
+				}
+				if refunded.Transaction.SenderBank == refunded.Transaction.ReceiverBank {
+					return h.HandleCommand(ctx, cmd)
+				}
+				payer, transferID, amount = cmd.BankID, cmd.RefundID, cmd.Amount
+			default:
+				return h.HandleCommand(ctx, cmd)
+			}
+
+			err := holds.Hold(payer, transferID, amount)
+			// the transfer was already held, so the command is a retry
+			alreadyExists := &repositories.AlreadyExistsError{}
+			if errors.As(err, &alreadyExists) {
+				return h.HandleCommand(ctx, cmd)
+			}
+			if err != nil {
+				return err
+			}
+			err = h.HandleCommand(ctx, cmd)
+			if err != nil {
+				releaseErr := holds.Release(transferID)
+				if releaseErr != nil {
+					logger.Error(releaseErr, "fail: release reserve", "transfer", transferID)
+				}
+				return err
+			}
+			return nil
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package reserve_test
+
+import (
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/lib/repositories"
+	"codepix/bank-api/reserve"
+	"codepix/bank-api/reserve/reservetest"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/transactiontest"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHolder(t *testing.T) {
+	ctx := context.Background()
+	insufficient := &aggregates.InvariantViolation{reserve.ErrInsufficientReserve}
+	rejected := &aggregates.InvariantViolation{transaction.ErrCannotStartIfNotTheSender}
+
+	start := transactiontest.ValidStartCommand(uuid.New())
+	sameBank := transactiontest.ValidStartCommand(uuid.New())
+	sameBank.ReceiverBank = sameBank.SenderBank
+	refund := transactiontest.ValidRequestRefundCommand(uuid.New(), uuid.New())
+	sameBankRefund := transactiontest.ValidRequestRefundCommand(uuid.New(), uuid.New())
+	transactions := transactionStore{
+		refund.ID:         transactiontest.CompletedTransaction(refund.ID),
+		sameBankRefund.ID: sameBankTransaction(sameBankRefund.ID),
+	}
+
+	testCases := []struct {
+		description string
+		cmd         eventhorizon.Command
+		hold        []any
+		holdErr     error
+		handleErr   error
+		release     bool
+		releaseErr  error
+		err         error
+	}{
+		{"start", start, []any{start.SenderBank, start.ID, start.Amount},
+			nil, nil, false, nil, nil},
+		{"refund", refund, []any{refund.BankID, refund.RefundID, refund.Amount},
+			nil, nil, false, nil, nil},
+		{"same bank", sameBank, nil, nil, nil, false, nil, nil},
+		{"same bank refund", sameBankRefund, nil, nil, nil, false, nil, nil},
+		{"other command", transactiontest.ValidConfirmCommand(start.ID),
+			nil, nil, nil, false, nil, nil},
+		{"insufficient", start, []any{start.SenderBank, start.ID, start.Amount},
+			insufficient, nil, false, nil, insufficient},
+		{"retry", start, []any{start.SenderBank, start.ID, start.Amount},
+			&repositories.AlreadyExistsError{}, rejected, false, nil, rejected},
+		{"rejected", start, []any{start.SenderBank, start.ID, start.Amount},
+			nil, rejected, true, nil, rejected},
+		{"release failed", start, []any{start.SenderBank, start.ID, start.Amount},
+			nil, insufficient, true, errors.New("some error"), insufficient},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			repo := &reservetest.MockRepo{}
+			commandHandler := &transactiontest.MockCommandHandler{}
+			handler := eventhorizon.UseCommandHandlerMiddleware(commandHandler,
+				reserve.Holder(bankapitest.Logger, repo, transactions))
+
+			if tc.hold != nil {
+				repo.On("Hold", tc.hold...).Return(tc.holdErr).Once()
+			}
+			if tc.holdErr != insufficient {
+				commandHandler.On("HandleCommand", mock.Anything, tc.cmd).
+					Return(tc.handleErr).Once()
+			}
+			if tc.release {
+				repo.On("Release", tc.hold[1]).Return(tc.releaseErr).Once()
+			}
+
+			err := handler.HandleCommand(ctx, tc.cmd)
+
+			assert.Equal(t, tc.err, err)
+			repo.AssertExpectations(t)
+			commandHandler.AssertExpectations(t)
+			if !tc.release {
+				repo.AssertNotCalled(t, "Release", mock.Anything)
+			}
+		})
+	}
+}
+
+type transactionStore map[uuid.UUID]*transaction.Aggregate
+
+func (s transactionStore) Load(ctx context.Context, aggregateType eventhorizon.AggregateType,
+	ID uuid.UUID,
+) (eventhorizon.Aggregate, error) {
+	return s[ID], nil
+}
+
+func sameBankTransaction(ID uuid.UUID) *transaction.Aggregate {
+	ag := transactiontest.CompletedTransaction(ID)
+	ag.Transaction.ReceiverBank = ag.Transaction.SenderBank
+	return ag
+}
@@ -0,0 +1,41 @@
+package reserve
+
+import (
+	"codepix/bank-api/transaction"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+// Transfers is the part of the reserves repository the mover needs.
+type Transfers interface {
+	Release(transferID uuid.UUID) error
+	Transfer(transferID, payee uuid.UUID) error
+}
+
+// Mover moves the funds held for a transaction or refund to the receiving bank once it
+// completes, and releases them once it fails.
+type Mover struct {
+	Transfers Transfers
+}
+
+var _ eventhorizon.EventHandler = Mover{}
+
+func (m Mover) HandlerType() eventhorizon.EventHandlerType {
+	return eventhorizon.EventHandlerType("reserve")
+}
+
+func (m Mover) HandleEvent(ctx context.Context, event eventhorizon.Event) error {
+	switch e := event.Data().(type) {
+	case *transaction.TransactionCompleted:
+		return m.Transfers.Transfer(event.AggregateID(), e.ReceiverBank)
+	case *transaction.TransactionFailed:
+		return m.Transfers.Release(event.AggregateID())
+	case *transaction.TransactionRefundCompleted:
+		return m.Transfers.Transfer(e.RefundID, e.SenderBank)
+	case *transaction.TransactionRefundFailed:
+		return m.Transfers.Release(e.RefundID)
+	}
+	return nil
+}
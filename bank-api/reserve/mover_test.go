@@ -0,0 +1,54 @@
+package reserve_test
+
+import (
+	"codepix/bank-api/reserve"
+	"codepix/bank-api/reserve/reservetest"
+	"codepix/bank-api/transaction"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMover(t *testing.T) {
+	repo := &reservetest.MockRepo{}
+	mover := reserve.Mover{Transfers: repo}
+
+	ID, refundID := uuid.New(), uuid.New()
+	senderBank, receiverBank := uuid.New(), uuid.New()
+	event := func(eventType eventhorizon.EventType, data eventhorizon.EventData,
+	) eventhorizon.Event {
+		return eventhorizon.NewEvent(eventType, data, time.Now(),
+			eventhorizon.ForAggregate(transaction.AggregateType, ID, 3))
+	}
+
+	repo.On("Transfer", ID, receiverBank).Return(nil).Once()
+	repo.On("Release", ID).Return(nil).Once()
+	repo.On("Transfer", refundID, senderBank).Return(nil).Once()
+	repo.On("Release", refundID).Return(nil).Once()
+
+	events := []eventhorizon.Event{
+		event(transaction.CompletedEvent, &transaction.TransactionCompleted{
+			SenderBank: senderBank, ReceiverBank: receiverBank, Amount: 100,
+		}),
+		event(transaction.FailedEvent, &transaction.TransactionFailed{
+			SenderBank: senderBank, ReceiverBank: receiverBank,
+		}),
+		event(transaction.RefundCompletedEvent, &transaction.TransactionRefundCompleted{
+			RefundID: refundID, SenderBank: senderBank, ReceiverBank: receiverBank, Amount: 40,
+		}),
+		event(transaction.RefundFailedEvent, &transaction.TransactionRefundFailed{
+			RefundID: refundID, SenderBank: senderBank, ReceiverBank: receiverBank,
+		}),
+		event(transaction.ConfirmedEvent, &transaction.TransactionConfirmed{
+			SenderBank: senderBank, ReceiverBank: receiverBank,
+		}),
+	}
+	for _, event := range events {
+		assert.NoError(t, mover.HandleEvent(context.Background(), event))
+	}
+	repo.AssertExpectations(t)
+}
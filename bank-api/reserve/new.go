@@ -0,0 +1,31 @@
+package reserve
+
+import (
+	"codepix/bank-api/adapters/eventhandler"
+	"codepix/bank-api/transaction"
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+)
+
+func Setup(logger logr.Logger, outbox eventhorizon.Outbox, transfers Transfers) error {
+	mover := Mover{Transfers: transfers}
+	err := outbox.AddHandler(context.Background(),
+		eventhorizon.MatchEvents{
+			transaction.CompletedEvent,
+			transaction.FailedEvent,
+			transaction.RefundCompletedEvent,
+			transaction.RefundFailedEvent,
+		},
+		eventhandler.Named(
+			eventhandler.Logger(logger.WithName("reserve"), mover),
+			mover.HandlerType(),
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("setup reserve: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package database
+
+import (
+	"codepix/bank-api/adapters/databaseclient"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/reserve"
+	"codepix/bank-api/reserve/repository"
+	"codepix/bank-api/transaction"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Database struct {
+	*databaseclient.Database
+}
+
+var _ repository.Repository = Database{}
+
+var errInsufficientReserve = &aggregates.InvariantViolation{reserve.ErrInsufficientReserve}
+
+func (db Database) Find(bankID uuid.UUID) (*reserve.Reserve, error) {
+	var accounts []ReserveAccount
+	tx := db.Where("bank_id = ?", bankID).Limit(1).Find(&accounts)
+	if tx.Error != nil {
+		return nil, databaseclient.MapError(tx)
+	}
+	if len(accounts) == 0 {
+		return &reserve.Reserve{BankID: bankID}, nil
+	}
+	return ReserveAccountFromDB(accounts[0]), nil
+}
+
+func (db Database) Credit(bankID uuid.UUID, amount transaction.Amount,
+) (*reserve.Reserve, error) {
+	tx := credit(db.DB, bankID, amount)
+	if tx.Error != nil {
+		return nil, databaseclient.MapError(tx)
+	}
+	return db.Find(bankID)
+}
+
+func (db Database) Debit(bankID uuid.UUID, amount transaction.Amount,
+) (*reserve.Reserve, error) {
+	tx := db.Model(&ReserveAccount{}).
+		Where("bank_id = ? and balance - held >= ?", bankID, amount).
+		Update("balance", gorm.Expr("balance - ?", amount))
+	if tx.Error != nil {
+		return nil, databaseclient.MapError(tx)
+	}
+	if tx.RowsAffected == 0 {
+		return nil, errInsufficientReserve
+	}
+	return db.Find(bankID)
+}
+
+func (db Database) Hold(bankID, transferID uuid.UUID, amount transaction.Amount) error {
+	return db.Transaction(func(db *gorm.DB) error {
+		tx := db.Create(NewReserveHold(bankID, transferID, amount))
+		if tx.Error != nil {
+			return databaseclient.MapError(tx)
+		}
+		tx = db.Model(&ReserveAccount{}).
+			Where("bank_id = ? and balance - held >= ?", bankID, amount).
+			Update("held", gorm.Expr("held + ?", amount))
+		if tx.Error != nil {
+			return databaseclient.MapError(tx)
+		}
+		if tx.RowsAffected == 0 {
+			return errInsufficientReserve
+		}
+		return nil
+	})
+}
+
+func (db Database) Release(transferID uuid.UUID) error {
+	return db.Transaction(func(db *gorm.DB) error {
+		hold, err := resolve(db, transferID, Released)
+		if hold == nil || err != nil {
+			return err
+		}
+		tx := db.Model(&ReserveAccount{}).
+			Where("bank_id = ?", hold.BankID).
+			Update("held", gorm.Expr("held - ?", hold.Amount))
+		return databaseclient.MapError(tx)
+	})
+}
+
+func (db Database) Transfer(transferID, payee uuid.UUID) error {
+	return db.Transaction(func(db *gorm.DB) error {
+		hold, err := resolve(db, transferID, Transferred)
+		if hold == nil || err != nil {
+			return err
+		}
+		tx := db.Model(&ReserveAccount{}).
+			Where("bank_id = ?", hold.BankID).
+			Updates(map[string]any{
+				"balance": gorm.Expr("balance - ?", hold.Amount),
+				"held":    gorm.Expr("held - ?", hold.Amount),
+			})
+		if tx.Error != nil {
+			return databaseclient.MapError(tx)
+		}
+		return databaseclient.MapError(credit(db, payee, hold.Amount))
+	})
+}
+
+// resolve marks a hold as no longer held, returning nil if it is not held anymore.
+func resolve(db *gorm.DB, transferID uuid.UUID, status HoldStatus) (*ReserveHold, error) {
+	var hold ReserveHold
+	tx := db.First(&hold, "id = ?", transferID)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		return nil, databaseclient.MapError(tx)
+	}
+	tx = db.Model(&ReserveHold{}).
+		Where("id = ? and status = ?", transferID, Held).
+		Update("status", status)
+	if tx.Error != nil {
+		return nil, databaseclient.MapError(tx)
+	}
+	if tx.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &hold, nil
+}
+
+func credit(db *gorm.DB, bankID uuid.UUID, amount transaction.Amount) *gorm.DB {
+	new := NewReserveAccount(bankID, amount)
+	return db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "bank_id"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"updated_at": gorm.Expr("excluded.updated_at"),
+			"balance":    gorm.Expr("reserve_accounts.balance + excluded.balance"),
+		}),
+	}).Create(new)
+}
+
+type ReserveAccount struct {
+	databaseclient.BaseModel
+	BankID  uuid.UUID          `gorm:"<-:create;uniqueIndex"`
+	Balance transaction.Amount `gorm:"not null"`
+	Held    transaction.Amount `gorm:"not null"`
+}
+
+func NewReserveAccount(bankID uuid.UUID, balance transaction.Amount) *ReserveAccount {
+	return &ReserveAccount{
+		BaseModel: databaseclient.NewBaseModel(),
+		BankID:    bankID,
+		Balance:   balance,
+	}
+}
+
+func ReserveAccountFromDB(dbAccount ReserveAccount) *reserve.Reserve {
+	if dbAccount == (ReserveAccount{}) {
+		return nil
+	}
+	return &reserve.Reserve{
+		BankID:  dbAccount.BankID,
+		Balance: dbAccount.Balance,
+		Held:    dbAccount.Held,
+	}
+}
+
+type HoldStatus int
+
+const (
+	_ HoldStatus = iota
+	Held
+	Released
+	Transferred
+)
+
+// ReserveHold is identified by the transfer it holds funds for.
+type ReserveHold struct {
+	databaseclient.BaseModel
+	BankID uuid.UUID          `gorm:"<-:create;index"`
+	Amount transaction.Amount `gorm:"<-:create"`
+	Status HoldStatus
+}
+
+func NewReserveHold(bankID, transferID uuid.UUID, amount transaction.Amount) *ReserveHold {
+	baseModel := databaseclient.NewBaseModel()
+	baseModel.ID = transferID
+	return &ReserveHold{
+		BaseModel: baseModel,
+		BankID:    bankID,
+		Amount:    amount,
+		Status:    Held,
+	}
+}
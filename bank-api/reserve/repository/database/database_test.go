@@ -0,0 +1,86 @@
+package database_test
+
+import (
+	"codepix/bank-api/adapters/databaseclient"
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/lib/repositories"
+	"codepix/bank-api/reserve"
+	"codepix/bank-api/reserve/repository"
+	"codepix/bank-api/reserve/repository/database"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Repo() repository.Repository {
+	client, err := databaseclient.Open(bankapitest.Config, bankapitest.Logger)
+	if err != nil {
+		panic(err)
+	}
+	err = client.AutoMigrate(
+		&database.ReserveAccount{},
+		&database.ReserveHold{},
+	)
+	if err != nil {
+		panic(err)
+	}
+	return &database.Database{Database: client}
+}
+
+func TestReserves(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	repo := Repo()
+	payer, payee := uuid.New(), uuid.New()
+	assertReserve := func(bankID uuid.UUID, balance, held uint64) {
+		t.Helper()
+		found, err := repo.Find(bankID)
+		require.NoError(t, err)
+		assert.Equal(t, reserve.Reserve{bankID, balance, held}, *found)
+	}
+	assertInsufficient := func(err error) {
+		t.Helper()
+		require.IsType(t, &aggregates.InvariantViolation{}, err)
+		assert.Equal(t, reserve.ErrInsufficientReserve, err.(*aggregates.InvariantViolation).Err)
+	}
+
+	assertReserve(payer, 0, 0)
+	assertInsufficient(repo.Hold(payer, uuid.New(), 1))
+
+	credited, err := repo.Credit(payer, 100)
+	require.NoError(t, err)
+	assert.Equal(t, reserve.Reserve{payer, 100, 0}, *credited)
+	_, err = repo.Credit(payer, 50)
+	require.NoError(t, err)
+	assertReserve(payer, 150, 0)
+
+	completed, failed := uuid.New(), uuid.New()
+	require.NoError(t, repo.Hold(payer, completed, 100))
+	assert.IsType(t, &repositories.AlreadyExistsError{}, repo.Hold(payer, completed, 100))
+	require.NoError(t, repo.Hold(payer, failed, 30))
+	assertReserve(payer, 150, 130)
+	assertInsufficient(repo.Hold(payer, uuid.New(), 21))
+	_, err = repo.Debit(payer, 21)
+	assertInsufficient(err)
+
+	require.NoError(t, repo.Release(failed))
+	require.NoError(t, repo.Release(failed), "releasing twice is ignored")
+	assertReserve(payer, 150, 100)
+
+	require.NoError(t, repo.Transfer(completed, payee))
+	require.NoError(t, repo.Transfer(completed, payee), "transferring twice is ignored")
+	require.NoError(t, repo.Release(completed), "a transferred hold cannot be released")
+	assertReserve(payer, 50, 0)
+	assertReserve(payee, 100, 0)
+
+	require.NoError(t, repo.Transfer(uuid.New(), payee), "transfers never held are ignored")
+	assertReserve(payee, 100, 0)
+
+	debited, err := repo.Debit(payer, 50)
+	require.NoError(t, err)
+	assert.Equal(t, reserve.Reserve{payer, 0, 0}, *debited)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"codepix/bank-api/reserve"
+	"codepix/bank-api/transaction"
+
+	"github.com/google/uuid"
+)
+
+// Repository keeps the reserves. Transfers are transactions or refunds, identified by
+// their ID.
+type Repository interface {
+	// Find returns an empty reserve for banks which were never credited.
+	Find(bankID uuid.UUID) (*reserve.Reserve, error)
+	Credit(bankID uuid.UUID, amount transaction.Amount) (*reserve.Reserve, error)
+	Debit(bankID uuid.UUID, amount transaction.Amount) (*reserve.Reserve, error)
+	// Hold sets funds of the bank aside for a transfer. It fails with an invariant
+	// violation if the available balance is not enough.
+	Hold(bankID, transferID uuid.UUID, amount transaction.Amount) error
+	// Release makes the funds held for a transfer available again. Transfers without
+	// funds held are ignored.
+	Release(transferID uuid.UUID) error
+	// Transfer moves the funds held for a transfer to the reserve of the payee. Transfers
+	// without funds held are ignored.
+	Transfer(transferID, payee uuid.UUID) error
+}
@@ -0,0 +1,30 @@
+// Package reserve keeps the reserve balance each participant bank holds at the operator.
+// Funds are held when a bank starts a transaction or a refund, so it cannot pay more than
+// its reserve, and moved to the receiving bank once the transfer completes.
+package reserve
+
+import (
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/transaction"
+
+	"github.com/google/uuid"
+)
+
+var ErrInsufficientReserve = &aggregates.AmountError{
+	"insufficient reserve",
+}
+
+type Reserve struct {
+	BankID  uuid.UUID
+	Balance transaction.Amount
+	Held    transaction.Amount
+}
+
+// Available is the part of the balance not held by transfers in progress. Nothing is
+// available when more is held than the balance, as after a debit by an operator.
+func (r Reserve) Available() transaction.Amount {
+	if r.Held > r.Balance {
+		return 0
+	}
+	return r.Balance - r.Held
+}
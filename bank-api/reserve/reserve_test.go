@@ -0,0 +1,14 @@
+package reserve_test
+
+import (
+	"codepix/bank-api/reserve"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvailable(t *testing.T) {
+	assert.Equal(t, uint64(70), uint64(reserve.Reserve{Balance: 100, Held: 30}.Available()))
+	assert.Zero(t, reserve.Reserve{Balance: 100, Held: 100}.Available())
+	assert.Zero(t, reserve.Reserve{Balance: 30, Held: 100}.Available())
+}
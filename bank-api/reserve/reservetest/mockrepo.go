@@ -0,0 +1,55 @@
+package reservetest
+
+import (
+	"codepix/bank-api/reserve"
+	"codepix/bank-api/reserve/repository"
+	"codepix/bank-api/transaction"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepo struct {
+	mock.Mock
+}
+
+var _ repository.Repository = MockRepo{}
+
+func (m MockRepo) Find(bankID uuid.UUID) (*reserve.Reserve, error) {
+	args := m.Called(bankID)
+	return get[*reserve.Reserve](args, 0), get[error](args, 1)
+}
+
+func (m MockRepo) Credit(bankID uuid.UUID, amount transaction.Amount,
+) (*reserve.Reserve, error) {
+	args := m.Called(bankID, amount)
+	return get[*reserve.Reserve](args, 0), get[error](args, 1)
+}
+
+func (m MockRepo) Debit(bankID uuid.UUID, amount transaction.Amount,
+) (*reserve.Reserve, error) {
+	args := m.Called(bankID, amount)
+	return get[*reserve.Reserve](args, 0), get[error](args, 1)
+}
+
+func (m MockRepo) Hold(bankID, transferID uuid.UUID, amount transaction.Amount) error {
+	args := m.Called(bankID, transferID, amount)
+	return get[error](args, 0)
+}
+
+func (m MockRepo) Release(transferID uuid.UUID) error {
+	args := m.Called(transferID)
+	return get[error](args, 0)
+}
+
+func (m MockRepo) Transfer(transferID, payee uuid.UUID) error {
+	args := m.Called(transferID, payee)
+	return get[error](args, 0)
+}
+
+func get[T any](args mock.Arguments, index int) T {
+	if args[index] == nil {
+		return *new(T)
+	}
+	return args[index].(T)
+}
@@ -0,0 +1,24 @@
+package reservetest
+
+import (
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/bankapitest"
+	proto "codepix/bank-api/proto/codepix/reserve"
+	"codepix/bank-api/reserve/service"
+)
+
+func ServiceWithMocks() (proto.ServiceClient, *MockRepo) {
+	validator, err := validator.New()
+	if err != nil {
+		panic(err)
+	}
+	server, client, serve := bankapitest.Server(validator)
+	repo := new(MockRepo)
+
+	err = service.Register(server, bankapitest.Config, validator, repo)
+	if err != nil {
+		panic(err)
+	}
+	serve()
+	return proto.NewServiceClient(client), repo
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"bytes"
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/config"
+	"codepix/bank-api/lib/validation"
+	proto "codepix/bank-api/proto/codepix/reserve"
+	"codepix/bank-api/reserve/repository"
+	_ "embed"
+
+	"google.golang.org/grpc"
+)
+
+//go:embed translations.json
+var translations []byte
+
+func Register(server *grpc.Server, config config.Config, val *validation.Validator,
+	repository repository.Repository,
+) error {
+	err := validator.LoadTranslationFile(val, bytes.NewReader(translations),
+		proto.FindRequest{},
+		proto.CreditRequest{},
+		proto.DebitRequest{},
+	)
+	if err != nil {
+		return err
+	}
+	service := &Service{
+		Repository: repository,
+		Operators:  config.Reserve.Operators,
+	}
+	proto.RegisterServiceServer(server, service)
+	return nil
+}
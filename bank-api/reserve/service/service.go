@@ -0,0 +1,78 @@
+package service
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/bank/auth"
+	proto "codepix/bank-api/proto/codepix/reserve"
+	"codepix/bank-api/reserve"
+	"codepix/bank-api/reserve/repository"
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service struct {
+	Repository repository.Repository
+	Operators  []uuid.UUID
+	proto.UnimplementedServiceServer
+}
+
+var _ proto.ServiceServer = Service{}
+
+// Find is allowed to the bank of the reserve, and to operators.
+func (s Service) Find(ctx context.Context, req *proto.FindRequest) (*proto.Reserve, error) {
+	callerID := auth.GetBankID(ctx)
+	bankID := callerID
+	if len(req.BankId) > 0 {
+		bankID, _ = uuid.FromBytes(req.BankId)
+	}
+	if bankID != callerID && !s.isOperator(callerID) {
+		return nil, status.Error(codes.PermissionDenied, "")
+	}
+	reserve, err := s.Repository.Find(bankID)
+	return reserveReply(reserve), rpc.MapError(ctx, err)
+}
+
+func (s Service) Credit(ctx context.Context, req *proto.CreditRequest) (*proto.Reserve, error) {
+	if !s.isOperator(auth.GetBankID(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "")
+	}
+	bankID, _ := uuid.FromBytes(req.BankId)
+
+	reserve, err := s.Repository.Credit(bankID, req.Amount)
+	return reserveReply(reserve), rpc.MapError(ctx, err)
+}
+
+// Debit fails if the funds are not available, as held funds cannot be debited.
+func (s Service) Debit(ctx context.Context, req *proto.DebitRequest) (*proto.Reserve, error) {
+	if !s.isOperator(auth.GetBankID(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "")
+	}
+	bankID, _ := uuid.FromBytes(req.BankId)
+
+	reserve, err := s.Repository.Debit(bankID, req.Amount)
+	return reserveReply(reserve), rpc.MapError(ctx, err)
+}
+
+func (s Service) isOperator(bankID uuid.UUID) bool {
+	for _, operator := range s.Operators {
+		if operator == bankID {
+			return true
+		}
+	}
+	return false
+}
+
+func reserveReply(reserve *reserve.Reserve) *proto.Reserve {
+	if reserve == nil {
+		return nil
+	}
+	return &proto.Reserve{
+		BankId:    reserve.BankID[:],
+		Balance:   reserve.Balance,
+		Held:      reserve.Held,
+		Available: reserve.Available(),
+	}
+}
@@ -0,0 +1,85 @@
+package service_test
+
+import (
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/lib/aggregates"
+	proto "codepix/bank-api/proto/codepix/reserve"
+	"codepix/bank-api/reserve"
+	"codepix/bank-api/reserve/reservetest"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var operator = bankapitest.Config.Reserve.Operators[0]
+
+func TestFind(t *testing.T) {
+	client, repo := reservetest.ServiceWithMocks()
+	bankID := uuid.New()
+
+	testCases := []struct {
+		description string
+		callerID    uuid.UUID
+		bankID      []byte
+		code        codes.Code
+	}{
+		{"own reserve", bankID, nil, codes.OK},
+		{"own reserve by ID", bankID, bankID[:], codes.OK},
+		{"operator", operator, bankID[:], codes.OK},
+		{"other bank", uuid.New(), bankID[:], codes.PermissionDenied},
+		{"invalid ID", bankID, []byte{1}, codes.InvalidArgument},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			if tc.code == codes.OK {
+				found := &reserve.Reserve{BankID: bankID, Balance: 100, Held: 30}
+				repo.On("Find", bankID).Return(found, nil).Once()
+			}
+			ctx := bankapitest.AuthenticatedContext(context.Background(), tc.callerID)
+
+			reply, err := client.Find(ctx, &proto.FindRequest{BankId: tc.bankID})
+
+			assert.Equal(t, tc.code.String(), statusCode(err).String())
+			if tc.code == codes.OK {
+				require.NotNil(t, reply)
+				assert.Equal(t, uint64(70), reply.Available)
+			}
+		})
+	}
+}
+
+func TestCreditAndDebit(t *testing.T) {
+	client, repo := reservetest.ServiceWithMocks()
+	bankID := uuid.New()
+	operatorCtx := bankapitest.AuthenticatedContext(context.Background(), operator)
+	bankCtx := bankapitest.AuthenticatedContext(context.Background(), bankID)
+
+	repo.On("Credit", bankID, uint64(100)).
+		Return(&reserve.Reserve{BankID: bankID, Balance: 100}, nil).Once()
+	reply, err := client.Credit(operatorCtx, &proto.CreditRequest{BankId: bankID[:], Amount: 100})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), reply.Balance)
+
+	repo.On("Debit", bankID, uint64(200)).
+		Return(nil, &aggregates.InvariantViolation{reserve.ErrInsufficientReserve}).Once()
+	_, err = client.Debit(operatorCtx, &proto.DebitRequest{BankId: bankID[:], Amount: 200})
+	assert.Equal(t, codes.FailedPrecondition.String(), statusCode(err).String())
+
+	_, err = client.Credit(bankCtx, &proto.CreditRequest{BankId: bankID[:], Amount: 100})
+	assert.Equal(t, codes.PermissionDenied.String(), statusCode(err).String())
+	_, err = client.Debit(bankCtx, &proto.DebitRequest{BankId: bankID[:], Amount: 100})
+	assert.Equal(t, codes.PermissionDenied.String(), statusCode(err).String())
+
+	repo.AssertExpectations(t)
+}
+
+func statusCode(err error) codes.Code {
+	status, _ := status.FromError(err)
+	return status.Code()
+}
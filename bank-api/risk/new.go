@@ -0,0 +1,43 @@
+package risk
+
+import (
+	"codepix/bank-api/config"
+	"codepix/bank-api/transaction/read/repository"
+)
+
+// Default returns the built-in rules, backed by the transaction projection. Rules with a
+// zero threshold in the config are left out.
+func Default(config config.Config, transactions repository.Repository) Rules {
+	c := config.Risk
+	rules := Rules{}
+	keys := map[string]bool{}
+	for _, key := range c.FlaggedKeys {
+		if key != "" {
+			keys[key] = true
+		}
+	}
+	if len(keys) > 0 {
+		rules = append(rules, FlaggedKeys{Keys: keys})
+	}
+	if c.VelocityMax > 0 {
+		rules = append(rules, Velocity{
+			Transactions: transactions,
+			Window:       c.VelocityWindow,
+			Max:          c.VelocityMax,
+		})
+	}
+	if c.FirstReceiverAmount > 0 {
+		rules = append(rules, FirstReceiver{
+			Transactions: transactions,
+			Amount:       c.FirstReceiverAmount,
+		})
+	}
+	if c.AnomalyHistory > 0 && c.AnomalyFactor > 0 {
+		rules = append(rules, AmountAnomaly{
+			Transactions: transactions,
+			History:      c.AnomalyHistory,
+			Factor:       c.AnomalyFactor,
+		})
+	}
+	return rules
+}
@@ -0,0 +1,92 @@
+// Package risk evaluates transactions before they start. Each rule allows, holds or
+// blocks a transaction, and the most severe decision wins: blocked transactions are not
+// started, and held ones are started but cannot be completed until a configured reviewer
+// releases them. The decisions are recorded along with the started event.
+package risk
+
+import (
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/transaction"
+	"context"
+	"fmt"
+)
+
+type Decision uint8
+
+const (
+	Allow Decision = iota
+	Hold
+	Block
+)
+
+func (d Decision) String() string {
+	switch d {
+	case Allow:
+		return "allow"
+	case Hold:
+		return "hold"
+	case Block:
+		return "block"
+	}
+	return fmt.Sprintf("decision(%d)", d)
+}
+
+// Subject is a transaction about to start. ReceiverKey is empty if the transaction pays a
+// charge rather than a key.
+type Subject struct {
+	Start       transaction.Start
+	ReceiverKey string
+}
+
+type Verdict struct {
+	Decision Decision
+	Reason   string
+}
+
+// Rule evaluates a single risk of a transaction. Rules must not change anything, as the
+// transaction may still not start.
+type Rule interface {
+	Name() string
+	Evaluate(ctx context.Context, subject Subject) (Verdict, error)
+}
+
+// Assessor evaluates transactions before they start, returning the command to start them
+// with, or an error if they must not start.
+type Assessor interface {
+	Assess(ctx context.Context, start transaction.Start, receiverKey string,
+	) (transaction.Start, error)
+}
+
+// Rules assesses transactions with every rule in order, stopping at the first one to
+// block the transaction.
+type Rules []Rule
+
+var _ Assessor = Rules{}
+
+func (r Rules) Assess(ctx context.Context, start transaction.Start, receiverKey string,
+) (transaction.Start, error) {
+	subject := Subject{Start: start, ReceiverKey: receiverKey}
+	decision := Allow
+	start.Risk = nil
+	for _, rule := range r {
+		verdict, err := rule.Evaluate(ctx, subject)
+		if err != nil {
+			return start, fmt.Errorf("evaluate risk rule %s: %w", rule.Name(), err)
+		}
+		if verdict.Decision == Block {
+			return start, &aggregates.InvariantViolation{&aggregates.PermissionError{
+				fmt.Sprintf("blocked by risk rule %s: %s", rule.Name(), verdict.Reason),
+			}}
+		}
+		if verdict.Decision > decision {
+			decision = verdict.Decision
+		}
+		start.Risk = append(start.Risk, transaction.RiskDecision{
+			Rule:     rule.Name(),
+			Decision: verdict.Decision.String(),
+			Reason:   verdict.Reason,
+		})
+	}
+	start.Held = decision == Hold
+	return start, nil
+}
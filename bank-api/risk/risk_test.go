@@ -0,0 +1,67 @@
+package risk_test
+
+import (
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/risk"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/transactiontest"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type rule struct {
+	name    string
+	verdict risk.Verdict
+	err     error
+}
+
+func (r rule) Name() string { return r.name }
+
+func (r rule) Evaluate(ctx context.Context, subject risk.Subject) (risk.Verdict, error) {
+	return r.verdict, r.err
+}
+
+func TestAssess(t *testing.T) {
+	ctx := context.Background()
+	start := transactiontest.ValidStartCommand(uuid.New())
+	allow := rule{"allow", risk.Verdict{risk.Allow, ""}, nil}
+	hold := rule{"hold", risk.Verdict{risk.Hold, "too fast"}, nil}
+	block := rule{"block", risk.Verdict{risk.Block, "flagged"}, nil}
+	failing := rule{"failing", risk.Verdict{}, errors.New("some error")}
+
+	t.Run("allowed", func(t *testing.T) {
+		assessed, err := risk.Rules{allow}.Assess(ctx, start, "key")
+		require.NoError(t, err)
+		assert.False(t, assessed.Held)
+		assert.Equal(t, []transaction.RiskDecision{{"allow", "allow", ""}}, assessed.Risk)
+	})
+	t.Run("held", func(t *testing.T) {
+		assessed, err := risk.Rules{hold, allow}.Assess(ctx, start, "key")
+		require.NoError(t, err)
+		assert.True(t, assessed.Held)
+		assert.Equal(t, []transaction.RiskDecision{
+			{"hold", "hold", "too fast"},
+			{"allow", "allow", ""},
+		}, assessed.Risk)
+	})
+	t.Run("blocked", func(t *testing.T) {
+		_, err := risk.Rules{hold, block, failing}.Assess(ctx, start, "key")
+		require.IsType(t, &aggregates.InvariantViolation{}, err)
+		assert.Equal(t, &aggregates.PermissionError{"blocked by risk rule block: flagged"},
+			err.(*aggregates.InvariantViolation).Err)
+	})
+	t.Run("rule error", func(t *testing.T) {
+		_, err := risk.Rules{allow, failing}.Assess(ctx, start, "key")
+		assert.EqualError(t, err, "evaluate risk rule failing: some error")
+	})
+	t.Run("no rules", func(t *testing.T) {
+		assessed, err := risk.Rules{}.Assess(ctx, start, "key")
+		require.NoError(t, err)
+		assert.Equal(t, start, assessed)
+	})
+}
@@ -0,0 +1,113 @@
+package risk
+
+import (
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/read/repository"
+	"context"
+	"fmt"
+	"time"
+)
+
+// Velocity holds transactions of senders who already started Max transactions within
+// Window.
+type Velocity struct {
+	Transactions repository.Repository
+	Window       time.Duration
+	Max          uint64
+}
+
+func (r Velocity) Name() string { return "velocity" }
+
+func (r Velocity) Evaluate(ctx context.Context, subject Subject) (Verdict, error) {
+	recent, err := r.Transactions.List(ctx, repository.ListOptions{
+		CreatedAfter: time.Now().Add(-r.Window),
+		SenderID:     subject.Start.Sender,
+		Limit:        r.Max,
+	})
+	if err != nil {
+		return Verdict{}, err
+	}
+	if uint64(len(recent)) < r.Max {
+		return Verdict{Decision: Allow}, nil
+	}
+	return Verdict{
+		Decision: Hold,
+		Reason:   fmt.Sprintf("%d or more transactions in the last %s", r.Max, r.Window),
+	}, nil
+}
+
+// FirstReceiver holds transactions of at least Amount to receivers the sender never sent
+// to before.
+type FirstReceiver struct {
+	Transactions repository.Repository
+	Amount       transaction.Amount
+}
+
+func (r FirstReceiver) Name() string { return "first_receiver" }
+
+func (r FirstReceiver) Evaluate(ctx context.Context, subject Subject) (Verdict, error) {
+	if subject.Start.Amount < r.Amount {
+		return Verdict{Decision: Allow}, nil
+	}
+	previous, err := r.Transactions.List(ctx, repository.ListOptions{
+		SenderID:   subject.Start.Sender,
+		ReceiverID: subject.Start.Receiver,
+		Limit:      1,
+	})
+	if err != nil {
+		return Verdict{}, err
+	}
+	if len(previous) > 0 {
+		return Verdict{Decision: Allow}, nil
+	}
+	return Verdict{Decision: Hold, Reason: "first transaction to the receiver"}, nil
+}
+
+// AmountAnomaly holds transactions of more than Factor times the average amount of the
+// last History transactions of the sender, failed ones aside. Senders with a shorter
+// history are not evaluated.
+type AmountAnomaly struct {
+	Transactions repository.Repository
+	History      uint64
+	Factor       uint64
+}
+
+func (r AmountAnomaly) Name() string { return "amount_anomaly" }
+
+func (r AmountAnomaly) Evaluate(ctx context.Context, subject Subject) (Verdict, error) {
+	history, err := r.Transactions.List(ctx, repository.ListOptions{
+		SenderID: subject.Start.Sender,
+		Limit:    r.History,
+	})
+	if err != nil {
+		return Verdict{}, err
+	}
+	var count, total transaction.Amount
+	for _, tx := range history {
+		if tx.Status != transaction.Failed {
+			count++
+			total += tx.Amount
+		}
+	}
+	if count == 0 || count < r.History || subject.Start.Amount <= total/count*r.Factor {
+		return Verdict{Decision: Allow}, nil
+	}
+	return Verdict{
+		Decision: Hold,
+		Reason:   fmt.Sprintf("amount over %d times the average of %d", r.Factor, total/count),
+	}, nil
+}
+
+// FlaggedKeys blocks transactions to keys flagged as fraudulent.
+type FlaggedKeys struct {
+	Keys map[string]bool
+}
+
+func (r FlaggedKeys) Name() string { return "flagged_key" }
+
+func (r FlaggedKeys) Evaluate(ctx context.Context, subject Subject) (Verdict, error) {
+	if !r.Keys[subject.ReceiverKey] {
+		return Verdict{Decision: Allow}, nil
+	}
+	return Verdict{Decision: Block, Reason: "receiver key flagged as fraudulent"}, nil
+}
@@ -0,0 +1,144 @@
+package risk_test
+
+import (
+	"codepix/bank-api/risk"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/read/repository"
+	"codepix/bank-api/transaction/transactiontest"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func transactions(amounts ...transaction.Amount) []repository.ListItem {
+	items := []repository.ListItem{}
+	for _, amount := range amounts {
+		items = append(items, repository.ListItem{
+			ID:     uuid.New(),
+			Amount: amount,
+			Status: transaction.Completed,
+		})
+	}
+	return items
+}
+
+func TestVelocity(t *testing.T) {
+	ctx := context.Background()
+	start := transactiontest.ValidStartCommand(uuid.New())
+	subject := risk.Subject{Start: start}
+
+	testCases := []struct {
+		description string
+		recent      []repository.ListItem
+		decision    risk.Decision
+	}{
+		{"below", transactions(10, 10), risk.Allow},
+		{"reached", transactions(10, 10, 10), risk.Hold},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			repo := &transactiontest.MockReadRepo{}
+			repo.On("List", ctx, mock.MatchedBy(func(options repository.ListOptions) bool {
+				since := time.Since(options.CreatedAfter)
+				return options.SenderID == start.Sender && options.Limit == 3 &&
+					since >= time.Hour && since < time.Hour+time.Minute
+			})).Return(tc.recent, nil).Once()
+			rule := risk.Velocity{Transactions: repo, Window: time.Hour, Max: 3}
+
+			verdict, err := rule.Evaluate(ctx, subject)
+			require.NoError(t, err)
+			assert.Equal(t, tc.decision, verdict.Decision)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestFirstReceiver(t *testing.T) {
+	ctx := context.Background()
+	start := transactiontest.ValidStartCommand(uuid.New())
+	options := repository.ListOptions{SenderID: start.Sender, ReceiverID: start.Receiver, Limit: 1}
+
+	testCases := []struct {
+		description string
+		amount      transaction.Amount
+		previous    []repository.ListItem
+		decision    risk.Decision
+	}{
+		{"known receiver", 100, transactions(50), risk.Allow},
+		{"first receiver", 100, transactions(), risk.Hold},
+		{"small amount", 99, nil, risk.Allow},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			repo := &transactiontest.MockReadRepo{}
+			if tc.previous != nil {
+				repo.On("List", ctx, options).Return(tc.previous, nil).Once()
+			}
+			rule := risk.FirstReceiver{Transactions: repo, Amount: 100}
+			subject := risk.Subject{Start: start}
+			subject.Start.Amount = tc.amount
+
+			verdict, err := rule.Evaluate(ctx, subject)
+			require.NoError(t, err)
+			assert.Equal(t, tc.decision, verdict.Decision)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAmountAnomaly(t *testing.T) {
+	ctx := context.Background()
+	start := transactiontest.ValidStartCommand(uuid.New())
+	options := repository.ListOptions{SenderID: start.Sender, Limit: 3}
+	withFailed := transactions(10, 20, 30)
+	withFailed[1].Status = transaction.Failed
+
+	testCases := []struct {
+		description string
+		amount      transaction.Amount
+		history     []repository.ListItem
+		decision    risk.Decision
+	}{
+		{"usual amount", 60, transactions(10, 20, 30), risk.Allow},
+		{"anomalous amount", 61, transactions(10, 20, 30), risk.Hold},
+		{"short history", 1000, transactions(10, 20), risk.Allow},
+		{"failed history", 1000, withFailed, risk.Allow},
+		{"no history", 1000, transactions(), risk.Allow},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			repo := &transactiontest.MockReadRepo{}
+			repo.On("List", ctx, options).Return(tc.history, nil).Once()
+			rule := risk.AmountAnomaly{Transactions: repo, History: 3, Factor: 3}
+			subject := risk.Subject{Start: start}
+			subject.Start.Amount = tc.amount
+
+			verdict, err := rule.Evaluate(ctx, subject)
+			require.NoError(t, err)
+			assert.Equal(t, tc.decision, verdict.Decision)
+		})
+	}
+}
+
+func TestFlaggedKeys(t *testing.T) {
+	ctx := context.Background()
+	start := transactiontest.ValidStartCommand(uuid.New())
+	rule := risk.FlaggedKeys{Keys: map[string]bool{"flagged@example.com": true}}
+
+	verdict, err := rule.Evaluate(ctx, risk.Subject{start, "flagged@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, risk.Block, verdict.Decision)
+
+	verdict, err = rule.Evaluate(ctx, risk.Subject{start, "other@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, risk.Allow, verdict.Decision)
+
+	verdict, err = rule.Evaluate(ctx, risk.Subject{start, ""})
+	require.NoError(t, err)
+	assert.Equal(t, risk.Allow, verdict.Decision)
+}
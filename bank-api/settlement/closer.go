@@ -0,0 +1,66 @@
+package settlement
+
+import (
+	"codepix/bank-api/settlement/repository"
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// Closer closes the settlement windows once they are over, signing the report of each
+// bank with its key.
+type Closer struct {
+	Logger     logr.Logger
+	Repository repository.Repository
+	Windows    Windows
+	Delay      time.Duration
+	Interval   time.Duration
+	SigningKey ed25519.PrivateKey
+}
+
+// Run closes the windows due every interval until the context is done.
+func (c Closer) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			err := c.CloseDue(now)
+			if err != nil {
+				c.Logger.Error(err, "fail: close settlement windows")
+			}
+		}
+	}
+}
+
+// CloseDue closes, oldest first, every window that ended at least the delay before now.
+// When no window was closed yet, only the latest one due is closed, and it settles every
+// flow recorded before its end.
+func (c Closer) CloseDue(now time.Time) error {
+	dueUntil := c.Windows.Start(now.Add(-c.Delay))
+	last, err := c.Repository.LastClosed()
+	if err != nil {
+		return fmt.Errorf("find last closed window: %w", err)
+	}
+	window := repository.Window{Start: dueUntil.Add(-c.Windows.Length), End: dueUntil}
+	if last != nil {
+		window = c.Windows.After(last.End)
+	}
+	for !window.End.After(dueUntil) {
+		window.ClosedAt = now.UTC().Truncate(time.Millisecond)
+		err := c.Repository.Close(window, func(report *repository.Report) {
+			Sign(c.SigningKey, report)
+		})
+		if err != nil {
+			return fmt.Errorf("close window %s: %w", window.Start, err)
+		}
+		c.Logger.Info("settlement window closed", "start", window.Start, "end", window.End)
+		window = c.Windows.After(window.End)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package settlement_test
+
+import (
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/settlement"
+	"codepix/bank-api/settlement/repository"
+	"codepix/bank-api/settlement/settlementtest"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloseDue(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	day := func(day, hour int) time.Time {
+		return time.Date(2022, 6, day, hour, 0, 0, 0, time.UTC)
+	}
+	window := func(start time.Time) repository.Window {
+		return repository.Window{Start: start, End: start.Add(24 * time.Hour)}
+	}
+	closed := func(window repository.Window, closedAt time.Time) repository.Window {
+		window.ClosedAt = closedAt
+		return window
+	}
+	closer := func(repo *settlementtest.MockRepo) settlement.Closer {
+		return settlement.Closer{
+			Logger:     bankapitest.Logger,
+			Repository: repo,
+			Windows:    settlement.Windows{Length: 24 * time.Hour, Offset: 3 * time.Hour},
+			Delay:      5 * time.Minute,
+			SigningKey: privateKey,
+		}
+	}
+
+	t.Run("first window", func(t *testing.T) {
+		repo := &settlementtest.MockRepo{}
+		now := day(5, 4)
+		report := &repository.Report{BankID: uuid.New(), Window: closed(window(day(4, 3)), now)}
+
+		repo.On("LastClosed").Return(nil, nil).Once()
+		repo.On("Close", closed(window(day(4, 3)), now)).Return(nil, []*repository.Report{report}).Once()
+
+		require.NoError(t, closer(repo).CloseDue(now))
+		repo.AssertExpectations(t)
+		assert.True(t, settlement.Verify(publicKey, *report))
+	})
+	t.Run("missed windows", func(t *testing.T) {
+		repo := &settlementtest.MockRepo{}
+		now := day(5, 4)
+		last := window(day(2, 3))
+
+		repo.On("LastClosed").Return(&last, nil).Once()
+		repo.On("Close", closed(window(day(3, 3)), now)).Return(nil, nil).Once()
+		repo.On("Close", closed(window(day(4, 3)), now)).Return(nil, nil).Once()
+
+		require.NoError(t, closer(repo).CloseDue(now))
+		repo.AssertExpectations(t)
+	})
+	t.Run("within delay", func(t *testing.T) {
+		repo := &settlementtest.MockRepo{}
+		now := day(5, 3).Add(time.Minute)
+		last := window(day(3, 3))
+
+		repo.On("LastClosed").Return(&last, nil).Once()
+
+		require.NoError(t, closer(repo).CloseDue(now))
+		repo.AssertNotCalled(t, "Close", mock.Anything)
+	})
+	t.Run("close fails", func(t *testing.T) {
+		repo := &settlementtest.MockRepo{}
+		now := day(5, 4)
+		last := window(day(2, 3))
+
+		repo.On("LastClosed").Return(&last, nil).Once()
+		repo.On("Close", closed(window(day(3, 3)), now)).Return(errors.New("some error"), nil).Once()
+
+		assert.Error(t, closer(repo).CloseDue(now))
+		repo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,42 @@
+package settlement
+
+import (
+	"codepix/bank-api/adapters/eventhandler"
+	"codepix/bank-api/config"
+	"codepix/bank-api/settlement/repository"
+	"codepix/bank-api/transaction"
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+)
+
+func Setup(logger logr.Logger, config config.Config, outbox eventhorizon.Outbox,
+	repository repository.Repository,
+) (*Closer, error) {
+	logger = logger.WithName("settlement")
+	recorder := Recorder{Repository: repository}
+	err := outbox.AddHandler(context.Background(),
+		eventhorizon.MatchEvents{transaction.CompletedEvent, transaction.RefundCompletedEvent},
+		eventhandler.Named(
+			eventhandler.Logger(logger, recorder),
+			recorder.HandlerType(),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("setup settlement: %w", err)
+	}
+	closer := &Closer{
+		Logger:     logger,
+		Repository: repository,
+		Windows: Windows{
+			Length: config.Settlement.Window,
+			Offset: config.Settlement.WindowOffset,
+		},
+		Delay:      config.Settlement.CloseDelay,
+		Interval:   config.Settlement.CloseInterval,
+		SigningKey: config.Settlement.SigningKey,
+	}
+	return closer, nil
+}
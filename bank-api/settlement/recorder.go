@@ -0,0 +1,51 @@
+package settlement
+
+import (
+	"codepix/bank-api/settlement/repository"
+	"codepix/bank-api/transaction"
+	"context"
+
+	"github.com/looplab/eventhorizon"
+)
+
+// Recorder records the money moved between banks by completed transactions and refunds.
+// Transactions within the same bank are not settled.
+type Recorder struct {
+	Repository repository.Repository
+}
+
+var _ eventhorizon.EventHandler = Recorder{}
+
+func (r Recorder) HandlerType() eventhorizon.EventHandlerType {
+	return eventhorizon.EventHandlerType("settlement")
+}
+
+func (r Recorder) HandleEvent(ctx context.Context, event eventhorizon.Event) error {
+	var flow repository.Flow
+	switch e := event.Data().(type) {
+	case *transaction.TransactionCompleted:
+		flow = repository.Flow{
+			ID:            event.AggregateID(),
+			TransactionID: event.AggregateID(),
+			Payer:         e.SenderBank,
+			Payee:         e.ReceiverBank,
+			Amount:        e.Amount,
+			SettledAt:     event.Timestamp(),
+		}
+	case *transaction.TransactionRefundCompleted:
+		flow = repository.Flow{
+			ID:            e.RefundID,
+			TransactionID: event.AggregateID(),
+			Payer:         e.ReceiverBank,
+			Payee:         e.SenderBank,
+			Amount:        e.Amount,
+			SettledAt:     event.Timestamp(),
+		}
+	default:
+		return nil
+	}
+	if flow.Payer == flow.Payee {
+		return nil
+	}
+	return r.Repository.Record(flow)
+}
@@ -0,0 +1,52 @@
+package settlement_test
+
+import (
+	"codepix/bank-api/settlement"
+	"codepix/bank-api/settlement/repository"
+	"codepix/bank-api/settlement/settlementtest"
+	"codepix/bank-api/transaction"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	repo := &settlementtest.MockRepo{}
+	recorder := settlement.Recorder{Repository: repo}
+
+	ID, refundID := uuid.New(), uuid.New()
+	senderBank, receiverBank := uuid.New(), uuid.New()
+	now := time.Now()
+	event := func(eventType eventhorizon.EventType, data eventhorizon.EventData,
+	) eventhorizon.Event {
+		return eventhorizon.NewEvent(eventType, data, now,
+			eventhorizon.ForAggregate(transaction.AggregateType, ID, 3))
+	}
+
+	completed := event(transaction.CompletedEvent, &transaction.TransactionCompleted{
+		SenderBank: senderBank, ReceiverBank: receiverBank, Amount: 100,
+	})
+	refunded := event(transaction.RefundCompletedEvent, &transaction.TransactionRefundCompleted{
+		RefundID: refundID, SenderBank: senderBank, ReceiverBank: receiverBank, Amount: 40,
+	})
+	sameBank := event(transaction.CompletedEvent, &transaction.TransactionCompleted{
+		SenderBank: senderBank, ReceiverBank: senderBank, Amount: 100,
+	})
+	failed := event(transaction.FailedEvent, &transaction.TransactionFailed{
+		SenderBank: senderBank, ReceiverBank: receiverBank,
+	})
+
+	repo.On("Record", repository.Flow{ID, ID, senderBank, receiverBank, 100, now}).
+		Return(nil).Once()
+	repo.On("Record", repository.Flow{refundID, ID, receiverBank, senderBank, 40, now}).
+		Return(nil).Once()
+
+	for _, event := range []eventhorizon.Event{completed, refunded, sameBank, failed} {
+		assert.NoError(t, recorder.HandleEvent(context.Background(), event))
+	}
+	repo.AssertExpectations(t)
+}
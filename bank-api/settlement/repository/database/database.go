@@ -0,0 +1,176 @@
+package database
+
+import (
+	"codepix/bank-api/adapters/databaseclient"
+	"codepix/bank-api/settlement/repository"
+	"codepix/bank-api/transaction"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Database struct {
+	*databaseclient.Database
+}
+
+var _ repository.Repository = Database{}
+
+func (db Database) Record(flow repository.Flow) error {
+	new := NewSettlementFlow(flow)
+	tx := db.Clauses(clause.OnConflict{DoNothing: true}).Create(new)
+	return databaseclient.MapError(tx)
+}
+
+func (db Database) OpenTotals() ([]repository.Total, error) {
+	return totals(db.DB, "window_start is null")
+}
+
+func (db Database) LastClosed() (*repository.Window, error) {
+	var dbWindows []SettlementWindow
+	tx := db.Order("window_start desc").Limit(1).Find(&dbWindows)
+	if len(dbWindows) == 0 {
+		return nil, databaseclient.MapError(tx)
+	}
+	return SettlementWindowFromDB(dbWindows[0]), databaseclient.MapError(tx)
+}
+
+// Close runs in a single database transaction, so flows recorded meanwhile are either
+// settled in the window and in its reports, or left for the next window.
+func (db Database) Close(window repository.Window, sign func(report *repository.Report)) error {
+	return db.Transaction(func(db *gorm.DB) error {
+		tx := db.Create(NewSettlementWindow(window))
+		if tx.Error != nil {
+			return databaseclient.MapError(tx)
+		}
+		tx = db.Model(&SettlementFlow{}).
+			Where("window_start is null and settled_at < ?", window.End.UTC()).
+			Update("window_start", window.Start.UTC())
+		if tx.Error != nil {
+			return databaseclient.MapError(tx)
+		}
+		totals, err := totals(db, "window_start = ?", window.Start.UTC())
+		if err != nil {
+			return err
+		}
+		for _, bankID := range repository.Banks(totals) {
+			report := repository.Report{
+				BankID:    bankID,
+				Window:    window,
+				Positions: repository.Positions(bankID, totals),
+			}
+			sign(&report)
+			tx = db.Create(NewSettlementReport(report))
+			if tx.Error != nil {
+				return databaseclient.MapError(tx)
+			}
+		}
+		return nil
+	})
+}
+
+// FindReport finds the latest report of the bank if no window start is given.
+func (db Database) FindReport(bankID uuid.UUID, windowStart time.Time,
+) (*repository.Report, error) {
+	var dbReport SettlementReport
+	tx := db.Where("bank_id = ?", bankID)
+	if !windowStart.IsZero() {
+		tx = tx.Where("window_start = ?", windowStart.UTC())
+	}
+	tx = tx.Order("window_start desc").First(&dbReport)
+	if tx.Error != nil {
+		return nil, databaseclient.MapError(tx)
+	}
+	var dbWindow SettlementWindow
+	tx = db.First(&dbWindow, "window_start = ?", dbReport.WindowStart)
+	if tx.Error != nil {
+		return nil, databaseclient.MapError(tx)
+	}
+	totals, err := totals(db.DB, "window_start = ?", dbReport.WindowStart)
+	if err != nil {
+		return nil, err
+	}
+	return &repository.Report{
+		BankID:    bankID,
+		Window:    *SettlementWindowFromDB(dbWindow),
+		Positions: repository.Positions(bankID, totals),
+		Signature: dbReport.Signature,
+	}, nil
+}
+
+func totals(db *gorm.DB, query string, args ...any) ([]repository.Total, error) {
+	var totals []repository.Total
+	tx := db.Model(&SettlementFlow{}).
+		Select("payer, payee, sum(amount) as amount").
+		Where(query, args...).
+		Group("payer, payee").
+		Scan(&totals)
+	return totals, databaseclient.MapError(tx)
+}
+
+type SettlementFlow struct {
+	databaseclient.BaseModel
+	TransactionID uuid.UUID          `gorm:"<-:create;index"`
+	Payer         uuid.UUID          `gorm:"<-:create"`
+	Payee         uuid.UUID          `gorm:"<-:create"`
+	Amount        transaction.Amount `gorm:"<-:create"`
+	SettledAt     time.Time          `gorm:"<-:create"`
+	WindowStart   *time.Time         `gorm:"index"`
+}
+
+func NewSettlementFlow(flow repository.Flow) *SettlementFlow {
+	baseModel := databaseclient.NewBaseModel()
+	baseModel.ID = flow.ID
+	return &SettlementFlow{
+		BaseModel:     baseModel,
+		TransactionID: flow.TransactionID,
+		Payer:         flow.Payer,
+		Payee:         flow.Payee,
+		Amount:        flow.Amount,
+		SettledAt:     flow.SettledAt.UTC(),
+	}
+}
+
+type SettlementWindow struct {
+	databaseclient.BaseModel
+	WindowStart time.Time `gorm:"<-:create;uniqueIndex"`
+	WindowEnd   time.Time `gorm:"<-:create"`
+	ClosedAt    time.Time `gorm:"<-:create"`
+}
+
+func NewSettlementWindow(window repository.Window) *SettlementWindow {
+	return &SettlementWindow{
+		BaseModel:   databaseclient.NewBaseModel(),
+		WindowStart: window.Start.UTC(),
+		WindowEnd:   window.End.UTC(),
+		ClosedAt:    window.ClosedAt.UTC(),
+	}
+}
+
+func SettlementWindowFromDB(dbWindow SettlementWindow) *repository.Window {
+	if dbWindow == (SettlementWindow{}) {
+		return nil
+	}
+	return &repository.Window{
+		Start:    dbWindow.WindowStart.UTC(),
+		End:      dbWindow.WindowEnd.UTC(),
+		ClosedAt: dbWindow.ClosedAt.UTC(),
+	}
+}
+
+type SettlementReport struct {
+	databaseclient.BaseModel
+	BankID      uuid.UUID `gorm:"<-:create;uniqueIndex:idx_settlement_reports_bank_id_window_start"`
+	WindowStart time.Time `gorm:"<-:create;uniqueIndex:idx_settlement_reports_bank_id_window_start"`
+	Signature   []byte    `gorm:"<-:create"`
+}
+
+func NewSettlementReport(report repository.Report) *SettlementReport {
+	return &SettlementReport{
+		BaseModel:   databaseclient.NewBaseModel(),
+		BankID:      report.BankID,
+		WindowStart: report.Window.Start.UTC(),
+		Signature:   report.Signature,
+	}
+}
@@ -0,0 +1,100 @@
+package database_test
+
+import (
+	"codepix/bank-api/adapters/databaseclient"
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/lib/repositories"
+	"codepix/bank-api/settlement/repository"
+	"codepix/bank-api/settlement/repository/database"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Repo() repository.Repository {
+	client, err := databaseclient.Open(bankapitest.Config, bankapitest.Logger)
+	if err != nil {
+		panic(err)
+	}
+	err = client.AutoMigrate(
+		&database.SettlementFlow{},
+		&database.SettlementWindow{},
+		&database.SettlementReport{},
+	)
+	if err != nil {
+		panic(err)
+	}
+	return &database.Database{Database: client}
+}
+
+func TestSettlement(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	repo := Repo()
+	bankA, bankB, bankC := uuid.New(), uuid.New(), uuid.New()
+	start := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	window := repository.Window{
+		Start:    start,
+		End:      start.Add(24 * time.Hour),
+		ClosedAt: start.Add(25 * time.Hour),
+	}
+
+	flow := func(payer, payee uuid.UUID, amount uint64, settledAt time.Time) repository.Flow {
+		return repository.Flow{
+			ID:            uuid.New(),
+			TransactionID: uuid.New(),
+			Payer:         payer,
+			Payee:         payee,
+			Amount:        amount,
+			SettledAt:     settledAt,
+		}
+	}
+	aToB := flow(bankA, bankB, 100, start.Add(time.Hour))
+	require.NoError(t, repo.Record(aToB))
+	require.NoError(t, repo.Record(aToB), "recording twice is ignored")
+	require.NoError(t, repo.Record(flow(bankB, bankA, 30, start.Add(2*time.Hour))))
+	require.NoError(t, repo.Record(flow(bankA, bankC, 50, start.Add(3*time.Hour))))
+	nextWindow := flow(bankA, bankB, 1000, window.End)
+	require.NoError(t, repo.Record(nextWindow))
+
+	var signed []uuid.UUID
+	err := repo.Close(window, func(report *repository.Report) {
+		signed = append(signed, report.BankID)
+		report.Signature = report.BankID[:]
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{bankA, bankB, bankC}, signed)
+
+	err = repo.Close(window, func(report *repository.Report) {})
+	assert.IsType(t, &repositories.AlreadyExistsError{}, err)
+
+	last, err := repo.LastClosed()
+	require.NoError(t, err)
+	assert.Equal(t, window, *last)
+
+	report, err := repo.FindReport(bankA, window.Start)
+	require.NoError(t, err)
+	assert.Equal(t, window, report.Window)
+	assert.Equal(t, bankA[:], report.Signature)
+	assert.ElementsMatch(t, []repository.Position{
+		{Counterparty: bankB, Sent: 100, Received: 30},
+		{Counterparty: bankC, Sent: 50},
+	}, report.Positions)
+	assert.Equal(t, int64(-120), report.Net())
+
+	latest, err := repo.FindReport(bankC, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(50), latest.Net())
+
+	_, err = repo.FindReport(uuid.New(), window.Start)
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+
+	open, err := repo.OpenTotals()
+	require.NoError(t, err)
+	assert.Contains(t, open, repository.Total{Payer: bankA, Payee: bankB, Amount: 1000})
+	assert.NotContains(t, open, repository.Total{Payer: bankA, Payee: bankB, Amount: 100})
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"codepix/bank-api/transaction"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// Record saves a flow once, flows already recorded are ignored.
+	Record(flow Flow) error
+	// OpenTotals sums the flows not settled by any closed window yet.
+	OpenTotals() ([]Total, error)
+	// LastClosed returns the latest closed window, or nil if none was closed yet.
+	LastClosed() (*Window, error)
+	// Close freezes the window, settling in it every open flow that happened before its
+	// end, and saves the report of each bank involved once sign fills its signature.
+	Close(window Window, sign func(report *Report)) error
+	FindReport(bankID uuid.UUID, windowStart time.Time) (*Report, error)
+}
+
+// Flow is money a completed transaction or refund moved from a bank to another.
+type Flow struct {
+	ID            uuid.UUID
+	TransactionID uuid.UUID
+	Payer         uuid.UUID
+	Payee         uuid.UUID
+	Amount        transaction.Amount
+	SettledAt     time.Time
+}
+
+// Total is the gross amount a bank paid to another.
+type Total struct {
+	Payer  uuid.UUID
+	Payee  uuid.UUID
+	Amount transaction.Amount
+}
+
+type Window struct {
+	Start    time.Time
+	End      time.Time
+	ClosedAt time.Time
+}
+
+// Position is what a bank paid to and received from a counterparty.
+type Position struct {
+	Counterparty uuid.UUID
+	Sent         transaction.Amount
+	Received     transaction.Amount
+}
+
+// Net is positive when the bank is owed money by the counterparty.
+func (p Position) Net() int64 {
+	return int64(p.Received) - int64(p.Sent)
+}
+
+type Report struct {
+	BankID    uuid.UUID
+	Window    Window
+	Positions []Position
+	Signature []byte
+}
+
+func (r Report) Net() int64 {
+	var net int64
+	for _, position := range r.Positions {
+		net += position.Net()
+	}
+	return net
+}
+
+// Positions nets the totals of the bank per counterparty, sorted by counterparty so
+// the same totals always give the same positions.
+func Positions(bankID uuid.UUID, totals []Total) []Position {
+	byCounterparty := map[uuid.UUID]*Position{}
+	get := func(counterparty uuid.UUID) *Position {
+		position, ok := byCounterparty[counterparty]
+		if !ok {
+			position = &Position{Counterparty: counterparty}
+			byCounterparty[counterparty] = position
+		}
+		return position
+	}
+	for _, total := range totals {
+		switch {
+		case total.Payer == total.Payee:
+		case total.Payer == bankID:
+			get(total.Payee).Sent += total.Amount
+		case total.Payee == bankID:
+			get(total.Payer).Received += total.Amount
+		}
+	}
+	positions := []Position{}
+	for _, position := range byCounterparty {
+		positions = append(positions, *position)
+	}
+	sort.Slice(positions, func(i, j int) bool {
+		return positions[i].Counterparty.String() < positions[j].Counterparty.String()
+	})
+	return positions
+}
+
+// Banks lists every bank found in the totals, sorted.
+func Banks(totals []Total) []uuid.UUID {
+	seen := map[uuid.UUID]bool{}
+	banks := []uuid.UUID{}
+	for _, total := range totals {
+		for _, bankID := range []uuid.UUID{total.Payer, total.Payee} {
+			if !seen[bankID] {
+				seen[bankID] = true
+				banks = append(banks, bankID)
+			}
+		}
+	}
+	sort.Slice(banks, func(i, j int) bool {
+		return banks[i].String() < banks[j].String()
+	})
+	return banks
+}
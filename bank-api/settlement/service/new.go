@@ -0,0 +1,14 @@
+package service
+
+import (
+	proto "codepix/bank-api/proto/codepix/settlement"
+	"codepix/bank-api/settlement/repository"
+
+	"google.golang.org/grpc"
+)
+
+func Register(server *grpc.Server, repository repository.Repository) error {
+	service := &Service{Repository: repository}
+	proto.RegisterServiceServer(server, service)
+	return nil
+}
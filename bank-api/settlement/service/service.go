@@ -0,0 +1,90 @@
+package service
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/bank/auth"
+	proto "codepix/bank-api/proto/codepix/settlement"
+	"codepix/bank-api/settlement"
+	"codepix/bank-api/settlement/repository"
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Service struct {
+	Repository repository.Repository
+	proto.UnimplementedServiceServer
+}
+
+var _ proto.ServiceServer = Service{}
+
+// Positions returns the running positions of the calling bank, from the flows not
+// settled by any closed window yet.
+func (s Service) Positions(ctx context.Context, req *proto.PositionsRequest,
+) (*proto.PositionsReply, error) {
+	bankID := auth.GetBankID(ctx)
+
+	last, err := s.Repository.LastClosed()
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	totals, err := s.Repository.OpenTotals()
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	report := repository.Report{
+		BankID:    bankID,
+		Positions: repository.Positions(bankID, totals),
+	}
+	reply := &proto.PositionsReply{
+		Positions: positionsReply(report.Positions),
+		Net:       report.Net(),
+	}
+	if last != nil {
+		reply.WindowStart = timestamppb.New(last.End)
+	}
+	return reply, nil
+}
+
+// Report only returns the reports of the calling bank.
+func (s Service) Report(ctx context.Context, req *proto.ReportRequest,
+) (*proto.ReportReply, error) {
+	bankID := auth.GetBankID(ctx)
+
+	windowStart := time.Time{}
+	if req.WindowStart != nil {
+		windowStart = req.WindowStart.AsTime()
+	}
+	report, err := s.Repository.FindReport(bankID, windowStart)
+	return reportReply(report), rpc.MapError(ctx, err)
+}
+
+func reportReply(report *repository.Report) *proto.ReportReply {
+	if report == nil {
+		return nil
+	}
+	return &proto.ReportReply{
+		BankId:      report.BankID[:],
+		WindowStart: timestamppb.New(report.Window.Start),
+		WindowEnd:   timestamppb.New(report.Window.End),
+		ClosedAt:    timestamppb.New(report.Window.ClosedAt),
+		Positions:   positionsReply(report.Positions),
+		Net:         report.Net(),
+		Payload:     settlement.Payload(*report),
+		Signature:   report.Signature,
+	}
+}
+
+func positionsReply(positions []repository.Position) []*proto.Position {
+	reply := []*proto.Position{}
+	for _, position := range positions {
+		reply = append(reply, &proto.Position{
+			Counterparty: position.Counterparty[:],
+			Sent:         position.Sent,
+			Received:     position.Received,
+			Net:          position.Net(),
+		})
+	}
+	return reply
+}
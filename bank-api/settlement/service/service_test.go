@@ -0,0 +1,92 @@
+package service_test
+
+import (
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/lib/repositories"
+	proto "codepix/bank-api/proto/codepix/settlement"
+	"codepix/bank-api/settlement"
+	"codepix/bank-api/settlement/repository"
+	"codepix/bank-api/settlement/settlementtest"
+	"context"
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPositions(t *testing.T) {
+	client, repo := settlementtest.ServiceWithMocks()
+
+	bankID, other := uuid.New(), uuid.New()
+	ctx := bankapitest.AuthenticatedContext(context.Background(), bankID)
+	last := repository.Window{End: time.Date(2022, 6, 1, 3, 0, 0, 0, time.UTC)}
+
+	repo.On("LastClosed").Return(&last, nil).Once()
+	repo.On("OpenTotals").Return([]repository.Total{
+		{Payer: bankID, Payee: other, Amount: 100},
+		{Payer: other, Payee: bankID, Amount: 30},
+		{Payer: other, Payee: uuid.New(), Amount: 50},
+	}, nil).Once()
+
+	reply, err := client.Positions(ctx, &proto.PositionsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, last.End, reply.WindowStart.AsTime())
+	require.Len(t, reply.Positions, 1)
+	assert.Equal(t, other[:], reply.Positions[0].Counterparty)
+	assert.Equal(t, uint64(100), reply.Positions[0].Sent)
+	assert.Equal(t, uint64(30), reply.Positions[0].Received)
+	assert.Equal(t, int64(-70), reply.Net)
+}
+
+func TestReport(t *testing.T) {
+	client, repo := settlementtest.ServiceWithMocks()
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	bankID := uuid.New()
+	ctx := bankapitest.AuthenticatedContext(context.Background(), bankID)
+	start := time.Date(2022, 6, 1, 3, 0, 0, 0, time.UTC)
+	report := &repository.Report{
+		BankID: bankID,
+		Window: repository.Window{
+			Start:    start,
+			End:      start.Add(24 * time.Hour),
+			ClosedAt: start.Add(25 * time.Hour),
+		},
+		Positions: []repository.Position{{Counterparty: uuid.New(), Received: 50}},
+	}
+	settlement.Sign(privateKey, report)
+
+	t.Run("window", func(t *testing.T) {
+		repo.On("FindReport", bankID, start).Return(report, nil).Once()
+
+		reply, err := client.Report(ctx, &proto.ReportRequest{WindowStart: timestamppb.New(start)})
+		require.NoError(t, err)
+		assert.Equal(t, int64(50), reply.Net)
+		assert.Equal(t, start.Add(24*time.Hour), reply.WindowEnd.AsTime())
+		assert.True(t, ed25519.Verify(publicKey, reply.Payload, reply.Signature))
+	})
+	t.Run("latest", func(t *testing.T) {
+		repo.On("FindReport", bankID, time.Time{}).Return(report, nil).Once()
+
+		_, err := client.Report(ctx, &proto.ReportRequest{})
+		require.NoError(t, err)
+	})
+	t.Run("not found", func(t *testing.T) {
+		repo.On("FindReport", bankID, start.Add(-24*time.Hour)).
+			Return(nil, &repositories.NotFoundError{}).Once()
+
+		_, err := client.Report(ctx, &proto.ReportRequest{
+			WindowStart: timestamppb.New(start.Add(-24 * time.Hour)),
+		})
+		status, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound.String(), status.Code().String())
+	})
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,90 @@
+// Package settlement nets what banks owe each other for the transactions completed
+// between them. Flows are recorded as transactions and refunds complete, and settled in
+// windows: once a window is closed its flows are frozen and each bank involved gets a
+// signed report of its positions.
+package settlement
+
+import (
+	"codepix/bank-api/settlement/repository"
+	"crypto/ed25519"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Windows splits time in settlement windows of the same length. Windows start at
+// multiples of Length since the Unix epoch, shifted by Offset.
+type Windows struct {
+	Length time.Duration
+	Offset time.Duration
+}
+
+// Start returns the start of the window t is in.
+func (w Windows) Start(t time.Time) time.Time {
+	since := t.Sub(time.Unix(0, 0).Add(w.Offset))
+	windows := since / w.Length
+	if since%w.Length < 0 {
+		windows--
+	}
+	return time.Unix(0, 0).Add(w.Offset).Add(windows * w.Length).UTC()
+}
+
+// After returns the window following one ending at end. It ends where the window end is
+// in ends, so windows realign after their length or offset change.
+func (w Windows) After(end time.Time) repository.Window {
+	return repository.Window{
+		Start: end.UTC(),
+		End:   w.Start(end).Add(w.Length),
+	}
+}
+
+type signedPosition struct {
+	Counterparty uuid.UUID `json:"counterparty"`
+	Sent         uint64    `json:"sent"`
+	Received     uint64    `json:"received"`
+	Net          int64     `json:"net"`
+}
+
+type signedReport struct {
+	BankID      uuid.UUID        `json:"bank_id"`
+	WindowStart time.Time        `json:"window_start"`
+	WindowEnd   time.Time        `json:"window_end"`
+	ClosedAt    time.Time        `json:"closed_at"`
+	Positions   []signedPosition `json:"positions"`
+	Net         int64            `json:"net"`
+}
+
+// Payload is the content of the report that is signed. Banks verify the signature
+// against it, so its encoding must not change.
+func Payload(report repository.Report) []byte {
+	positions := []signedPosition{}
+	for _, position := range report.Positions {
+		positions = append(positions, signedPosition{
+			Counterparty: position.Counterparty,
+			Sent:         position.Sent,
+			Received:     position.Received,
+			Net:          position.Net(),
+		})
+	}
+	payload, err := json.Marshal(signedReport{
+		BankID:      report.BankID,
+		WindowStart: report.Window.Start.UTC(),
+		WindowEnd:   report.Window.End.UTC(),
+		ClosedAt:    report.Window.ClosedAt.UTC(),
+		Positions:   positions,
+		Net:         report.Net(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return payload
+}
+
+func Sign(key ed25519.PrivateKey, report *repository.Report) {
+	report.Signature = ed25519.Sign(key, Payload(*report))
+}
+
+func Verify(key ed25519.PublicKey, report repository.Report) bool {
+	return ed25519.Verify(key, Payload(report), report.Signature)
+}
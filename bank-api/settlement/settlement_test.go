@@ -0,0 +1,104 @@
+package settlement_test
+
+import (
+	"codepix/bank-api/settlement"
+	"codepix/bank-api/settlement/repository"
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWindows(t *testing.T) {
+	daily := settlement.Windows{Length: 24 * time.Hour, Offset: 3 * time.Hour}
+	day := func(day, hour int) time.Time {
+		return time.Date(2022, 6, day, hour, 0, 0, 0, time.UTC)
+	}
+	testCases := []struct {
+		description string
+		windows     settlement.Windows
+		t           time.Time
+		start       time.Time
+	}{
+		{"start", daily, day(2, 3), day(2, 3)},
+		{"after start", daily, day(2, 15), day(2, 3)},
+		{"before offset", daily, day(2, 2), day(1, 3)},
+		{"other zone", daily, day(2, 2).In(time.FixedZone("BRT", -3*60*60)), day(1, 3)},
+		{"hourly", settlement.Windows{Length: time.Hour}, day(2, 2).Add(59 * time.Minute), day(2, 2)},
+		{"before epoch", settlement.Windows{Length: time.Hour},
+			time.Unix(-90*60, 0), time.Unix(-2*60*60, 0).UTC()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.start, tc.windows.Start(tc.t))
+		})
+	}
+
+	assert.Equal(t, repository.Window{Start: day(2, 3), End: day(3, 3)}, daily.After(day(2, 3)))
+	// windows realign after the offset changes
+	assert.Equal(t, repository.Window{Start: day(2, 0), End: day(2, 3)}, daily.After(day(2, 0)))
+}
+
+func TestSign(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	start := time.Date(2022, 6, 1, 3, 0, 0, 0, time.UTC)
+	report := repository.Report{
+		BankID: uuid.New(),
+		Window: repository.Window{
+			Start:    start,
+			End:      start.Add(24 * time.Hour),
+			ClosedAt: start.Add(25 * time.Hour),
+		},
+		Positions: []repository.Position{
+			{Counterparty: uuid.New(), Sent: 100, Received: 30},
+		},
+	}
+
+	settlement.Sign(privateKey, &report)
+	assert.True(t, settlement.Verify(publicKey, report))
+
+	// times read back in another zone must verify the same
+	report.Window.Start = report.Window.Start.In(time.FixedZone("BRT", -3*60*60))
+	assert.True(t, settlement.Verify(publicKey, report))
+
+	tampered := report
+	tampered.Positions = []repository.Position{
+		{Counterparty: report.Positions[0].Counterparty, Sent: 10, Received: 30},
+	}
+	assert.False(t, settlement.Verify(publicKey, tampered))
+}
+
+func TestPositions(t *testing.T) {
+	bankA, bankB, bankC := uuid.New(), uuid.New(), uuid.New()
+	totals := []repository.Total{
+		{Payer: bankA, Payee: bankB, Amount: 100},
+		{Payer: bankB, Payee: bankA, Amount: 30},
+		{Payer: bankC, Payee: bankA, Amount: 50},
+		{Payer: bankB, Payee: bankC, Amount: 10},
+	}
+
+	positions := repository.Positions(bankA, totals)
+	assert.ElementsMatch(t, []repository.Position{
+		{Counterparty: bankB, Sent: 100, Received: 30},
+		{Counterparty: bankC, Received: 50},
+	}, positions)
+	assert.Equal(t, int64(-70), positions[indexOf(positions, bankB)].Net())
+	assert.Equal(t, positions, repository.Positions(bankA, []repository.Total{
+		totals[3], totals[2], totals[1], totals[0],
+	}), "positions must be sorted to be signed")
+
+	assert.ElementsMatch(t, []uuid.UUID{bankA, bankB, bankC}, repository.Banks(totals))
+}
+
+func indexOf(positions []repository.Position, counterparty uuid.UUID) int {
+	for i, position := range positions {
+		if position.Counterparty == counterparty {
+			return i
+		}
+	}
+	return -1
+}
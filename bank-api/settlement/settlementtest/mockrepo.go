@@ -0,0 +1,52 @@
+package settlementtest
+
+import (
+	"codepix/bank-api/settlement/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepo struct {
+	mock.Mock
+}
+
+var _ repository.Repository = MockRepo{}
+
+func (m MockRepo) Record(flow repository.Flow) error {
+	args := m.Called(flow)
+	return get[error](args, 0)
+}
+
+func (m MockRepo) OpenTotals() ([]repository.Total, error) {
+	args := m.Called()
+	return get[[]repository.Total](args, 0), get[error](args, 1)
+}
+
+func (m MockRepo) LastClosed() (*repository.Window, error) {
+	args := m.Called()
+	return get[*repository.Window](args, 0), get[error](args, 1)
+}
+
+// Close signs the reports returned after the error, so tests can check their signatures.
+func (m MockRepo) Close(window repository.Window, sign func(report *repository.Report)) error {
+	args := m.Called(window)
+	for _, report := range get[[]*repository.Report](args, 1) {
+		sign(report)
+	}
+	return get[error](args, 0)
+}
+
+func (m MockRepo) FindReport(bankID uuid.UUID, windowStart time.Time,
+) (*repository.Report, error) {
+	args := m.Called(bankID, windowStart)
+	return get[*repository.Report](args, 0), get[error](args, 1)
+}
+
+func get[T any](args mock.Arguments, index int) T {
+	if args[index] == nil {
+		return *new(T)
+	}
+	return args[index].(T)
+}
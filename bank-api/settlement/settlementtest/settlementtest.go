@@ -0,0 +1,24 @@
+package settlementtest
+
+import (
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/bankapitest"
+	proto "codepix/bank-api/proto/codepix/settlement"
+	"codepix/bank-api/settlement/service"
+)
+
+func ServiceWithMocks() (proto.ServiceClient, *MockRepo) {
+	validator, err := validator.New()
+	if err != nil {
+		panic(err)
+	}
+	server, client, serve := bankapitest.Server(validator)
+	repo := new(MockRepo)
+
+	err = service.Register(server, repo)
+	if err != nil {
+		panic(err)
+	}
+	serve()
+	return proto.NewServiceClient(client), repo
+}
@@ -13,6 +13,12 @@ import (
 
 const AggregateType = eventhorizon.AggregateType("transaction")
 
+// BankMetadataKey holds the bank that issued the command which raised an event.
+const BankMetadataKey = "bank_id"
+
+// RiskMetadataKey holds the decisions of the risk rules a transaction was started with.
+const RiskMetadataKey = "risk"
+
 type Aggregate struct {
 	*events.AggregateBase
 	Transaction *Transaction
@@ -34,7 +40,15 @@ func (ag Aggregate) HandleCommand(ctx context.Context, command eventhorizon.Comm
 		if err != nil {
 			return &aggregates.InvariantViolation{err}
 		}
-		ag.AppendEvent(event.Type(), event, time.Now())
+		metadata := map[string]any{
+			BankMetadataKey: cmd.IssuingBank().String(),
+		}
+		if cmd, ok := cmd.(interface{ Metadata() map[string]any }); ok {
+			for key, value := range cmd.Metadata() {
+				metadata[key] = value
+			}
+		}
+		ag.AppendEvent(event.Type(), event, time.Now(), eventhorizon.WithMetadata(metadata))
 		return nil
 	}
 	return fmt.Errorf("unknown command type %s/%T", command.CommandType(), command)
@@ -47,3 +61,31 @@ func (ag *Aggregate) ApplyEvent(ctx context.Context, event eventhorizon.Event) e
 	}
 	return fmt.Errorf("unknown event type %s/%T", event.EventType(), event.Data())
 }
+
+// CausedBy returns the bank that issued the command which raised the event. Events saved
+// before banks were recorded fall back to the only bank allowed to raise them, so failures,
+// which either bank may cause, return uuid.Nil.
+func CausedBy(event eventhorizon.Event) uuid.UUID {
+	if bank, ok := event.Metadata()[BankMetadataKey].(string); ok {
+		if ID, err := uuid.Parse(bank); err == nil {
+			return ID
+		}
+	}
+	switch data := event.Data().(type) {
+	case *TransactionStarted:
+		return data.SenderBank
+	case *TransactionConfirmed:
+		return data.ReceiverBank
+	case *TransactionCompleted:
+		return data.SenderBank
+	case *TransactionReleased:
+		return data.SenderBank
+	case *TransactionRefundRequested:
+		return data.ReceiverBank
+	case *TransactionRefundConfirmed:
+		return data.SenderBank
+	case *TransactionRefundCompleted:
+		return data.ReceiverBank
+	}
+	return uuid.Nil
+}
@@ -20,6 +20,36 @@ var (
 	ErrCannotFailIfNotStartedOrConfirmed = &aggregates.StatusMismatchError{
 		"cannot fail transaction if not started or confirmed",
 	}
+	ErrCannotTimeOutConfirmIfNotStarted = &aggregates.StatusMismatchError{
+		"cannot time out confirmation if not started",
+	}
+	ErrCannotTimeOutCompleteIfNotConfirmed = &aggregates.StatusMismatchError{
+		"cannot time out completion if not confirmed",
+	}
+	ErrCannotTimeOutWithoutTimeoutCode = &aggregates.StatusMismatchError{
+		"cannot time out transaction without a timeout code",
+	}
+	ErrCannotRefundIfNotCompleted = &aggregates.StatusMismatchError{
+		"cannot refund transaction if not completed",
+	}
+	ErrRefundAlreadyRequested = &aggregates.StatusMismatchError{
+		"refund already requested",
+	}
+	ErrCannotConfirmRefundIfNotRequested = &aggregates.StatusMismatchError{
+		"cannot confirm refund if not requested",
+	}
+	ErrCannotCompleteRefundIfNotConfirmed = &aggregates.StatusMismatchError{
+		"cannot complete refund if not confirmed",
+	}
+	ErrCannotFailRefundIfNotRequestedOrConfirmed = &aggregates.StatusMismatchError{
+		"cannot fail refund if not requested or confirmed",
+	}
+	ErrCannotCompleteIfHeld = &aggregates.StatusMismatchError{
+		"cannot complete transaction held for review",
+	}
+	ErrCannotReleaseIfNotHeld = &aggregates.StatusMismatchError{
+		"cannot release transaction if not held",
+	}
 
 	ErrCannotStartIfNotTheSender = &aggregates.PermissionError{
 		"cannot start transaction if not the sender",
@@ -33,10 +63,36 @@ var (
 	ErrCannotFailIfNotSenderOrReceiver = &aggregates.PermissionError{
 		"cannot fail transaction if not the sender or receiver",
 	}
+	ErrCannotRefundIfNotTheReceiver = &aggregates.PermissionError{
+		"cannot refund transaction if not the receiver",
+	}
+	ErrCannotConfirmRefundIfNotTheSender = &aggregates.PermissionError{
+		"cannot confirm refund if not the sender",
+	}
+	ErrCannotCompleteRefundIfNotTheReceiver = &aggregates.PermissionError{
+		"cannot complete refund if not the receiver",
+	}
+	ErrCannotFailRefundIfNotSenderOrReceiver = &aggregates.PermissionError{
+		"cannot fail refund if not the sender or receiver",
+	}
+	ErrCannotReleaseIfSenderOrReceiver = &aggregates.PermissionError{
+		"cannot release transaction if the sender or receiver",
+	}
+	ErrCannotFailWithTimeoutCode = &aggregates.PermissionError{
+		"cannot fail transaction with a timeout code",
+	}
+
+	ErrRefundAmountNotPositive = &aggregates.AmountError{
+		"refund amount must be positive",
+	}
+	ErrRefundAmountExceeded = &aggregates.AmountError{
+		"refund amount exceeds the refundable amount",
+	}
 )
 
 type Command interface {
 	ToEvent(ag Aggregate) (Event, error)
+	IssuingBank() uuid.UUID
 }
 
 type Start struct {
@@ -47,7 +103,20 @@ type Start struct {
 	Receiver     uuid.UUID
 	ReceiverBank uuid.UUID
 	Amount       Amount
-	Description  string `eh:"optional"`
+	Description  string    `eh:"optional"`
+	BatchID      uuid.UUID `eh:"optional"`
+	ChargeID     uuid.UUID `eh:"optional"`
+	// Held transactions cannot be completed until a configured reviewer releases them.
+	Held bool `eh:"optional"`
+	// Risk holds the decisions of the risk rules the transaction was evaluated with.
+	Risk []RiskDecision `eh:"optional"`
+}
+
+// RiskDecision is the decision of a risk rule on a transaction before it started.
+type RiskDecision struct {
+	Rule     string
+	Decision string
+	Reason   string
 }
 
 func (c Start) ToEvent(ag Aggregate) (Event, error) {
@@ -64,9 +133,28 @@ func (c Start) ToEvent(ag Aggregate) (Event, error) {
 		ReceiverBank: c.ReceiverBank,
 		Amount:       c.Amount,
 		Description:  c.Description,
+		BatchID:      c.BatchID,
+		ChargeID:     c.ChargeID,
+		Held:         c.Held,
 	}, nil
 }
 
+// Metadata records the risk decisions along with the event, for audit.
+func (c Start) Metadata() map[string]any {
+	if len(c.Risk) == 0 {
+		return nil
+	}
+	decisions := []any{}
+	for _, decision := range c.Risk {
+		decisions = append(decisions, map[string]any{
+			"rule":     decision.Rule,
+			"decision": decision.Decision,
+			"reason":   decision.Reason,
+		})
+	}
+	return map[string]any{RiskMetadataKey: decisions}
+}
+
 type Confirm struct {
 	ID     uuid.UUID
 	BankID uuid.UUID
@@ -82,6 +170,7 @@ func (c Confirm) ToEvent(ag Aggregate) (Event, error) {
 	return TransactionConfirmed{
 		SenderBank:   ag.Transaction.SenderBank,
 		ReceiverBank: ag.Transaction.ReceiverBank,
+		Held:         ag.Transaction.Held,
 	}, nil
 }
 
@@ -97,15 +186,21 @@ func (c Complete) ToEvent(ag Aggregate) (Event, error) {
 	if c.BankID != ag.Transaction.SenderBank {
 		return nil, ErrCannotCompleteIfNotTheSender
 	}
+	if ag.Transaction.Held {
+		return nil, ErrCannotCompleteIfHeld
+	}
 	return TransactionCompleted{
 		SenderBank:   ag.Transaction.SenderBank,
 		ReceiverBank: ag.Transaction.ReceiverBank,
+		ChargeID:     ag.Transaction.ChargeID,
+		Amount:       ag.Transaction.Amount,
 	}, nil
 }
 
 type Fail struct {
 	ID     uuid.UUID
 	BankID uuid.UUID
+	Code   FailureCode
 	Reason string `eh:"optional"`
 }
 
@@ -116,7 +211,161 @@ func (c Fail) ToEvent(ag Aggregate) (Event, error) {
 	if !(c.BankID == ag.Transaction.SenderBank || c.BankID == ag.Transaction.ReceiverBank) {
 		return nil, ErrCannotFailIfNotSenderOrReceiver
 	}
+	if c.Code.Timeout() {
+		return nil, ErrCannotFailWithTimeoutCode
+	}
+	return TransactionFailed{
+		SenderBank:   ag.Transaction.SenderBank,
+		ReceiverBank: ag.Transaction.ReceiverBank,
+		Code:         c.Code,
+		Reason:       c.Reason,
+		ChargeID:     ag.Transaction.ChargeID,
+	}, nil
+}
+
+// TimeOut fails a transaction a bank did not move on in time. It is only issued by the
+// timeout manager, on behalf of the bank the transaction waited on, so banks cannot fail
+// transactions with timeout codes.
+type TimeOut struct {
+	ID     uuid.UUID
+	BankID uuid.UUID
+	Code   FailureCode
+}
+
+func (c TimeOut) ToEvent(ag Aggregate) (Event, error) {
+	switch {
+	case c.Code == FailureTimeoutConfirm && ag.Transaction.Status != Started:
+		return nil, ErrCannotTimeOutConfirmIfNotStarted
+	case c.Code == FailureTimeoutComplete && ag.Transaction.Status != Confirmed:
+		return nil, ErrCannotTimeOutCompleteIfNotConfirmed
+	case !c.Code.Timeout():
+		return nil, ErrCannotTimeOutWithoutTimeoutCode
+	}
 	return TransactionFailed{
+		SenderBank:   ag.Transaction.SenderBank,
+		ReceiverBank: ag.Transaction.ReceiverBank,
+		Code:         c.Code,
+		ChargeID:     ag.Transaction.ChargeID,
+	}, nil
+}
+
+// Release lets a transaction held for review be completed, once a reviewer approved it.
+// Reviewers are checked where commands are received, as the aggregate doesn't know them,
+// but no bank can approve its own transaction. Rejected transactions are failed instead.
+type Release struct {
+	ID     uuid.UUID
+	BankID uuid.UUID
+}
+
+func (c Release) ToEvent(ag Aggregate) (Event, error) {
+	if !(ag.Transaction.Status == Started || ag.Transaction.Status == Confirmed) ||
+		!ag.Transaction.Held {
+		return nil, ErrCannotReleaseIfNotHeld
+	}
+	if c.BankID == ag.Transaction.SenderBank || c.BankID == ag.Transaction.ReceiverBank {
+		return nil, ErrCannotReleaseIfSenderOrReceiver
+	}
+	return TransactionReleased{
+		SenderBank:   ag.Transaction.SenderBank,
+		ReceiverBank: ag.Transaction.ReceiverBank,
+		Confirmed:    ag.Transaction.Status == Confirmed,
+	}, nil
+}
+
+// RequestRefund returns part or all of a completed transaction from the receiver back to
+// the sender. Refunds flow in the opposite direction, so the original sender confirms them
+// and the original receiver completes them.
+type RequestRefund struct {
+	ID       uuid.UUID
+	RefundID uuid.UUID
+	BankID   uuid.UUID
+	Amount   Amount
+	Reason   string `eh:"optional"`
+}
+
+func (c RequestRefund) ToEvent(ag Aggregate) (Event, error) {
+	if ag.Transaction.Status != Completed {
+		return nil, ErrCannotRefundIfNotCompleted
+	}
+	if c.BankID != ag.Transaction.ReceiverBank {
+		return nil, ErrCannotRefundIfNotTheReceiver
+	}
+	if _, exists := ag.Transaction.Refunds[c.RefundID]; exists {
+		return nil, ErrRefundAlreadyRequested
+	}
+	if c.Amount == 0 {
+		return nil, ErrRefundAmountNotPositive
+	}
+	if c.Amount > ag.Transaction.Refundable() {
+		return nil, ErrRefundAmountExceeded
+	}
+	return TransactionRefundRequested{
+		RefundID:     c.RefundID,
+		SenderBank:   ag.Transaction.SenderBank,
+		ReceiverBank: ag.Transaction.ReceiverBank,
+		Amount:       c.Amount,
+		Reason:       c.Reason,
+	}, nil
+}
+
+type ConfirmRefund struct {
+	ID       uuid.UUID
+	RefundID uuid.UUID
+	BankID   uuid.UUID
+}
+
+func (c ConfirmRefund) ToEvent(ag Aggregate) (Event, error) {
+	if ag.Transaction.Refunds[c.RefundID].Status != RefundRequested {
+		return nil, ErrCannotConfirmRefundIfNotRequested
+	}
+	if c.BankID != ag.Transaction.SenderBank {
+		return nil, ErrCannotConfirmRefundIfNotTheSender
+	}
+	return TransactionRefundConfirmed{
+		RefundID:     c.RefundID,
+		SenderBank:   ag.Transaction.SenderBank,
+		ReceiverBank: ag.Transaction.ReceiverBank,
+	}, nil
+}
+
+type CompleteRefund struct {
+	ID       uuid.UUID
+	RefundID uuid.UUID
+	BankID   uuid.UUID
+}
+
+func (c CompleteRefund) ToEvent(ag Aggregate) (Event, error) {
+	if ag.Transaction.Refunds[c.RefundID].Status != RefundConfirmed {
+		return nil, ErrCannotCompleteRefundIfNotConfirmed
+	}
+	if c.BankID != ag.Transaction.ReceiverBank {
+		return nil, ErrCannotCompleteRefundIfNotTheReceiver
+	}
+	return TransactionRefundCompleted{
+		RefundID:     c.RefundID,
+		SenderBank:   ag.Transaction.SenderBank,
+		ReceiverBank: ag.Transaction.ReceiverBank,
+		Amount:       ag.Transaction.Refunds[c.RefundID].Amount,
+	}, nil
+}
+
+type FailRefund struct {
+	ID       uuid.UUID
+	RefundID uuid.UUID
+	BankID   uuid.UUID
+	Reason   string `eh:"optional"`
+}
+
+func (c FailRefund) ToEvent(ag Aggregate) (Event, error) {
+	status := ag.Transaction.Refunds[c.RefundID].Status
+	if !(status == RefundRequested || status == RefundConfirmed) {
+		return nil, ErrCannotFailRefundIfNotRequestedOrConfirmed
+	}
+	if !(c.BankID == ag.Transaction.SenderBank || c.BankID == ag.Transaction.ReceiverBank) {
+		return nil, ErrCannotFailRefundIfNotSenderOrReceiver
+	}
+	return TransactionRefundFailed{
+		RefundID:     c.RefundID,
 		SenderBank:   ag.Transaction.SenderBank,
 		ReceiverBank: ag.Transaction.ReceiverBank,
 		Reason:       c.Reason,
@@ -128,6 +377,13 @@ const (
 	ConfirmCommand  = eh.CommandType(AggregateType + "_confirm")
 	CompleteCommand = eh.CommandType(AggregateType + "_complete")
 	FailCommand     = eh.CommandType(AggregateType + "_fail")
+	TimeOutCommand  = eh.CommandType(AggregateType + "_time_out")
+	ReleaseCommand  = eh.CommandType(AggregateType + "_release")
+
+	RequestRefundCommand  = eh.CommandType(AggregateType + "_request_refund")
+	ConfirmRefundCommand  = eh.CommandType(AggregateType + "_confirm_refund")
+	CompleteRefundCommand = eh.CommandType(AggregateType + "_complete_refund")
+	FailRefundCommand     = eh.CommandType(AggregateType + "_fail_refund")
 )
 
 func init() {
@@ -135,20 +391,60 @@ func init() {
 	eh.RegisterCommand(func() eh.Command { return Confirm{} })
 	eh.RegisterCommand(func() eh.Command { return Complete{} })
 	eh.RegisterCommand(func() eh.Command { return Fail{} })
+	eh.RegisterCommand(func() eh.Command { return TimeOut{} })
+	eh.RegisterCommand(func() eh.Command { return Release{} })
+	eh.RegisterCommand(func() eh.Command { return RequestRefund{} })
+	eh.RegisterCommand(func() eh.Command { return ConfirmRefund{} })
+	eh.RegisterCommand(func() eh.Command { return CompleteRefund{} })
+	eh.RegisterCommand(func() eh.Command { return FailRefund{} })
 }
 
 func (c Start) AggregateID() uuid.UUID          { return c.ID }
 func (c Start) AggregateType() eh.AggregateType { return AggregateType }
 func (c Start) CommandType() eh.CommandType     { return StartCommand }
+func (c Start) IssuingBank() uuid.UUID          { return c.BankID }
 
 func (c Confirm) AggregateID() uuid.UUID          { return c.ID }
 func (c Confirm) AggregateType() eh.AggregateType { return AggregateType }
 func (c Confirm) CommandType() eh.CommandType     { return ConfirmCommand }
+func (c Confirm) IssuingBank() uuid.UUID          { return c.BankID }
 
 func (c Complete) AggregateID() uuid.UUID          { return c.ID }
 func (c Complete) AggregateType() eh.AggregateType { return AggregateType }
 func (c Complete) CommandType() eh.CommandType     { return CompleteCommand }
+func (c Complete) IssuingBank() uuid.UUID          { return c.BankID }
 
 func (c Fail) AggregateID() uuid.UUID          { return c.ID }
 func (c Fail) AggregateType() eh.AggregateType { return AggregateType }
 func (c Fail) CommandType() eh.CommandType     { return FailCommand }
+func (c Fail) IssuingBank() uuid.UUID          { return c.BankID }
+
+func (c TimeOut) AggregateID() uuid.UUID          { return c.ID }
+func (c TimeOut) AggregateType() eh.AggregateType { return AggregateType }
+func (c TimeOut) CommandType() eh.CommandType     { return TimeOutCommand }
+func (c TimeOut) IssuingBank() uuid.UUID          { return c.BankID }
+
+func (c Release) AggregateID() uuid.UUID          { return c.ID }
+func (c Release) AggregateType() eh.AggregateType { return AggregateType }
+func (c Release) CommandType() eh.CommandType     { return ReleaseCommand }
+func (c Release) IssuingBank() uuid.UUID          { return c.BankID }
+
+func (c RequestRefund) AggregateID() uuid.UUID          { return c.ID }
+func (c RequestRefund) AggregateType() eh.AggregateType { return AggregateType }
+func (c RequestRefund) CommandType() eh.CommandType     { return RequestRefundCommand }
+func (c RequestRefund) IssuingBank() uuid.UUID          { return c.BankID }
+
+func (c ConfirmRefund) AggregateID() uuid.UUID          { return c.ID }
+func (c ConfirmRefund) AggregateType() eh.AggregateType { return AggregateType }
+func (c ConfirmRefund) CommandType() eh.CommandType     { return ConfirmRefundCommand }
+func (c ConfirmRefund) IssuingBank() uuid.UUID          { return c.BankID }
+
+func (c CompleteRefund) AggregateID() uuid.UUID          { return c.ID }
+func (c CompleteRefund) AggregateType() eh.AggregateType { return AggregateType }
+func (c CompleteRefund) CommandType() eh.CommandType     { return CompleteRefundCommand }
+func (c CompleteRefund) IssuingBank() uuid.UUID          { return c.BankID }
+
+func (c FailRefund) AggregateID() uuid.UUID          { return c.ID }
+func (c FailRefund) AggregateType() eh.AggregateType { return AggregateType }
+func (c FailRefund) CommandType() eh.CommandType     { return FailRefundCommand }
+func (c FailRefund) IssuingBank() uuid.UUID          { return c.BankID }
@@ -25,6 +25,17 @@ var StartedTransaction = transactiontest.StartedTransaction
 var ConfirmedTransaction = transactiontest.ConfirmedTransaction
 var CompletedTransaction = transactiontest.CompletedTransaction
 var FailedTransaction = transactiontest.FailedTransaction
+var ValidReleaseCommand = transactiontest.ValidReleaseCommand
+var HeldTransaction = transactiontest.HeldTransaction
+var HeldConfirmedTransaction = transactiontest.HeldConfirmedTransaction
+var ValidRequestRefundCommand = transactiontest.ValidRequestRefundCommand
+var ValidConfirmRefundCommand = transactiontest.ValidConfirmRefundCommand
+var ValidCompleteRefundCommand = transactiontest.ValidCompleteRefundCommand
+var ValidFailRefundCommand = transactiontest.ValidFailRefundCommand
+var RefundRequestedTransaction = transactiontest.RefundRequestedTransaction
+var RefundConfirmedTransaction = transactiontest.RefundConfirmedTransaction
+var RefundCompletedTransaction = transactiontest.RefundCompletedTransaction
+var RefundFailedTransaction = transactiontest.RefundFailedTransaction
 
 func TestStartTransaction(t *testing.T) {
 	ctx := context.Background()
@@ -114,6 +125,34 @@ func TestConfirmTransaction(t *testing.T) {
 	}
 }
 
+func TestStartMetadata(t *testing.T) {
+	ctx := context.Background()
+	ID := uuid.New()
+	cmd := ValidStartCommand(ID)
+	cmd.Held = true
+	cmd.Risk = []transaction.RiskDecision{
+		{Rule: "velocity", Decision: "allow"},
+		{Rule: "first_receiver", Decision: "hold", Reason: "first transaction to receiver"},
+	}
+
+	ag := transaction.New(ID)
+	require.NoError(t, ag.HandleCommand(ctx, cmd))
+
+	event := ag.UncommittedEvents()[0]
+	assert.Equal(t, map[string]any{
+		transaction.BankMetadataKey: cmd.BankID.String(),
+		transaction.RiskMetadataKey: []any{
+			map[string]any{"rule": "velocity", "decision": "allow", "reason": ""},
+			map[string]any{
+				"rule":     "first_receiver",
+				"decision": "hold",
+				"reason":   "first transaction to receiver",
+			},
+		},
+	}, event.Metadata())
+	assert.True(t, event.Data().(transaction.TransactionStarted).Held)
+}
+
 func TestCompleteTransaction(t *testing.T) {
 	ctx := context.Background()
 	ID := uuid.New()
@@ -134,6 +173,7 @@ func TestCompleteTransaction(t *testing.T) {
 		{FailedTransaction(ID), valid, transaction.ErrCannotCompleteIfNotConfirmed},
 
 		{ConfirmedTransaction(ID), notTheSender, transaction.ErrCannotCompleteIfNotTheSender},
+		{HeldConfirmedTransaction(ID), valid, transaction.ErrCannotCompleteIfHeld},
 	}
 	for i, tc := range testCases {
 		t.Run(fmt.Sprint(i), func(t *testing.T) {
@@ -158,6 +198,57 @@ func TestCompleteTransaction(t *testing.T) {
 	}
 }
 
+func TestReleaseTransaction(t *testing.T) {
+	ctx := context.Background()
+	ID := uuid.New()
+	valid := ValidReleaseCommand(ID)
+
+	theSender := ValidReleaseCommand(ID)
+	theSender.BankID = uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	theReceiver := ValidReleaseCommand(ID)
+	theReceiver.BankID = uuid.MustParse("44444444-4444-4444-4444-444444444444")
+
+	testCases := []struct {
+		initialState *transaction.Aggregate
+		cmd          transaction.Release
+		err          error
+	}{
+		{transaction.New(ID), valid, transaction.ErrCannotReleaseIfNotHeld},
+		{StartedTransaction(ID), valid, transaction.ErrCannotReleaseIfNotHeld},
+		{HeldTransaction(ID), valid, nil},
+		{HeldConfirmedTransaction(ID), valid, nil},
+		{CompletedTransaction(ID), valid, transaction.ErrCannotReleaseIfNotHeld},
+
+		{HeldTransaction(ID), theSender, transaction.ErrCannotReleaseIfSenderOrReceiver},
+		{HeldConfirmedTransaction(ID), theReceiver, transaction.ErrCannotReleaseIfSenderOrReceiver},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			ag := Copy(tc.initialState)
+
+			err := ag.HandleCommand(ctx, tc.cmd)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.IsType(t, &aggregates.InvariantViolation{}, err)
+				require.ErrorAs(t, err, &tc.err)
+			}
+
+			if tc.err == nil {
+				lastEvent := ag.UncommittedEvents()[len(ag.UncommittedEvents())-1]
+				ag.ApplyEvent(ctx, lastEvent)
+				assert.False(t, ag.Transaction.Held)
+				assert.Equal(t, tc.initialState.Transaction.Status, ag.Transaction.Status)
+				if ag.Transaction.Status == transaction.Confirmed {
+					require.NoError(t, ag.HandleCommand(ctx, ValidCompleteCommand(ID)))
+				}
+			} else {
+				assert.Empty(t, cmp.Diff(tc.initialState, ag, Comparer))
+			}
+		})
+	}
+}
+
 func TestFailTransaction(t *testing.T) {
 	ctx := context.Background()
 	ID := uuid.New()
@@ -166,6 +257,12 @@ func TestFailTransaction(t *testing.T) {
 	notSenderOrReceiver := ValidFailCommand(ID)
 	notSenderOrReceiver.BankID = uuid.New()
 
+	confirmTimeout := ValidFailCommand(ID)
+	confirmTimeout.Code = transaction.FailureTimeoutConfirm
+
+	completeTimeout := ValidFailCommand(ID)
+	completeTimeout.Code = transaction.FailureTimeoutComplete
+
 	testCases := []struct {
 		initialState *transaction.Aggregate
 		cmd          transaction.Fail
@@ -178,6 +275,9 @@ func TestFailTransaction(t *testing.T) {
 		{FailedTransaction(ID), valid, transaction.ErrCannotFailIfNotStartedOrConfirmed},
 
 		{StartedTransaction(ID), notSenderOrReceiver, transaction.ErrCannotFailIfNotSenderOrReceiver},
+
+		{StartedTransaction(ID), confirmTimeout, transaction.ErrCannotFailWithTimeoutCode},
+		{ConfirmedTransaction(ID), completeTimeout, transaction.ErrCannotFailWithTimeoutCode},
 	}
 	for i, tc := range testCases {
 		t.Run(fmt.Sprint(i), func(t *testing.T) {
@@ -196,6 +296,252 @@ func TestFailTransaction(t *testing.T) {
 				ag.ApplyEvent(ctx, lastEvent)
 				failedTransaction := FailedTransaction(ID)
 				assert.Empty(t, cmp.Diff(failedTransaction.Transaction, ag.Transaction, ExceptStatus))
+				assert.Equal(t, tc.cmd.BankID, transaction.CausedBy(lastEvent))
+			} else {
+				assert.Empty(t, cmp.Diff(tc.initialState, ag, Comparer))
+			}
+		})
+	}
+}
+
+func TestTimeOutTransaction(t *testing.T) {
+	ctx := context.Background()
+	ID := uuid.New()
+	bankID := uuid.New()
+	confirmTimeout := transaction.TimeOut{ID, bankID, transaction.FailureTimeoutConfirm}
+	completeTimeout := transaction.TimeOut{ID, bankID, transaction.FailureTimeoutComplete}
+	notATimeout := transaction.TimeOut{ID, bankID, transaction.FailureInsufficientFunds}
+
+	testCases := []struct {
+		initialState *transaction.Aggregate
+		cmd          transaction.TimeOut
+		err          error
+	}{
+		{StartedTransaction(ID), confirmTimeout, nil},
+		{ConfirmedTransaction(ID), confirmTimeout, transaction.ErrCannotTimeOutConfirmIfNotStarted},
+		{StartedTransaction(ID), completeTimeout, transaction.ErrCannotTimeOutCompleteIfNotConfirmed},
+		{ConfirmedTransaction(ID), completeTimeout, nil},
+		{CompletedTransaction(ID), completeTimeout, transaction.ErrCannotTimeOutCompleteIfNotConfirmed},
+		{StartedTransaction(ID), notATimeout, transaction.ErrCannotTimeOutWithoutTimeoutCode},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			ag := Copy(tc.initialState)
+
+			err := ag.HandleCommand(ctx, tc.cmd)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.IsType(t, &aggregates.InvariantViolation{}, err)
+				require.ErrorAs(t, err, &tc.err)
+			}
+
+			if tc.err == nil {
+				lastEvent := ag.UncommittedEvents()[len(ag.UncommittedEvents())-1]
+				failed := lastEvent.Data().(transaction.TransactionFailed)
+				assert.Equal(t, tc.cmd.Code, failed.Code)
+				assert.Equal(t, bankID, transaction.CausedBy(lastEvent))
+			} else {
+				assert.Empty(t, cmp.Diff(tc.initialState, ag, Comparer))
+			}
+		})
+	}
+}
+
+func TestRequestRefund(t *testing.T) {
+	ctx := context.Background()
+	ID, refundID := uuid.New(), uuid.New()
+	valid := ValidRequestRefundCommand(ID, refundID)
+
+	notTheReceiver := ValidRequestRefundCommand(ID, refundID)
+	notTheReceiver.BankID = uuid.New()
+
+	anotherRefund := ValidRequestRefundCommand(ID, uuid.New())
+
+	remainingAmount := ValidRequestRefundCommand(ID, uuid.New())
+	remainingAmount.Amount = ValidStartCommand(ID).Amount - valid.Amount
+
+	exceedingAmount := ValidRequestRefundCommand(ID, uuid.New())
+	exceedingAmount.Amount = remainingAmount.Amount + 1
+
+	zeroAmount := ValidRequestRefundCommand(ID, refundID)
+	zeroAmount.Amount = 0
+
+	testCases := []struct {
+		initialState *transaction.Aggregate
+		cmd          transaction.RequestRefund
+		err          error
+	}{
+		{transaction.New(ID), valid, transaction.ErrCannotRefundIfNotCompleted},
+		{StartedTransaction(ID), valid, transaction.ErrCannotRefundIfNotCompleted},
+		{ConfirmedTransaction(ID), valid, transaction.ErrCannotRefundIfNotCompleted},
+		{CompletedTransaction(ID), valid, nil},
+		{FailedTransaction(ID), valid, transaction.ErrCannotRefundIfNotCompleted},
+
+		{CompletedTransaction(ID), notTheReceiver, transaction.ErrCannotRefundIfNotTheReceiver},
+		{RefundRequestedTransaction(ID, refundID), valid, transaction.ErrRefundAlreadyRequested},
+		{CompletedTransaction(ID), zeroAmount, transaction.ErrRefundAmountNotPositive},
+
+		{RefundRequestedTransaction(ID, refundID), anotherRefund, nil},
+		{RefundRequestedTransaction(ID, refundID), remainingAmount, nil},
+		{RefundRequestedTransaction(ID, refundID), exceedingAmount, transaction.ErrRefundAmountExceeded},
+		{RefundCompletedTransaction(ID, refundID), exceedingAmount, transaction.ErrRefundAmountExceeded},
+		{RefundFailedTransaction(ID, refundID), exceedingAmount, nil},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			ag := Copy(tc.initialState)
+
+			err := ag.HandleCommand(ctx, tc.cmd)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.IsType(t, &aggregates.InvariantViolation{}, err)
+				require.ErrorAs(t, err, &tc.err)
+			}
+
+			if tc.err == nil {
+				lastEvent := ag.UncommittedEvents()[len(ag.UncommittedEvents())-1]
+				ag.ApplyEvent(ctx, lastEvent)
+				refund := ag.Transaction.Refunds[tc.cmd.RefundID]
+				assert.Equal(t, transaction.RefundRequested, refund.Status)
+				assert.Equal(t, tc.cmd.Amount, refund.Amount)
+			} else {
+				assert.Empty(t, cmp.Diff(tc.initialState, ag, Comparer))
+			}
+		})
+	}
+}
+
+func TestConfirmRefund(t *testing.T) {
+	ctx := context.Background()
+	ID, refundID := uuid.New(), uuid.New()
+	valid := ValidConfirmRefundCommand(ID, refundID)
+
+	notTheSender := ValidConfirmRefundCommand(ID, refundID)
+	notTheSender.BankID = uuid.New()
+
+	unknownRefund := ValidConfirmRefundCommand(ID, uuid.New())
+
+	testCases := []struct {
+		initialState *transaction.Aggregate
+		cmd          transaction.ConfirmRefund
+		err          error
+	}{
+		{CompletedTransaction(ID), valid, transaction.ErrCannotConfirmRefundIfNotRequested},
+		{RefundRequestedTransaction(ID, refundID), valid, nil},
+		{RefundConfirmedTransaction(ID, refundID), valid, transaction.ErrCannotConfirmRefundIfNotRequested},
+		{RefundCompletedTransaction(ID, refundID), valid, transaction.ErrCannotConfirmRefundIfNotRequested},
+		{RefundFailedTransaction(ID, refundID), valid, transaction.ErrCannotConfirmRefundIfNotRequested},
+
+		{RefundRequestedTransaction(ID, refundID), notTheSender, transaction.ErrCannotConfirmRefundIfNotTheSender},
+		{RefundRequestedTransaction(ID, refundID), unknownRefund, transaction.ErrCannotConfirmRefundIfNotRequested},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			ag := Copy(tc.initialState)
+
+			err := ag.HandleCommand(ctx, tc.cmd)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.IsType(t, &aggregates.InvariantViolation{}, err)
+				require.ErrorAs(t, err, &tc.err)
+			}
+
+			if tc.err == nil {
+				lastEvent := ag.UncommittedEvents()[len(ag.UncommittedEvents())-1]
+				ag.ApplyEvent(ctx, lastEvent)
+				assert.Empty(t, cmp.Diff(RefundConfirmedTransaction(ID, refundID).Transaction, ag.Transaction))
+			} else {
+				assert.Empty(t, cmp.Diff(tc.initialState, ag, Comparer))
+			}
+		})
+	}
+}
+
+func TestCompleteRefund(t *testing.T) {
+	ctx := context.Background()
+	ID, refundID := uuid.New(), uuid.New()
+	valid := ValidCompleteRefundCommand(ID, refundID)
+
+	notTheReceiver := ValidCompleteRefundCommand(ID, refundID)
+	notTheReceiver.BankID = uuid.New()
+
+	testCases := []struct {
+		initialState *transaction.Aggregate
+		cmd          transaction.CompleteRefund
+		err          error
+	}{
+		{CompletedTransaction(ID), valid, transaction.ErrCannotCompleteRefundIfNotConfirmed},
+		{RefundRequestedTransaction(ID, refundID), valid, transaction.ErrCannotCompleteRefundIfNotConfirmed},
+		{RefundConfirmedTransaction(ID, refundID), valid, nil},
+		{RefundCompletedTransaction(ID, refundID), valid, transaction.ErrCannotCompleteRefundIfNotConfirmed},
+		{RefundFailedTransaction(ID, refundID), valid, transaction.ErrCannotCompleteRefundIfNotConfirmed},
+
+		{RefundConfirmedTransaction(ID, refundID), notTheReceiver, transaction.ErrCannotCompleteRefundIfNotTheReceiver},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			ag := Copy(tc.initialState)
+
+			err := ag.HandleCommand(ctx, tc.cmd)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.IsType(t, &aggregates.InvariantViolation{}, err)
+				require.ErrorAs(t, err, &tc.err)
+			}
+
+			if tc.err == nil {
+				lastEvent := ag.UncommittedEvents()[len(ag.UncommittedEvents())-1]
+				ag.ApplyEvent(ctx, lastEvent)
+				assert.Empty(t, cmp.Diff(RefundCompletedTransaction(ID, refundID).Transaction, ag.Transaction))
+			} else {
+				assert.Empty(t, cmp.Diff(tc.initialState, ag, Comparer))
+			}
+		})
+	}
+}
+
+func TestFailRefund(t *testing.T) {
+	ctx := context.Background()
+	ID, refundID := uuid.New(), uuid.New()
+	valid := ValidFailRefundCommand(ID, refundID)
+
+	notSenderOrReceiver := ValidFailRefundCommand(ID, refundID)
+	notSenderOrReceiver.BankID = uuid.New()
+
+	testCases := []struct {
+		initialState *transaction.Aggregate
+		cmd          transaction.FailRefund
+		err          error
+	}{
+		{CompletedTransaction(ID), valid, transaction.ErrCannotFailRefundIfNotRequestedOrConfirmed},
+		{RefundRequestedTransaction(ID, refundID), valid, nil},
+		{RefundConfirmedTransaction(ID, refundID), valid, nil},
+		{RefundCompletedTransaction(ID, refundID), valid, transaction.ErrCannotFailRefundIfNotRequestedOrConfirmed},
+		{RefundFailedTransaction(ID, refundID), valid, transaction.ErrCannotFailRefundIfNotRequestedOrConfirmed},
+
+		{RefundRequestedTransaction(ID, refundID), notSenderOrReceiver, transaction.ErrCannotFailRefundIfNotSenderOrReceiver},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			ag := Copy(tc.initialState)
+
+			err := ag.HandleCommand(ctx, tc.cmd)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.IsType(t, &aggregates.InvariantViolation{}, err)
+				require.ErrorAs(t, err, &tc.err)
+			}
+
+			if tc.err == nil {
+				lastEvent := ag.UncommittedEvents()[len(ag.UncommittedEvents())-1]
+				ag.ApplyEvent(ctx, lastEvent)
+				assert.Equal(t, transaction.RefundFailed, ag.Transaction.Refunds[refundID].Status)
+				assert.Equal(t, ag.Transaction.Amount, ag.Transaction.Refundable())
 			} else {
 				assert.Empty(t, cmp.Diff(tc.initialState, ag, Comparer))
 			}
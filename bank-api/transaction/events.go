@@ -17,6 +17,9 @@ type TransactionStarted struct {
 	ReceiverBank uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
 	Amount       Amount    `json:"amount" bson:"amount"`
 	Description  string    `json:"description" bson:"description"`
+	BatchID      uuid.UUID `json:"batch_id" bson:"batch_id"`
+	ChargeID     uuid.UUID `json:"charge_id" bson:"charge_id"`
+	Held         bool      `json:"held" bson:"held"`
 }
 
 func (e TransactionStarted) Apply(tx *Transaction) {
@@ -26,12 +29,15 @@ func (e TransactionStarted) Apply(tx *Transaction) {
 	tx.ReceiverBank = e.ReceiverBank
 	tx.Amount = e.Amount
 	tx.Description = e.Description
+	tx.ChargeID = e.ChargeID
+	tx.Held = e.Held
 	tx.Status = Started
 }
 
 type TransactionConfirmed struct {
 	SenderBank   uuid.UUID `json:"sender_bank" bson:"sender_bank"`
 	ReceiverBank uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
+	Held         bool      `json:"held" bson:"held"`
 }
 
 func (e TransactionConfirmed) Apply(tx *Transaction) {
@@ -41,6 +47,8 @@ func (e TransactionConfirmed) Apply(tx *Transaction) {
 type TransactionCompleted struct {
 	SenderBank   uuid.UUID `json:"sender_bank" bson:"sender_bank"`
 	ReceiverBank uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
+	ChargeID     uuid.UUID `json:"charge_id" bson:"charge_id"`
+	Amount       Amount    `json:"amount" bson:"amount"`
 }
 
 func (e TransactionCompleted) Apply(tx *Transaction) {
@@ -48,13 +56,81 @@ func (e TransactionCompleted) Apply(tx *Transaction) {
 }
 
 type TransactionFailed struct {
+	SenderBank   uuid.UUID   `json:"sender_bank" bson:"sender_bank"`
+	ReceiverBank uuid.UUID   `json:"receiver_bank" bson:"receiver_bank"`
+	Code         FailureCode `json:"code" bson:"code"`
+	Reason       string      `json:"reason" bson:"reason"`
+	ChargeID     uuid.UUID   `json:"charge_id" bson:"charge_id"`
+}
+
+func (e TransactionFailed) Apply(tx *Transaction) {
+	tx.Status = Failed
+}
+
+type TransactionReleased struct {
+	SenderBank   uuid.UUID `json:"sender_bank" bson:"sender_bank"`
+	ReceiverBank uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
+	Confirmed    bool      `json:"confirmed" bson:"confirmed"`
+}
+
+func (e TransactionReleased) Apply(tx *Transaction) {
+	tx.Held = false
+}
+
+type TransactionRefundRequested struct {
+	RefundID     uuid.UUID `json:"refund_id" bson:"refund_id"`
 	SenderBank   uuid.UUID `json:"sender_bank" bson:"sender_bank"`
 	ReceiverBank uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
+	Amount       Amount    `json:"amount" bson:"amount"`
 	Reason       string    `json:"reason" bson:"reason"`
 }
 
-func (e TransactionFailed) Apply(tx *Transaction) {
-	tx.Status = Failed
+func (e TransactionRefundRequested) Apply(tx *Transaction) {
+	if tx.Refunds == nil {
+		tx.Refunds = map[uuid.UUID]Refund{}
+	}
+	tx.Refunds[e.RefundID] = Refund{
+		Amount: e.Amount,
+		Status: RefundRequested,
+	}
+}
+
+type TransactionRefundConfirmed struct {
+	RefundID     uuid.UUID `json:"refund_id" bson:"refund_id"`
+	SenderBank   uuid.UUID `json:"sender_bank" bson:"sender_bank"`
+	ReceiverBank uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
+}
+
+func (e TransactionRefundConfirmed) Apply(tx *Transaction) {
+	tx.setRefundStatus(e.RefundID, RefundConfirmed)
+}
+
+type TransactionRefundCompleted struct {
+	RefundID     uuid.UUID `json:"refund_id" bson:"refund_id"`
+	SenderBank   uuid.UUID `json:"sender_bank" bson:"sender_bank"`
+	ReceiverBank uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
+	Amount       Amount    `json:"amount" bson:"amount"`
+}
+
+func (e TransactionRefundCompleted) Apply(tx *Transaction) {
+	tx.setRefundStatus(e.RefundID, RefundCompleted)
+}
+
+type TransactionRefundFailed struct {
+	RefundID     uuid.UUID `json:"refund_id" bson:"refund_id"`
+	SenderBank   uuid.UUID `json:"sender_bank" bson:"sender_bank"`
+	ReceiverBank uuid.UUID `json:"receiver_bank" bson:"receiver_bank"`
+	Reason       string    `json:"reason" bson:"reason"`
+}
+
+func (e TransactionRefundFailed) Apply(tx *Transaction) {
+	tx.setRefundStatus(e.RefundID, RefundFailed)
+}
+
+func (tx *Transaction) setRefundStatus(refundID uuid.UUID, status RefundStatus) {
+	refund := tx.Refunds[refundID]
+	refund.Status = status
+	tx.Refunds[refundID] = refund
 }
 
 const (
@@ -62,6 +138,12 @@ const (
 	ConfirmedEvent = eh.EventType(AggregateType + "_confirmed")
 	CompletedEvent = eh.EventType(AggregateType + "_completed")
 	FailedEvent    = eh.EventType(AggregateType + "_failed")
+	ReleasedEvent  = eh.EventType(AggregateType + "_released")
+
+	RefundRequestedEvent = eh.EventType(AggregateType + "_refund_requested")
+	RefundConfirmedEvent = eh.EventType(AggregateType + "_refund_confirmed")
+	RefundCompletedEvent = eh.EventType(AggregateType + "_refund_completed")
+	RefundFailedEvent    = eh.EventType(AggregateType + "_refund_failed")
 )
 
 func init() {
@@ -69,9 +151,20 @@ func init() {
 	eh.RegisterEventData(ConfirmedEvent, func() eh.EventData { return &TransactionConfirmed{} })
 	eh.RegisterEventData(CompletedEvent, func() eh.EventData { return &TransactionCompleted{} })
 	eh.RegisterEventData(FailedEvent, func() eh.EventData { return &TransactionFailed{} })
+	eh.RegisterEventData(ReleasedEvent, func() eh.EventData { return &TransactionReleased{} })
+	eh.RegisterEventData(RefundRequestedEvent, func() eh.EventData { return &TransactionRefundRequested{} })
+	eh.RegisterEventData(RefundConfirmedEvent, func() eh.EventData { return &TransactionRefundConfirmed{} })
+	eh.RegisterEventData(RefundCompletedEvent, func() eh.EventData { return &TransactionRefundCompleted{} })
+	eh.RegisterEventData(RefundFailedEvent, func() eh.EventData { return &TransactionRefundFailed{} })
 }
 
 func (TransactionStarted) Type() eh.EventType   { return StartedEvent }
 func (TransactionConfirmed) Type() eh.EventType { return ConfirmedEvent }
 func (TransactionCompleted) Type() eh.EventType { return CompletedEvent }
 func (TransactionFailed) Type() eh.EventType    { return FailedEvent }
+func (TransactionReleased) Type() eh.EventType  { return ReleasedEvent }
+
+func (TransactionRefundRequested) Type() eh.EventType { return RefundRequestedEvent }
+func (TransactionRefundConfirmed) Type() eh.EventType { return RefundConfirmedEvent }
+func (TransactionRefundCompleted) Type() eh.EventType { return RefundCompletedEvent }
+func (TransactionRefundFailed) Type() eh.EventType    { return RefundFailedEvent }
@@ -93,3 +93,49 @@ func TestTransactionFailed(t *testing.T) {
 	assert.Equal(t, tx.Description, original.Description)
 	assert.Equal(t, transaction.Failed, tx.Status)
 }
+
+func TestTransactionRefundRequested(t *testing.T) {
+	ag := transaction.New(uuid.New())
+	tx := ag.Transaction
+	tx.Amount = 100
+	tx.Status = transaction.Completed
+
+	event := transaction.TransactionRefundRequested{
+		RefundID:     uuid.New(),
+		SenderBank:   tx.SenderBank,
+		ReceiverBank: tx.ReceiverBank,
+		Amount:       40,
+		Reason:       "wrong amount",
+	}
+	event.Apply(tx)
+
+	assert.Equal(t, transaction.Completed, tx.Status)
+	assert.Equal(t, transaction.Refund{
+		Amount: event.Amount,
+		Status: transaction.RefundRequested,
+	}, tx.Refunds[event.RefundID])
+	assert.Equal(t, transaction.Amount(60), tx.Refundable())
+}
+
+func TestTransactionRefundStatus(t *testing.T) {
+	refundID := uuid.New()
+	testCases := []struct {
+		event  transaction.Event
+		status transaction.RefundStatus
+	}{
+		{transaction.TransactionRefundConfirmed{RefundID: refundID}, transaction.RefundConfirmed},
+		{transaction.TransactionRefundCompleted{RefundID: refundID}, transaction.RefundCompleted},
+		{transaction.TransactionRefundFailed{RefundID: refundID}, transaction.RefundFailed},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.event.Type()), func(t *testing.T) {
+			tx := &transaction.Transaction{Amount: 100}
+			transaction.TransactionRefundRequested{RefundID: refundID, Amount: 40}.Apply(tx)
+
+			tc.event.Apply(tx)
+
+			assert.Equal(t, tc.status, tx.Refunds[refundID].Status)
+			assert.Equal(t, transaction.Amount(40), tx.Refunds[refundID].Amount)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package eventlog
+
+import (
+	"codepix/bank-api/adapters/eventstore"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/read/repository"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+type EventSource interface {
+	Load(ctx context.Context, ID uuid.UUID) ([]eventhorizon.Event, error)
+}
+
+// EventLog builds transaction timelines from the event store.
+type EventLog struct {
+	Source EventSource
+}
+
+var _ repository.History = EventLog{}
+
+func (l EventLog) History(ctx context.Context, ID uuid.UUID) (*repository.Timeline, error) {
+	events, err := l.Source.Load(ctx, ID)
+	if err != nil {
+		return nil, eventstore.MapError(err, repository.EntityType)
+	}
+	return Timeline(ID, events)
+}
+
+// Timeline applies the events in order, recording the statuses each one led to.
+func Timeline(ID uuid.UUID, events []eventhorizon.Event) (*repository.Timeline, error) {
+	tx := &transaction.Transaction{}
+	steps := []repository.Step{}
+	for _, event := range events {
+		data, ok := event.Data().(transaction.Event)
+		if !ok {
+			return nil, fmt.Errorf("unknown event type %s/%T", event.EventType(), event.Data())
+		}
+		data.Apply(tx)
+
+		step := repository.Step{
+			EventType: event.EventType(),
+			Version:   event.Version(),
+			Timestamp: event.Timestamp(),
+			Bank:      transaction.CausedBy(event),
+			Status:    tx.Status,
+		}
+		if refundID := refundID(data); refundID != uuid.Nil {
+			step.RefundID = refundID
+			step.RefundStatus = tx.Refunds[refundID].Status
+		}
+		steps = append(steps, step)
+	}
+	return &repository.Timeline{
+		ID:           ID,
+		SenderBank:   tx.SenderBank,
+		ReceiverBank: tx.ReceiverBank,
+		Steps:        steps,
+	}, nil
+}
+
+func refundID(data transaction.Event) uuid.UUID {
+	switch data := data.(type) {
+	case *transaction.TransactionRefundRequested:
+		return data.RefundID
+	case *transaction.TransactionRefundConfirmed:
+		return data.RefundID
+	case *transaction.TransactionRefundCompleted:
+		return data.RefundID
+	case *transaction.TransactionRefundFailed:
+		return data.RefundID
+	}
+	return uuid.Nil
+}
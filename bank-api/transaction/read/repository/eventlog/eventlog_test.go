@@ -0,0 +1,85 @@
+package eventlog_test
+
+import (
+	"codepix/bank-api/lib/repositories"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/read/repository"
+	"codepix/bank-api/transaction/read/repository/eventlog"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type source []eventhorizon.Event
+
+func (s source) Load(ctx context.Context, ID uuid.UUID) ([]eventhorizon.Event, error) {
+	if len(s) == 0 {
+		return nil, &eventhorizon.EventStoreError{
+			Err:         eventhorizon.ErrAggregateNotFound,
+			Op:          eventhorizon.EventStoreOpLoad,
+			AggregateID: ID,
+		}
+	}
+	return s, nil
+}
+
+func TestHistory(t *testing.T) {
+	ctx := context.Background()
+	ID := uuid.New()
+	senderBank, receiverBank := uuid.New(), uuid.New()
+	refundID := uuid.New()
+	now := time.Now()
+
+	event := func(version int, data transaction.Event, options ...eventhorizon.EventOption,
+	) eventhorizon.Event {
+		options = append(options, eventhorizon.ForAggregate(transaction.AggregateType, ID, version))
+		return eventhorizon.NewEvent(data.Type(), data, now.Add(time.Duration(version)*time.Second),
+			options...)
+	}
+	causedBy := func(bankID uuid.UUID) eventhorizon.EventOption {
+		return eventhorizon.WithMetadata(map[string]any{
+			transaction.BankMetadataKey: bankID.String(),
+		})
+	}
+
+	// the first events were saved before banks were recorded
+	events := source{
+		event(1, &transaction.TransactionStarted{SenderBank: senderBank, ReceiverBank: receiverBank}),
+		event(2, &transaction.TransactionConfirmed{SenderBank: senderBank, ReceiverBank: receiverBank}),
+		event(3, &transaction.TransactionCompleted{SenderBank: senderBank, ReceiverBank: receiverBank},
+			causedBy(senderBank)),
+		event(4, &transaction.TransactionRefundRequested{RefundID: refundID, Amount: 10},
+			causedBy(receiverBank)),
+		event(5, &transaction.TransactionRefundFailed{RefundID: refundID}),
+	}
+	eventLog := eventlog.EventLog{events}
+
+	timeline, err := eventLog.History(ctx, ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, &repository.Timeline{
+		ID:           ID,
+		SenderBank:   senderBank,
+		ReceiverBank: receiverBank,
+		Steps: []repository.Step{
+			{transaction.StartedEvent, 1, now.Add(1 * time.Second), senderBank,
+				transaction.Started, uuid.Nil, 0},
+			{transaction.ConfirmedEvent, 2, now.Add(2 * time.Second), receiverBank,
+				transaction.Confirmed, uuid.Nil, 0},
+			{transaction.CompletedEvent, 3, now.Add(3 * time.Second), senderBank,
+				transaction.Completed, uuid.Nil, 0},
+			{transaction.RefundRequestedEvent, 4, now.Add(4 * time.Second), receiverBank,
+				transaction.Completed, refundID, transaction.RefundRequested},
+			{transaction.RefundFailedEvent, 5, now.Add(5 * time.Second), uuid.Nil,
+				transaction.Completed, refundID, transaction.RefundFailed},
+		},
+	}, timeline)
+
+	_, err = eventlog.EventLog{source{}}.History(ctx, ID)
+	assert.IsType(t, &repositories.NotFoundError{}, err)
+}
@@ -25,3 +25,18 @@ func New(client *projectionclient.StoreProjection) (*Projection, error) {
 	}
 	return &Projection{projection}, nil
 }
+
+func NewRebuilder(client *projectionclient.StoreProjection, source projectionclient.EventSource,
+) projectionclient.Rebuilder {
+	projector := &Projector{}
+	entityType := func() eventhorizon.Entity {
+		return &repository.Transaction{}
+	}
+	return client.NewRebuilder(
+		source,
+		projector.ProjectorType(),
+		entityType,
+		projector,
+		transaction.AggregateType,
+	)
+}
@@ -38,6 +38,9 @@ func (p Projection) List(ctx context.Context, options repository.ListOptions,
 		if options.ReceiverID != uuid.Nil {
 			filter = append(filter, bson.E{"receiver", options.ReceiverID.String()})
 		}
+		if options.BatchID != uuid.Nil {
+			filter = append(filter, bson.E{"batch_id", options.BatchID.String()})
+		}
 		opts := opts.Find().
 			SetSort(bson.D{{"created_at", -1}}).
 			SetLimit(int64(options.Limit)).
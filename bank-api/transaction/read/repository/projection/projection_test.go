@@ -116,6 +116,7 @@ func Find(repo repository.Repository, commandHandler eventhorizon.CommandHandler
 					tx.Amount == start.Amount &&
 					tx.Description == start.Description &&
 					tx.Status == transaction.Failed &&
+					tx.FailureCode == fail.Code &&
 					tx.ReasonForFailing == fail.Reason
 			}, projectionTimeout, projectionInterval)
 		}
@@ -345,9 +346,42 @@ func List(repo repository.Repository, commandHandler eventhorizon.CommandHandler
 				return true
 			}, projectionTimeout, projectionInterval)
 		}
+		TestBatchFilter := func(t *testing.T) {
+			ctx := context.Background()
+			totalTxs := 10
+			expectedTxs := totalTxs / 2
+
+			batchID := uuid.New()
+			senderID := uuid.New()
+			for i := 0; i < totalTxs; i++ {
+				start := ValidStartCommand(uuid.New())
+				start.Sender = senderID
+				if i < expectedTxs {
+					start.BatchID = batchID
+				}
+				err := commandHandler.HandleCommand(ctx, start)
+				require.NoError(t, err)
+			}
+
+			assert.Eventually(t, func() bool {
+				txs, err := repo.List(ctx, repository.ListOptions{
+					BatchID: batchID,
+				})
+				if len(txs) != expectedTxs || err != nil {
+					return false
+				}
+				for _, tx := range txs {
+					if tx.BatchID != batchID {
+						return false
+					}
+				}
+				return true
+			}, projectionTimeout, projectionInterval)
+		}
 		tests := []func(t *testing.T){
 			TestCreatedAfterFilter,
 			TestIDFilters,
+			TestBatchFilter,
 			TestNewestFirstSort,
 			TestLimit,
 			TestSkip,
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/looplab/eventhorizon"
 	"github.com/looplab/eventhorizon/eventhandler/projector"
 )
@@ -24,6 +25,12 @@ func (p Projector) Project(ctx context.Context, event eventhorizon.Event, entity
 	if !ok {
 		return nil, fmt.Errorf("unknown entity type %T", entity)
 	}
+	// rows projected before versioning have no version, so any event is applied to them
+	legacy := tx.Version == 0 && tx.ID != uuid.Nil
+	if !legacy && event.Version() != tx.Version+1 {
+		return nil, fmt.Errorf("%w: expected version %d, got %d",
+			eventhorizon.ErrIncorrectEntityVersion, tx.Version+1, event.Version())
+	}
 	switch e := event.Data().(type) {
 	case *transaction.TransactionStarted:
 		tx.ID = event.AggregateID()
@@ -36,6 +43,9 @@ func (p Projector) Project(ctx context.Context, event eventhorizon.Event, entity
 		tx.Amount = e.Amount
 		tx.Description = e.Description
 		tx.Status = transaction.Started
+		tx.BatchID = e.BatchID
+		tx.ChargeID = e.ChargeID
+		tx.Held = e.Held
 
 	case *transaction.TransactionConfirmed:
 		tx.Status = transaction.Confirmed
@@ -43,13 +53,63 @@ func (p Projector) Project(ctx context.Context, event eventhorizon.Event, entity
 	case *transaction.TransactionCompleted:
 		tx.Status = transaction.Completed
 
+	case *transaction.TransactionReleased:
+		tx.Held = false
+
 	case *transaction.TransactionFailed:
 		tx.Status = transaction.Failed
+		tx.FailureCode = e.Code
 		tx.ReasonForFailing = e.Reason
 
+	case *transaction.TransactionRefundRequested:
+		tx.Refunds = append(tx.Refunds, repository.Refund{
+			ID:        e.RefundID,
+			CreatedAt: event.Timestamp(),
+			UpdatedAt: event.Timestamp(),
+			Amount:    e.Amount,
+			Reason:    e.Reason,
+			Status:    transaction.RefundRequested,
+		})
+
+	case *transaction.TransactionRefundConfirmed:
+		refund := findRefund(tx, e.RefundID)
+		if refund == nil {
+			return nil, fmt.Errorf("refund %s not found", e.RefundID)
+		}
+		refund.Status = transaction.RefundConfirmed
+		refund.UpdatedAt = event.Timestamp()
+
+	case *transaction.TransactionRefundCompleted:
+		refund := findRefund(tx, e.RefundID)
+		if refund == nil {
+			return nil, fmt.Errorf("refund %s not found", e.RefundID)
+		}
+		refund.Status = transaction.RefundCompleted
+		refund.UpdatedAt = event.Timestamp()
+		tx.RefundedAmount += refund.Amount
+
+	case *transaction.TransactionRefundFailed:
+		refund := findRefund(tx, e.RefundID)
+		if refund == nil {
+			return nil, fmt.Errorf("refund %s not found", e.RefundID)
+		}
+		refund.Status = transaction.RefundFailed
+		refund.UpdatedAt = event.Timestamp()
+		refund.ReasonForFailing = e.Reason
+
 	default:
 		return nil, fmt.Errorf("unknown event type %s/%T", event.EventType(), event.Data())
 	}
 	tx.UpdatedAt = event.Timestamp()
+	tx.Version = event.Version()
 	return tx, nil
 }
+
+func findRefund(tx *repository.Transaction, refundID uuid.UUID) *repository.Refund {
+	for i := range tx.Refunds {
+		if tx.Refunds[i].ID == refundID {
+			return &tx.Refunds[i]
+		}
+	}
+	return nil
+}
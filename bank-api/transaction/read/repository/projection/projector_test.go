@@ -0,0 +1,118 @@
+package projection_test
+
+import (
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/read/repository"
+	"codepix/bank-api/transaction/read/repository/projection"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectRefunds(t *testing.T) {
+	projector := projection.Projector{}
+	ctx := context.Background()
+	ID := uuid.New()
+	now := time.Now()
+
+	project := func(tx *repository.Transaction, version int, data transaction.Event,
+	) *repository.Transaction {
+		event := eventhorizon.NewEvent(data.Type(), data, now.Add(time.Duration(version)*time.Second),
+			eventhorizon.ForAggregate(transaction.AggregateType, ID, version))
+		entity, err := projector.Project(ctx, event, tx)
+		require.NoError(t, err)
+		return entity.(*repository.Transaction)
+	}
+
+	tx := &repository.Transaction{ID: ID, Amount: 100, Status: transaction.Completed, Version: 3}
+	completedID, failedID := uuid.New(), uuid.New()
+
+	tx = project(tx, 4, &transaction.TransactionRefundRequested{RefundID: completedID, Amount: 40, Reason: "wrong amount"})
+	tx = project(tx, 5, &transaction.TransactionRefundRequested{RefundID: failedID, Amount: 60})
+	tx = project(tx, 6, &transaction.TransactionRefundConfirmed{RefundID: completedID})
+	tx = project(tx, 7, &transaction.TransactionRefundCompleted{RefundID: completedID})
+	tx = project(tx, 8, &transaction.TransactionRefundFailed{RefundID: failedID, Reason: "account closed"})
+
+	assert.Equal(t, transaction.Completed, tx.Status)
+	assert.Equal(t, transaction.Amount(40), tx.RefundedAmount)
+	assert.Equal(t, now.Add(8*time.Second), tx.UpdatedAt)
+	assert.Equal(t, []repository.Refund{
+		{
+			ID:        completedID,
+			CreatedAt: now.Add(4 * time.Second),
+			UpdatedAt: now.Add(7 * time.Second),
+			Amount:    40,
+			Reason:    "wrong amount",
+			Status:    transaction.RefundCompleted,
+		},
+		{
+			ID:               failedID,
+			CreatedAt:        now.Add(5 * time.Second),
+			UpdatedAt:        now.Add(8 * time.Second),
+			Amount:           60,
+			Status:           transaction.RefundFailed,
+			ReasonForFailing: "account closed",
+		},
+	}, tx.Refunds)
+
+	event := eventhorizon.NewEvent(transaction.RefundConfirmedEvent,
+		&transaction.TransactionRefundConfirmed{RefundID: uuid.New()}, now,
+		eventhorizon.ForAggregate(transaction.AggregateType, ID, 9))
+	_, err := projector.Project(ctx, event, tx)
+	assert.Error(t, err)
+}
+
+func TestProjectHeld(t *testing.T) {
+	projector := projection.Projector{}
+	ctx := context.Background()
+	ID := uuid.New()
+
+	project := func(tx *repository.Transaction, version int, data transaction.Event,
+	) *repository.Transaction {
+		event := eventhorizon.NewEvent(data.Type(), data, time.Now(),
+			eventhorizon.ForAggregate(transaction.AggregateType, ID, version))
+		entity, err := projector.Project(ctx, event, tx)
+		require.NoError(t, err)
+		return entity.(*repository.Transaction)
+	}
+
+	tx := project(&repository.Transaction{}, 1, &transaction.TransactionStarted{Amount: 100, Held: true})
+	assert.True(t, tx.Held)
+	tx = project(tx, 2, &transaction.TransactionConfirmed{})
+	assert.True(t, tx.Held)
+	tx = project(tx, 3, &transaction.TransactionReleased{})
+	assert.False(t, tx.Held)
+	assert.Equal(t, transaction.Confirmed, tx.Status)
+}
+
+func TestProjectVersions(t *testing.T) {
+	projector := projection.Projector{}
+	ctx := context.Background()
+	ID := uuid.New()
+
+	confirmed := func(version int) eventhorizon.Event {
+		return eventhorizon.NewEvent(transaction.ConfirmedEvent,
+			&transaction.TransactionConfirmed{}, time.Now(),
+			eventhorizon.ForAggregate(transaction.AggregateType, ID, version))
+	}
+
+	_, err := projector.Project(ctx, confirmed(2), &repository.Transaction{})
+	assert.ErrorIs(t, err, eventhorizon.ErrIncorrectEntityVersion)
+
+	_, err = projector.Project(ctx, confirmed(4), &repository.Transaction{ID: ID, Version: 2})
+	assert.ErrorIs(t, err, eventhorizon.ErrIncorrectEntityVersion)
+
+	entity, err := projector.Project(ctx, confirmed(3), &repository.Transaction{ID: ID, Version: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 3, entity.(*repository.Transaction).Version)
+
+	// rows projected before versioning accept any event
+	entity, err = projector.Project(ctx, confirmed(5), &repository.Transaction{ID: ID})
+	require.NoError(t, err)
+	assert.Equal(t, 5, entity.(*repository.Transaction).Version)
+}
@@ -0,0 +1,76 @@
+package projection_test
+
+import (
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/read/repository"
+	"codepix/bank-api/transaction/transactiontest"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebuild(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	projection, rebuilder, commandHandler, tearDown := transactiontest.Rebuilder()
+	defer tearDown()
+	ctx := context.Background()
+
+	confirmed := func() *repository.Transaction {
+		ID := uuid.New()
+		require.NoError(t, commandHandler.HandleCommand(ctx, ValidStartCommand(ID)))
+		require.NoError(t, commandHandler.HandleCommand(ctx, ValidConfirmCommand(ID)))
+
+		var tx *repository.Transaction
+		require.Eventually(t, func() bool {
+			tx, _ = projection.Find(ctx, ID)
+			return tx != nil && tx.Status == transaction.Confirmed
+		}, projectionTimeout, projectionInterval)
+		return tx
+	}
+	corrupt := func(tx repository.Transaction) {
+		tx.Description = "corrupted"
+		tx.Status = transaction.Failed
+		require.NoError(t, projection.Repo.Save(ctx, &tx))
+	}
+
+	t.Run("replay", func(t *testing.T) {
+		tx := confirmed()
+		other := confirmed()
+		corrupt(*tx)
+		corrupt(*other)
+
+		require.NoError(t, rebuilder.Replay(ctx, tx.ID))
+
+		replayed, err := projection.Find(ctx, tx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, tx, replayed)
+
+		untouched, err := projection.Find(ctx, other.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "corrupted", untouched.Description)
+	})
+	t.Run("rebuild", func(t *testing.T) {
+		tx := confirmed()
+		corrupt(*tx)
+
+		require.NoError(t, rebuilder.Rebuild(ctx))
+
+		rebuilt, err := projection.Find(ctx, tx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, tx, rebuilt)
+
+		// the outbox handler keeps projecting into the swapped collection
+		require.NoError(t, commandHandler.HandleCommand(ctx, ValidCompleteCommand(tx.ID)))
+		assert.Eventually(t, func() bool {
+			completed, _ := projection.Find(ctx, tx.ID)
+			return completed != nil &&
+				completed.Status == transaction.Completed &&
+				completed.Version == 3
+		}, projectionTimeout, projectionInterval)
+	})
+}
@@ -17,6 +17,12 @@ type Repository interface {
 	List(ctx context.Context, options ListOptions) ([]ListItem, error)
 }
 
+// History reads the lifecycle of a transaction straight from its events, rather than from
+// the projected state.
+type History interface {
+	History(ctx context.Context, ID uuid.UUID) (*Timeline, error)
+}
+
 type Transaction struct {
 	ID           uuid.UUID `bson:"_id"`
 	Sender       uuid.UUID `bson:"sender"`
@@ -24,12 +30,30 @@ type Transaction struct {
 	Receiver     uuid.UUID `bson:"receiver"`
 	ReceiverBank uuid.UUID `bson:"receiver_bank"`
 
-	CreatedAt        time.Time          `bson:"created_at"`
-	UpdatedAt        time.Time          `bson:"updated_at"`
-	Amount           transaction.Amount `bson:"amount"`
-	Description      string             `bson:"description"`
-	Status           transaction.Status `bson:"status"`
-	ReasonForFailing string             `bson:"reason_for_failing"`
+	CreatedAt        time.Time               `bson:"created_at"`
+	UpdatedAt        time.Time               `bson:"updated_at"`
+	Amount           transaction.Amount      `bson:"amount"`
+	Description      string                  `bson:"description"`
+	Status           transaction.Status      `bson:"status"`
+	FailureCode      transaction.FailureCode `bson:"failure_code"`
+	ReasonForFailing string                  `bson:"reason_for_failing"`
+	RefundedAmount   transaction.Amount      `bson:"refunded_amount"`
+	Refunds          []Refund                `bson:"refunds"`
+	BatchID          uuid.UUID               `bson:"batch_id"`
+	ChargeID         uuid.UUID               `bson:"charge_id"`
+	Held             bool                    `bson:"held"`
+
+	Version int `bson:"version"`
+}
+
+type Refund struct {
+	ID               uuid.UUID                `bson:"_id"`
+	CreatedAt        time.Time                `bson:"created_at"`
+	UpdatedAt        time.Time                `bson:"updated_at"`
+	Amount           transaction.Amount       `bson:"amount"`
+	Reason           string                   `bson:"reason"`
+	Status           transaction.RefundStatus `bson:"status"`
+	ReasonForFailing string                   `bson:"reason_for_failing"`
 }
 
 var _ eventhorizon.Entity = Transaction{}
@@ -38,12 +62,50 @@ func (t Transaction) EntityID() uuid.UUID {
 	return t.ID
 }
 
+var _ eventhorizon.Versionable = Transaction{}
+
+func (t Transaction) AggregateVersion() int {
+	return t.Version
+}
+
+// Refundable returns the amount that can still be refunded, reserving the amount of
+// refunds that are still in progress.
+func (t Transaction) Refundable() transaction.Amount {
+	refundable := t.Amount
+	for _, refund := range t.Refunds {
+		if refund.Status != transaction.RefundFailed {
+			refundable -= refund.Amount
+		}
+	}
+	return refundable
+}
+
 type ListItem = Transaction
 
 type ListOptions struct {
 	CreatedAfter time.Time
 	SenderID     uuid.UUID
 	ReceiverID   uuid.UUID
+	BatchID      uuid.UUID
 	Limit        uint64
 	Skip         uint64
 }
+
+type Timeline struct {
+	ID           uuid.UUID
+	SenderBank   uuid.UUID
+	ReceiverBank uuid.UUID
+	Steps        []Step
+}
+
+// Step is a single event of a transaction, along with the statuses it led to. Refund
+// fields are only set for refund events.
+type Step struct {
+	EventType    eventhorizon.EventType
+	Version      int
+	Timestamp    time.Time
+	Bank         uuid.UUID
+	Status       transaction.Status
+	RefundID     uuid.UUID
+	RefundStatus transaction.RefundStatus
+}
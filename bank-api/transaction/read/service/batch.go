@@ -0,0 +1,64 @@
+package service
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/lib/repositories"
+	proto "codepix/bank-api/proto/codepix/transaction/read"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/read/repository"
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FindBatch aggregates the statuses of the transactions started by a batch. Batches are
+// started by the sender bank, so only it may see them.
+func (s Service) FindBatch(ctx context.Context, req *proto.FindBatchRequest,
+) (*proto.BatchReply, error) {
+	bankID := auth.GetBankID(ctx)
+	ID, _ := uuid.FromBytes(req.Id)
+
+	transactions, err := s.Repository.List(ctx, repository.ListOptions{BatchID: ID})
+	if err == nil && len(transactions) == 0 {
+		err = &repositories.NotFoundError{"batch"}
+	}
+	if err == nil {
+		for _, transaction := range transactions {
+			if transaction.SenderBank != bankID {
+				return nil, status.Error(codes.PermissionDenied, "")
+			}
+		}
+	}
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	return batchReply(ID, transactions), nil
+}
+
+func batchReply(ID uuid.UUID, transactions []repository.ListItem) *proto.BatchReply {
+	reply := &proto.BatchReply{
+		Id:   ID[:],
+		Done: true,
+	}
+	for _, tx := range transactions {
+		switch tx.Status {
+		case transaction.Started:
+			reply.Started++
+			reply.Done = false
+		case transaction.Confirmed:
+			reply.Confirmed++
+			reply.Done = false
+		case transaction.Completed:
+			reply.Completed++
+			reply.CompletedAmount += tx.Amount
+		case transaction.Failed:
+			reply.Failed++
+		}
+		reply.Amount += tx.Amount
+		reply.Items = append(reply.Items, listItemReply(tx))
+	}
+	return reply
+}
@@ -0,0 +1,76 @@
+package service_test
+
+import (
+	"codepix/bank-api/lib/repositories"
+	proto "codepix/bank-api/proto/codepix/transaction/read"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/read/repository"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFindBatch(t *testing.T) {
+	type output = []repository.ListItem
+
+	client, _, repo, _ := ServiceWithMocks()
+
+	batchID := uuid.New()
+	options := repository.ListOptions{BatchID: batchID}
+
+	valid := output{}
+	for _, status := range []transaction.Status{
+		transaction.Completed, transaction.Completed, transaction.Failed, transaction.Confirmed,
+	} {
+		tx := *ValidTransaction()
+		tx.BatchID = batchID
+		tx.Status = status
+		tx.Amount = 100
+		valid = append(valid, tx)
+	}
+	senderCtx := AuthenticatedContext(context.Background(), valid[0].SenderBank)
+	receiverCtx := AuthenticatedContext(context.Background(), valid[0].ReceiverBank)
+
+	testCases := []struct {
+		description string
+		ctx         context.Context
+		output      output
+		err         error
+		status      codes.Code
+	}{
+		{"valid", senderCtx, valid, nil, codes.OK},
+		{"not found", senderCtx, output{}, nil, codes.NotFound},
+		{"receiver denied", receiverCtx, valid, nil, codes.PermissionDenied},
+		{"internal error", senderCtx, nil, &repositories.InternalError{}, codes.Internal},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			repo.On("List", mock.IsType(tc.ctx), options).Return(tc.output, tc.err).Once()
+
+			reply, err := client.FindBatch(tc.ctx, &proto.FindBatchRequest{Id: batchID[:]})
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.status.String(), status.Code().String())
+
+			if tc.status == codes.OK {
+				require.NotNil(t, reply)
+				assert.Equal(t, batchID[:], reply.Id)
+				assert.Equal(t, uint64(0), reply.Started)
+				assert.Equal(t, uint64(1), reply.Confirmed)
+				assert.Equal(t, uint64(2), reply.Completed)
+				assert.Equal(t, uint64(1), reply.Failed)
+				assert.Equal(t, uint64(400), reply.Amount)
+				assert.Equal(t, uint64(200), reply.CompletedAmount)
+				assert.False(t, reply.Done)
+				assert.Len(t, reply.Items, 4)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/bank/auth"
+	proto "codepix/bank-api/proto/codepix/transaction/read"
+	"codepix/bank-api/transaction/read/repository"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s Service) History(ctx context.Context, req *proto.HistoryRequest,
+) (*proto.HistoryReply, error) {
+	bankID := auth.GetBankID(ctx)
+	ID, _ := uuid.FromBytes(req.Id)
+
+	timeline, err := s.EventLog.History(ctx, ID)
+	if err == nil {
+		allowed := timeline.SenderBank == bankID || timeline.ReceiverBank == bankID
+		if !allowed {
+			return nil, status.Error(codes.PermissionDenied, "")
+		}
+	}
+	return historyReply(timeline), rpc.MapError(ctx, err)
+}
+
+func historyReply(timeline *repository.Timeline) *proto.HistoryReply {
+	if timeline == nil {
+		return nil
+	}
+	steps := []*proto.HistoryStep{}
+	for i, step := range timeline.Steps {
+		var sincePrevious time.Duration
+		if i > 0 {
+			sincePrevious = step.Timestamp.Sub(timeline.Steps[i-1].Timestamp)
+		}
+		steps = append(steps, &proto.HistoryStep{
+			EventType:     string(step.EventType),
+			Version:       uint64(step.Version),
+			Timestamp:     timestamppb.New(step.Timestamp),
+			Bank:          optionalID(step.Bank),
+			SincePrevious: durationpb.New(sincePrevious),
+			SinceStart:    durationpb.New(step.Timestamp.Sub(timeline.Steps[0].Timestamp)),
+			Status:        proto.Status(step.Status),
+			RefundId:      optionalID(step.RefundID),
+			RefundStatus:  proto.RefundStatus(step.RefundStatus),
+		})
+	}
+	return &proto.HistoryReply{
+		Id:    timeline.ID[:],
+		Steps: steps,
+	}
+}
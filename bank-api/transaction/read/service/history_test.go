@@ -0,0 +1,253 @@
+package service_test
+
+import (
+	"codepix/bank-api/lib/repositories"
+	proto "codepix/bank-api/proto/codepix/transaction/read"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/read/repository"
+	"codepix/bank-api/transaction/transactiontest"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHistory(t *testing.T) {
+	type request = proto.HistoryRequest
+	type reply = proto.HistoryReply
+	type output = repository.Timeline
+
+	type in struct {
+		ctx     context.Context
+		request *request
+	}
+	type out struct {
+		output *output
+		err    error
+		reply  *reply
+		status codes.Code
+	}
+	type testCase struct {
+		description string
+		in          in
+		out         out
+	}
+
+	client, _, repo, _ := ServiceWithMocks()
+
+	ID := uuid.New()
+	senderBank, receiverBank := uuid.New(), uuid.New()
+	refundID := uuid.New()
+	start := time.Now()
+
+	valid := &output{
+		ID:           ID,
+		SenderBank:   senderBank,
+		ReceiverBank: receiverBank,
+		Steps: []repository.Step{
+			{transaction.StartedEvent, 1, start, senderBank,
+				transaction.Started, uuid.Nil, 0},
+			{transaction.ConfirmedEvent, 2, start.Add(2 * time.Second), receiverBank,
+				transaction.Confirmed, uuid.Nil, 0},
+			{transaction.CompletedEvent, 3, start.Add(5 * time.Second), senderBank,
+				transaction.Completed, uuid.Nil, 0},
+			{transaction.RefundRequestedEvent, 4, start.Add(time.Minute), receiverBank,
+				transaction.Completed, refundID, transaction.RefundRequested},
+			{transaction.RefundFailedEvent, 5, start.Add(2 * time.Minute), uuid.Nil,
+				transaction.Completed, refundID, transaction.RefundFailed},
+		},
+	}
+	validRequest := &request{Id: ID[:]}
+
+	validReply := &reply{
+		Id: ID[:],
+		Steps: []*proto.HistoryStep{
+			{
+				EventType:     string(transaction.StartedEvent),
+				Version:       1,
+				Timestamp:     timestamppb.New(start),
+				Bank:          senderBank[:],
+				SincePrevious: durationpb.New(0),
+				SinceStart:    durationpb.New(0),
+				Status:        proto.Status_Started,
+			},
+			{
+				EventType:     string(transaction.ConfirmedEvent),
+				Version:       2,
+				Timestamp:     timestamppb.New(start.Add(2 * time.Second)),
+				Bank:          receiverBank[:],
+				SincePrevious: durationpb.New(2 * time.Second),
+				SinceStart:    durationpb.New(2 * time.Second),
+				Status:        proto.Status_Confirmed,
+			},
+			{
+				EventType:     string(transaction.CompletedEvent),
+				Version:       3,
+				Timestamp:     timestamppb.New(start.Add(5 * time.Second)),
+				Bank:          senderBank[:],
+				SincePrevious: durationpb.New(3 * time.Second),
+				SinceStart:    durationpb.New(5 * time.Second),
+				Status:        proto.Status_Completed,
+			},
+			{
+				EventType:     string(transaction.RefundRequestedEvent),
+				Version:       4,
+				Timestamp:     timestamppb.New(start.Add(time.Minute)),
+				Bank:          receiverBank[:],
+				SincePrevious: durationpb.New(time.Minute - 5*time.Second),
+				SinceStart:    durationpb.New(time.Minute),
+				Status:        proto.Status_Completed,
+				RefundId:      refundID[:],
+				RefundStatus:  proto.RefundStatus_RefundRequested,
+			},
+			{
+				EventType:     string(transaction.RefundFailedEvent),
+				Version:       5,
+				Timestamp:     timestamppb.New(start.Add(2 * time.Minute)),
+				SincePrevious: durationpb.New(time.Minute),
+				SinceStart:    durationpb.New(2 * time.Minute),
+				Status:        proto.Status_Completed,
+				RefundId:      refundID[:],
+				RefundStatus:  proto.RefundStatus_RefundFailed,
+			},
+		},
+	}
+
+	senderCtx := AuthenticatedContext(context.Background(), senderBank)
+	receiverCtx := AuthenticatedContext(context.Background(), receiverBank)
+
+	testCases := []testCase{
+		{
+			"valid as sender",
+			in{
+				senderCtx,
+				validRequest,
+			},
+			out{
+				valid,
+				nil,
+				validReply,
+				codes.OK,
+			},
+		},
+		{
+			"valid as receiver",
+			in{
+				receiverCtx,
+				validRequest,
+			},
+			out{
+				valid,
+				nil,
+				validReply,
+				codes.OK,
+			},
+		},
+		{
+			"not found",
+			in{
+				senderCtx,
+				validRequest,
+			},
+			out{
+				nil,
+				&repositories.NotFoundError{},
+				nil,
+				codes.NotFound,
+			},
+		},
+		{
+			"unauthenticated",
+			in{
+				context.Background(),
+				validRequest,
+			},
+			out{
+				nil,
+				nil,
+				nil,
+				codes.Unauthenticated,
+			},
+		},
+		{
+			"permission denied",
+			in{
+				AuthenticatedContext(context.Background(), uuid.New()),
+				validRequest,
+			},
+			out{
+				valid,
+				nil,
+				nil,
+				codes.PermissionDenied,
+			},
+		},
+		{
+			"internal error",
+			in{
+				senderCtx,
+				validRequest,
+			},
+			out{
+				nil,
+				&repositories.InternalError{},
+				nil,
+				codes.Internal,
+			},
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			if tc.out.status != codes.Unauthenticated {
+				repo.On("History", mock.IsType(tc.in.ctx), ID).Return(tc.out.output, tc.out.err).Once()
+			}
+
+			reply, err := client.History(tc.in.ctx, tc.in.request)
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.out.status.String(), status.Code().String())
+
+			if tc.out.status == codes.OK {
+				assert.Empty(t, cmp.Diff(tc.out.reply, reply, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func HistoryIntegration(client proto.ServiceClient, creator transactiontest.Creator,
+) func(t *testing.T) {
+	return func(t *testing.T) {
+		if testing.Short() {
+			t.Skip()
+		}
+		ID, senderIDs, receiverIDs := creator.StartedIDs()
+
+		for _, bankID := range []uuid.UUID{senderIDs.BankID, receiverIDs.BankID} {
+			ctx := AuthenticatedContext(context.Background(), bankID)
+			reply, err := client.History(ctx, &proto.HistoryRequest{Id: ID[:]})
+			require.NoError(t, err)
+			require.Len(t, reply.Steps, 1)
+			assert.Equal(t, string(transaction.StartedEvent), reply.Steps[0].EventType)
+			assert.Equal(t, senderIDs.BankID[:], reply.Steps[0].Bank)
+		}
+
+		ctx := AuthenticatedContext(context.Background(), uuid.New())
+		_, err := client.History(ctx, &proto.HistoryRequest{Id: ID[:]})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		missingID := uuid.New()
+		_, err = client.History(ctx, &proto.HistoryRequest{Id: missingID[:]})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	}
+}
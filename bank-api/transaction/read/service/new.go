@@ -7,8 +7,10 @@ import (
 	"google.golang.org/grpc"
 )
 
-func Register(server *grpc.Server, repository repository.Repository) error {
-	service := &Service{Repository: repository}
+func Register(server *grpc.Server, repository repository.Repository,
+	eventLog repository.History,
+) error {
+	service := &Service{Repository: repository, EventLog: eventLog}
 	proto.RegisterServiceServer(server, service)
 	return nil
 }
@@ -15,6 +15,7 @@ import (
 
 type Service struct {
 	Repository repository.Repository
+	EventLog   repository.History
 	proto.UnimplementedServiceServer
 }
 
@@ -50,7 +51,13 @@ func findReply(transaction *repository.Transaction) *proto.FindReply {
 		Amount:           transaction.Amount,
 		Description:      transaction.Description,
 		Status:           proto.Status(transaction.Status),
+		FailureCode:      proto.FailureCode(transaction.FailureCode),
 		ReasonForFailing: transaction.ReasonForFailing,
+		RefundedAmount:   transaction.RefundedAmount,
+		Refunds:          refundsReply(transaction.Refunds),
+		BatchId:          optionalID(transaction.BatchID),
+		ChargeId:         optionalID(transaction.ChargeID),
+		Held:             transaction.Held,
 	}
 }
 
@@ -104,6 +111,36 @@ func listItemReply(transaction repository.ListItem) *proto.ListItem {
 		Amount:           transaction.Amount,
 		Description:      transaction.Description,
 		Status:           proto.Status(transaction.Status),
+		FailureCode:      proto.FailureCode(transaction.FailureCode),
 		ReasonForFailing: transaction.ReasonForFailing,
+		RefundedAmount:   transaction.RefundedAmount,
+		Refunds:          refundsReply(transaction.Refunds),
+		BatchId:          optionalID(transaction.BatchID),
+		ChargeId:         optionalID(transaction.ChargeID),
+		Held:             transaction.Held,
 	}
 }
+
+func refundsReply(refunds []repository.Refund) []*proto.Refund {
+	items := []*proto.Refund{}
+	for _, refund := range refunds {
+		items = append(items, &proto.Refund{
+			Id:               refund.ID[:],
+			CreatedAt:        timestamppb.New(refund.CreatedAt),
+			UpdatedAt:        timestamppb.New(refund.UpdatedAt),
+			Amount:           refund.Amount,
+			Reason:           refund.Reason,
+			Status:           proto.RefundStatus(refund.Status),
+			ReasonForFailing: refund.ReasonForFailing,
+		})
+	}
+	return items
+}
+
+// optionalID leaves IDs that are not set empty, instead of sending nil UUIDs.
+func optionalID(ID uuid.UUID) []byte {
+	if ID == uuid.Nil {
+		return nil
+	}
+	return ID[:]
+}
@@ -48,6 +48,7 @@ func TestIntegration(t *testing.T) {
 	tests := []test{
 		{"find", FindIntegration(client, repo, creator)},
 		{"list", ListIntegration(client, repo, creator)},
+		{"history", HistoryIntegration(client, creator)},
 	}
 	for i, test := range tests {
 		t.Run(fmt.Sprint(i, "_", test.description), test.fn)
@@ -228,5 +228,159 @@ func failedMapper(event eventhorizon.Event) *proto.FailedTransaction {
 		Id:        ID[:],
 		Timestamp: timestamppb.New(event.Timestamp()),
 		Reason:    failed.Reason,
+		Code:      proto.FailureCode(failed.Code),
+	}
+}
+
+func (s Stream) Released(stream proto.Stream_ReleasedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.ReleasedTransaction{}
+		for _, event := range events {
+			p := releasedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.ReleasedTransactions{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consume(stream.Context(),
+		sender,
+		stream.Recv,
+		transaction.ReleasedEvent,
+		transaction.ReleasedStream(bankID),
+		bankID.String(),
+	)
+}
+func releasedMapper(event eventhorizon.Event) *proto.ReleasedTransaction {
+	ID := event.AggregateID()
+	return &proto.ReleasedTransaction{
+		Id:        ID[:],
+		Timestamp: timestamppb.New(event.Timestamp()),
+	}
+}
+
+func (s Stream) RefundRequested(stream proto.Stream_RefundRequestedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.RequestedRefund{}
+		for _, event := range events {
+			p := refundRequestedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.RequestedRefunds{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consume(stream.Context(),
+		sender,
+		stream.Recv,
+		transaction.RefundRequestedEvent,
+		transaction.RefundRequestedStream(bankID),
+		bankID.String(),
+	)
+}
+func refundRequestedMapper(event eventhorizon.Event) *proto.RequestedRefund {
+	ID := event.AggregateID()
+	requested := event.Data().(*transaction.TransactionRefundRequested)
+	return &proto.RequestedRefund{
+		Id:           ID[:],
+		RefundId:     requested.RefundID[:],
+		Timestamp:    timestamppb.New(event.Timestamp()),
+		SenderBank:   requested.SenderBank[:],
+		ReceiverBank: requested.ReceiverBank[:],
+		Amount:       requested.Amount,
+		Reason:       requested.Reason,
+	}
+}
+
+func (s Stream) RefundConfirmed(stream proto.Stream_RefundConfirmedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.ConfirmedRefund{}
+		for _, event := range events {
+			p := refundConfirmedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.ConfirmedRefunds{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consume(stream.Context(),
+		sender,
+		stream.Recv,
+		transaction.RefundConfirmedEvent,
+		transaction.RefundConfirmedStream(bankID),
+		bankID.String(),
+	)
+}
+func refundConfirmedMapper(event eventhorizon.Event) *proto.ConfirmedRefund {
+	ID := event.AggregateID()
+	confirmed := event.Data().(*transaction.TransactionRefundConfirmed)
+	return &proto.ConfirmedRefund{
+		Id:        ID[:],
+		RefundId:  confirmed.RefundID[:],
+		Timestamp: timestamppb.New(event.Timestamp()),
+	}
+}
+
+func (s Stream) RefundCompleted(stream proto.Stream_RefundCompletedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.CompletedRefund{}
+		for _, event := range events {
+			p := refundCompletedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.CompletedRefunds{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consume(stream.Context(),
+		sender,
+		stream.Recv,
+		transaction.RefundCompletedEvent,
+		transaction.RefundCompletedStream(bankID),
+		bankID.String(),
+	)
+}
+func refundCompletedMapper(event eventhorizon.Event) *proto.CompletedRefund {
+	ID := event.AggregateID()
+	completed := event.Data().(*transaction.TransactionRefundCompleted)
+	return &proto.CompletedRefund{
+		Id:        ID[:],
+		RefundId:  completed.RefundID[:],
+		Timestamp: timestamppb.New(event.Timestamp()),
+	}
+}
+
+func (s Stream) RefundFailed(stream proto.Stream_RefundFailedServer) error {
+	sender := func(events []eventhorizon.Event) error {
+		ps := []*proto.FailedRefund{}
+		for _, event := range events {
+			p := refundFailedMapper(event)
+			ps = append(ps, p)
+		}
+		return stream.Send(&proto.FailedRefunds{
+			Events: ps,
+		})
+	}
+	bankID := auth.GetBankID(stream.Context())
+	return s.Consume(stream.Context(),
+		sender,
+		stream.Recv,
+		transaction.RefundFailedEvent,
+		transaction.RefundFailedStream(bankID),
+		bankID.String(),
+	)
+}
+func refundFailedMapper(event eventhorizon.Event) *proto.FailedRefund {
+	ID := event.AggregateID()
+	failed := event.Data().(*transaction.TransactionRefundFailed)
+	return &proto.FailedRefund{
+		Id:        ID[:],
+		RefundId:  failed.RefundID[:],
+		Timestamp: timestamppb.New(event.Timestamp()),
+		Reason:    failed.Reason,
 	}
 }
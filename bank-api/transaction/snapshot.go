@@ -0,0 +1,79 @@
+package transaction
+
+import (
+	"github.com/google/uuid"
+)
+
+// snapshotVersion must be bumped whenever the snapshot shape changes, so snapshots taken
+// before are ignored and the aggregate is replayed from its events instead.
+const snapshotVersion = 3
+
+type snapshot struct {
+	Sender       uuid.UUID        `bson:"sender"`
+	SenderBank   uuid.UUID        `bson:"sender_bank"`
+	Receiver     uuid.UUID        `bson:"receiver"`
+	ReceiverBank uuid.UUID        `bson:"receiver_bank"`
+	Amount       Amount           `bson:"amount"`
+	Description  string           `bson:"description"`
+	ChargeID     uuid.UUID        `bson:"charge_id"`
+	Status       Status           `bson:"status"`
+	Held         bool             `bson:"held"`
+	Refunds      []refundSnapshot `bson:"refunds"`
+}
+
+type refundSnapshot struct {
+	ID     uuid.UUID    `bson:"_id"`
+	Amount Amount       `bson:"amount"`
+	Status RefundStatus `bson:"status"`
+}
+
+func (ag *Aggregate) SnapshotVersion() int {
+	return snapshotVersion
+}
+
+func (ag *Aggregate) Snapshot() any {
+	tx := ag.Transaction
+	refunds := make([]refundSnapshot, 0, len(tx.Refunds))
+	for ID, refund := range tx.Refunds {
+		refunds = append(refunds, refundSnapshot{ID, refund.Amount, refund.Status})
+	}
+	return snapshot{
+		Sender:       tx.Sender,
+		SenderBank:   tx.SenderBank,
+		Receiver:     tx.Receiver,
+		ReceiverBank: tx.ReceiverBank,
+		Amount:       tx.Amount,
+		Description:  tx.Description,
+		ChargeID:     tx.ChargeID,
+		Status:       tx.Status,
+		Held:         tx.Held,
+		Refunds:      refunds,
+	}
+}
+
+func (ag *Aggregate) RestoreSnapshot(decode func(state any) error) error {
+	var state snapshot
+	err := decode(&state)
+	if err != nil {
+		return err
+	}
+	tx := &Transaction{
+		Sender:       state.Sender,
+		SenderBank:   state.SenderBank,
+		Receiver:     state.Receiver,
+		ReceiverBank: state.ReceiverBank,
+		Amount:       state.Amount,
+		Description:  state.Description,
+		ChargeID:     state.ChargeID,
+		Status:       state.Status,
+		Held:         state.Held,
+	}
+	if len(state.Refunds) > 0 {
+		tx.Refunds = map[uuid.UUID]Refund{}
+	}
+	for _, refund := range state.Refunds {
+		tx.Refunds[refund.ID] = Refund{refund.Amount, refund.Status}
+	}
+	ag.Transaction = tx
+	return nil
+}
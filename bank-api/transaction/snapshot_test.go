@@ -0,0 +1,73 @@
+package transaction_test
+
+import (
+	"codepix/bank-api/transaction"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/looplab/eventhorizon/codec/bson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestSnapshot(t *testing.T) {
+	testCases := map[string]*transaction.Transaction{
+		"started": {
+			Sender:       uuid.New(),
+			SenderBank:   uuid.New(),
+			Receiver:     uuid.New(),
+			ReceiverBank: uuid.New(),
+			Amount:       100,
+			Description:  "test",
+			Status:       transaction.Started,
+		},
+		"charge": {
+			Sender:       uuid.New(),
+			SenderBank:   uuid.New(),
+			Receiver:     uuid.New(),
+			ReceiverBank: uuid.New(),
+			Amount:       100,
+			ChargeID:     uuid.New(),
+			Status:       transaction.Confirmed,
+		},
+		"held": {
+			Sender:       uuid.New(),
+			SenderBank:   uuid.New(),
+			Receiver:     uuid.New(),
+			ReceiverBank: uuid.New(),
+			Amount:       100,
+			Status:       transaction.Started,
+			Held:         true,
+		},
+		"refunded": {
+			Sender:       uuid.New(),
+			SenderBank:   uuid.New(),
+			Receiver:     uuid.New(),
+			ReceiverBank: uuid.New(),
+			Amount:       100,
+			Status:       transaction.Completed,
+			Refunds: map[uuid.UUID]transaction.Refund{
+				uuid.New(): {40, transaction.RefundCompleted},
+				uuid.New(): {60, transaction.RefundFailed},
+			},
+		},
+	}
+	for description, tx := range testCases {
+		t.Run(description, func(t *testing.T) {
+			ag := transaction.New(uuid.New())
+			ag.Transaction = tx
+
+			state, err := bson.Marshal(ag.Snapshot())
+			require.NoError(t, err)
+
+			restored := transaction.New(ag.EntityID())
+			err = restored.RestoreSnapshot(func(into any) error {
+				return bson.Unmarshal(state, into)
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tx, restored.Transaction)
+			assert.Equal(t, tx.Refundable(), restored.Transaction.Refundable())
+		})
+	}
+}
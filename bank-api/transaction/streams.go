@@ -6,8 +6,19 @@ const startedStream = string(StartedEvent) + "_"
 const confirmedStream = string(ConfirmedEvent) + "_"
 const completedStream = string(CompletedEvent) + "_"
 const failedStream = string(FailedEvent) + "_"
+const releasedStream = string(ReleasedEvent) + "_"
+const refundRequestedStream = string(RefundRequestedEvent) + "_"
+const refundConfirmedStream = string(RefundConfirmedEvent) + "_"
+const refundCompletedStream = string(RefundCompletedEvent) + "_"
+const refundFailedStream = string(RefundFailedEvent) + "_"
 
 func StartedStream(bankID uuid.UUID) string   { return startedStream + bankID.String() }
 func ConfirmedStream(bankID uuid.UUID) string { return confirmedStream + bankID.String() }
 func CompletedStream(bankID uuid.UUID) string { return completedStream + bankID.String() }
 func FailedStream(bankID uuid.UUID) string    { return failedStream + bankID.String() }
+func ReleasedStream(bankID uuid.UUID) string  { return releasedStream + bankID.String() }
+
+func RefundRequestedStream(bankID uuid.UUID) string { return refundRequestedStream + bankID.String() }
+func RefundConfirmedStream(bankID uuid.UUID) string { return refundConfirmedStream + bankID.String() }
+func RefundCompletedStream(bankID uuid.UUID) string { return refundCompletedStream + bankID.String() }
+func RefundFailedStream(bankID uuid.UUID) string    { return refundFailedStream + bankID.String() }
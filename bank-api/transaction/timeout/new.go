@@ -0,0 +1,38 @@
+package timeout
+
+import (
+	"codepix/bank-api/adapters/eventhandler"
+	"codepix/bank-api/config"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/timeout/repository"
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/looplab/eventhorizon"
+)
+
+func Setup(logger logr.Logger, config config.Config, outbox eventhorizon.Outbox,
+	commandHandler eventhorizon.CommandHandler, repository repository.Repository,
+) (*Manager, error) {
+	logger = logger.WithName("timeout")
+	manager := &Manager{
+		Logger:          logger,
+		CommandHandler:  commandHandler,
+		Repository:      repository,
+		ConfirmTimeout:  config.Transaction.ConfirmTimeout,
+		CompleteTimeout: config.Transaction.CompleteTimeout,
+		Interval:        config.Transaction.TimeoutInterval,
+	}
+	err := outbox.AddHandler(context.Background(),
+		eventhorizon.MatchAggregates{transaction.AggregateType},
+		eventhandler.Named(
+			eventhandler.Logger(logger, manager),
+			manager.HandlerType(),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("setup timeout manager: %w", err)
+	}
+	return manager, nil
+}
@@ -0,0 +1,87 @@
+package database
+
+import (
+	"codepix/bank-api/adapters/databaseclient"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/timeout/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+)
+
+type Database struct {
+	*databaseclient.Database
+}
+
+var _ repository.Repository = Database{}
+
+func (db Database) Save(deadline repository.Deadline) error {
+	new := NewDeadline(deadline)
+	tx := db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "transaction_id"}},
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "deadlines.version < excluded.version"},
+		}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"updated_at", "version", "bank_id", "code", "due_at", "done",
+		}),
+	}).Create(new)
+	return databaseclient.MapError(tx)
+}
+
+func (db Database) ListDue(now time.Time) ([]repository.Deadline, error) {
+	var dbDeadlines []Deadline
+	tx := db.Where("done = ? and due_at <= ?", false, now).
+		Order("due_at").
+		Find(&dbDeadlines)
+
+	deadlines := []repository.Deadline{}
+	for _, dbDeadline := range dbDeadlines {
+		deadlines = append(deadlines, *DeadlineFromDB(dbDeadline))
+	}
+	return deadlines, databaseclient.MapError(tx)
+}
+
+func (db Database) Resolve(transactionID uuid.UUID, version int) error {
+	tx := db.Model(&Deadline{}).
+		Where("transaction_id = ? and version = ?", transactionID, version).
+		Update("done", true)
+	return databaseclient.MapError(tx)
+}
+
+type Deadline struct {
+	databaseclient.BaseModel
+	TransactionID uuid.UUID `gorm:"<-:create;uniqueIndex"`
+	Version       int
+	BankID        uuid.UUID
+	Code          transaction.FailureCode
+	DueAt         time.Time `gorm:"index"`
+	Done          bool
+}
+
+func NewDeadline(deadline repository.Deadline) *Deadline {
+	return &Deadline{
+		BaseModel:     databaseclient.NewBaseModel(),
+		TransactionID: deadline.TransactionID,
+		Version:       deadline.Version,
+		BankID:        deadline.BankID,
+		Code:          deadline.Code,
+		DueAt:         deadline.DueAt,
+		Done:          deadline.Done,
+	}
+}
+
+func DeadlineFromDB(dbDeadline Deadline) *repository.Deadline {
+	if dbDeadline == (Deadline{}) {
+		return nil
+	}
+	return &repository.Deadline{
+		TransactionID: dbDeadline.TransactionID,
+		Version:       dbDeadline.Version,
+		BankID:        dbDeadline.BankID,
+		Code:          dbDeadline.Code,
+		DueAt:         dbDeadline.DueAt,
+		Done:          dbDeadline.Done,
+	}
+}
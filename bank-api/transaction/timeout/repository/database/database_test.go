@@ -0,0 +1,88 @@
+package database_test
+
+import (
+	"codepix/bank-api/adapters/databaseclient"
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/timeout/repository"
+	"codepix/bank-api/transaction/timeout/repository/database"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Repo() repository.Repository {
+	client, err := databaseclient.Open(bankapitest.Config, bankapitest.Logger)
+	if err != nil {
+		panic(err)
+	}
+	err = client.AutoMigrate(
+		&database.Deadline{},
+	)
+	if err != nil {
+		panic(err)
+	}
+	return &database.Database{Database: client}
+}
+
+func TestDeadlines(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	repo := Repo()
+	now := time.Now().Truncate(time.Millisecond)
+
+	started := repository.Deadline{
+		TransactionID: uuid.New(),
+		Version:       1,
+		BankID:        uuid.New(),
+		Code:          transaction.FailureTimeoutConfirm,
+		DueAt:         now.Add(-time.Second),
+	}
+	confirmed := started
+	confirmed.Version = 2
+	confirmed.Code = transaction.FailureTimeoutComplete
+	confirmed.DueAt = now.Add(-time.Millisecond)
+
+	notDue := repository.Deadline{
+		TransactionID: uuid.New(),
+		Version:       1,
+		BankID:        uuid.New(),
+		Code:          transaction.FailureTimeoutConfirm,
+		DueAt:         now.Add(time.Hour),
+	}
+
+	// events may be handled out of order
+	require.NoError(t, repo.Save(confirmed))
+	require.NoError(t, repo.Save(started))
+	require.NoError(t, repo.Save(notDue))
+
+	deadlines, err := repo.ListDue(now)
+	require.NoError(t, err)
+	require.Len(t, deadlines, 1)
+	assert.Empty(t, cmp.Diff(confirmed, deadlines[0]))
+
+	require.NoError(t, repo.Resolve(confirmed.TransactionID, started.Version))
+	deadlines, err = repo.ListDue(now)
+	require.NoError(t, err)
+	assert.Len(t, deadlines, 1)
+
+	require.NoError(t, repo.Resolve(confirmed.TransactionID, confirmed.Version))
+	deadlines, err = repo.ListDue(now)
+	require.NoError(t, err)
+	assert.Empty(t, deadlines)
+
+	completed := repository.Deadline{
+		TransactionID: notDue.TransactionID,
+		Version:       2,
+		Done:          true,
+	}
+	require.NoError(t, repo.Save(completed))
+	deadlines, err = repo.ListDue(now.Add(2 * time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, deadlines)
+}
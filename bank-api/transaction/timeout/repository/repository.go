@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"codepix/bank-api/transaction"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Save(deadline Deadline) error
+	ListDue(now time.Time) ([]Deadline, error)
+	Resolve(transactionID uuid.UUID, version int) error
+}
+
+// Deadline is the pending timeout of a transaction. Only the deadline of the latest
+// event version is kept, so events handled out of order don't reschedule it.
+type Deadline struct {
+	TransactionID uuid.UUID
+	Version       int
+	BankID        uuid.UUID
+	Code          transaction.FailureCode
+	DueAt         time.Time
+	Done          bool
+}
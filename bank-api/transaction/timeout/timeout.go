@@ -0,0 +1,133 @@
+package timeout
+
+import (
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/timeout/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+// Manager schedules a deadline whenever a transaction waits on a bank and fails the
+// transaction once the deadline passes. Deadlines are persisted, so they are still
+// enforced after a restart. Held transactions wait on a reviewer rather than a bank, so
+// their deadlines are paused until they are released, and then scheduled in full.
+type Manager struct {
+	Logger          logr.Logger
+	CommandHandler  eventhorizon.CommandHandler
+	Repository      repository.Repository
+	ConfirmTimeout  time.Duration
+	CompleteTimeout time.Duration
+	Interval        time.Duration
+}
+
+var _ eventhorizon.EventHandler = Manager{}
+
+func (m Manager) HandlerType() eventhorizon.EventHandlerType {
+	return eventhorizon.EventHandlerType(transaction.AggregateType + "_timeout")
+}
+
+func (m Manager) HandleEvent(ctx context.Context, event eventhorizon.Event) error {
+	deadline := repository.Deadline{
+		TransactionID: event.AggregateID(),
+		Version:       event.Version(),
+	}
+	switch e := event.Data().(type) {
+	case *transaction.TransactionStarted:
+		if e.Held {
+			deadline.Done = true
+			break
+		}
+		m.confirmDeadline(&deadline, e.ReceiverBank, event.Timestamp())
+
+	case *transaction.TransactionConfirmed:
+		if e.Held {
+			deadline.Done = true
+			break
+		}
+		m.completeDeadline(&deadline, e.SenderBank, event.Timestamp())
+
+	case *transaction.TransactionReleased:
+		if e.Confirmed {
+			m.completeDeadline(&deadline, e.SenderBank, event.Timestamp())
+		} else {
+			m.confirmDeadline(&deadline, e.ReceiverBank, event.Timestamp())
+		}
+
+	case *transaction.TransactionCompleted, *transaction.TransactionFailed:
+		deadline.Done = true
+
+	case *transaction.TransactionRefundRequested, *transaction.TransactionRefundConfirmed,
+		*transaction.TransactionRefundCompleted, *transaction.TransactionRefundFailed:
+		return nil
+
+	default:
+		return fmt.Errorf("unknown event type %s/%T", event.EventType(), event.Data())
+	}
+	return m.Repository.Save(deadline)
+}
+
+// confirmDeadline is the deadline of the receiver bank, which must confirm the transaction.
+func (m Manager) confirmDeadline(deadline *repository.Deadline, receiverBank uuid.UUID, from time.Time) {
+	deadline.BankID = receiverBank
+	deadline.Code = transaction.FailureTimeoutConfirm
+	deadline.DueAt = from.Add(m.ConfirmTimeout)
+}
+
+// completeDeadline is the deadline of the sender bank, which must complete the transaction.
+func (m Manager) completeDeadline(deadline *repository.Deadline, senderBank uuid.UUID, from time.Time) {
+	deadline.BankID = senderBank
+	deadline.Code = transaction.FailureTimeoutComplete
+	deadline.DueAt = from.Add(m.CompleteTimeout)
+}
+
+// Run fails the transactions whose deadlines passed every interval until the context is done.
+func (m Manager) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.Expire(ctx, now)
+		}
+	}
+}
+
+func (m Manager) Expire(ctx context.Context, now time.Time) {
+	deadlines, err := m.Repository.ListDue(now)
+	if err != nil {
+		m.Logger.Error(err, "fail: list due deadlines")
+		return
+	}
+	for _, deadline := range deadlines {
+		kvs := []any{
+			"tx", deadline.TransactionID,
+			"code", deadline.Code,
+		}
+		err := m.CommandHandler.HandleCommand(ctx, transaction.TimeOut{
+			ID:     deadline.TransactionID,
+			BankID: deadline.BankID,
+			Code:   deadline.Code,
+		})
+		// an invariant violation means the transaction moved on before the deadline was handled
+		invariantViolation := &aggregates.InvariantViolation{}
+		if err != nil && !errors.As(err, &invariantViolation) {
+			m.Logger.Error(err, "fail: handle command", kvs...)
+			continue
+		}
+		err = m.Repository.Resolve(deadline.TransactionID, deadline.Version)
+		if err != nil {
+			m.Logger.Error(err, "fail: resolve deadline", kvs...)
+			continue
+		}
+		m.Logger.Info("deadline handled", kvs...)
+	}
+}
@@ -0,0 +1,209 @@
+package timeout_test
+
+import (
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/timeout"
+	"codepix/bank-api/transaction/timeout/repository"
+	"codepix/bank-api/transaction/transactiontest"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandleEvent(t *testing.T) {
+	repo := &transactiontest.MockDeadlineRepo{}
+	manager := timeout.Manager{
+		Logger:          bankapitest.Logger,
+		Repository:      repo,
+		ConfirmTimeout:  time.Minute,
+		CompleteTimeout: time.Hour,
+	}
+
+	ID := uuid.New()
+	senderBank, receiverBank := uuid.New(), uuid.New()
+	now := time.Now()
+
+	testCases := []struct {
+		data     eventhorizon.EventData
+		version  int
+		deadline repository.Deadline
+	}{
+		{
+			&transaction.TransactionStarted{SenderBank: senderBank, ReceiverBank: receiverBank},
+			1,
+			repository.Deadline{ID, 1, receiverBank, transaction.FailureTimeoutConfirm,
+				now.Add(time.Minute), false},
+		},
+		{
+			&transaction.TransactionConfirmed{SenderBank: senderBank, ReceiverBank: receiverBank},
+			2,
+			repository.Deadline{ID, 2, senderBank, transaction.FailureTimeoutComplete,
+				now.Add(time.Hour), false},
+		},
+		{
+			&transaction.TransactionStarted{SenderBank: senderBank, ReceiverBank: receiverBank,
+				Held: true},
+			1,
+			repository.Deadline{ID, 1, uuid.Nil, 0, time.Time{}, true},
+		},
+		{
+			&transaction.TransactionConfirmed{SenderBank: senderBank, ReceiverBank: receiverBank,
+				Held: true},
+			2,
+			repository.Deadline{ID, 2, uuid.Nil, 0, time.Time{}, true},
+		},
+		{
+			&transaction.TransactionReleased{SenderBank: senderBank, ReceiverBank: receiverBank},
+			2,
+			repository.Deadline{ID, 2, receiverBank, transaction.FailureTimeoutConfirm,
+				now.Add(time.Minute), false},
+		},
+		{
+			&transaction.TransactionReleased{SenderBank: senderBank, ReceiverBank: receiverBank,
+				Confirmed: true},
+			3,
+			repository.Deadline{ID, 3, senderBank, transaction.FailureTimeoutComplete,
+				now.Add(time.Hour), false},
+		},
+		{
+			&transaction.TransactionCompleted{SenderBank: senderBank, ReceiverBank: receiverBank},
+			3,
+			repository.Deadline{ID, 3, uuid.Nil, 0, time.Time{}, true},
+		},
+		{
+			&transaction.TransactionFailed{SenderBank: senderBank, ReceiverBank: receiverBank},
+			3,
+			repository.Deadline{ID, 3, uuid.Nil, 0, time.Time{}, true},
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			data := tc.data.(interface{ Type() eventhorizon.EventType })
+			event := eventhorizon.NewEvent(data.Type(), tc.data, now,
+				eventhorizon.ForAggregate(transaction.AggregateType, ID, tc.version))
+
+			repo.On("Save", tc.deadline).Return(nil).Once()
+
+			err := manager.HandleEvent(context.Background(), event)
+			assert.NoError(t, err)
+		})
+	}
+	repo.AssertExpectations(t)
+}
+
+// Deadlines are on the bank the transaction waits on: the receiver confirms, and the
+// sender completes.
+func TestDeadlineBank(t *testing.T) {
+	repo := &transactiontest.MockDeadlineRepo{}
+	manager := timeout.Manager{
+		Logger:     bankapitest.Logger,
+		Repository: repo,
+	}
+	senderBank, receiverBank := uuid.New(), uuid.New()
+
+	testCases := []struct {
+		description string
+		data        eventhorizon.EventData
+		code        transaction.FailureCode
+		bankID      uuid.UUID
+	}{
+		{
+			"started",
+			&transaction.TransactionStarted{SenderBank: senderBank, ReceiverBank: receiverBank},
+			transaction.FailureTimeoutConfirm,
+			receiverBank,
+		},
+		{
+			"confirmed",
+			&transaction.TransactionConfirmed{SenderBank: senderBank, ReceiverBank: receiverBank},
+			transaction.FailureTimeoutComplete,
+			senderBank,
+		},
+		{
+			"released before confirmation",
+			&transaction.TransactionReleased{SenderBank: senderBank, ReceiverBank: receiverBank},
+			transaction.FailureTimeoutConfirm,
+			receiverBank,
+		},
+		{
+			"released after confirmation",
+			&transaction.TransactionReleased{SenderBank: senderBank, ReceiverBank: receiverBank,
+				Confirmed: true},
+			transaction.FailureTimeoutComplete,
+			senderBank,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			data := tc.data.(interface{ Type() eventhorizon.EventType })
+			event := eventhorizon.NewEvent(data.Type(), tc.data, time.Now(),
+				eventhorizon.ForAggregate(transaction.AggregateType, uuid.New(), 1))
+
+			var saved repository.Deadline
+			repo.On("Save", mock.Anything).
+				Run(func(args mock.Arguments) { saved = args.Get(0).(repository.Deadline) }).
+				Return(nil).Once()
+
+			err := manager.HandleEvent(context.Background(), event)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.code, saved.Code)
+			assert.Equal(t, tc.bankID, saved.BankID)
+		})
+	}
+}
+
+func TestExpire(t *testing.T) {
+	type command = transaction.TimeOut
+
+	repo := &transactiontest.MockDeadlineRepo{}
+	commandHandler := &transactiontest.MockCommandHandler{}
+	manager := timeout.Manager{
+		Logger:         bankapitest.Logger,
+		CommandHandler: commandHandler,
+		Repository:     repo,
+	}
+	now := time.Now()
+
+	newDeadline := func() repository.Deadline {
+		return repository.Deadline{uuid.New(), 1, uuid.New(), transaction.FailureTimeoutConfirm,
+			now.Add(-time.Second), false}
+	}
+	failCommand := func(deadline repository.Deadline) command {
+		return command{deadline.TransactionID, deadline.BankID, deadline.Code}
+	}
+
+	failed := newDeadline()
+	alreadyConfirmed := newDeadline()
+	unavailable := newDeadline()
+
+	repo.On("ListDue", now).
+		Return([]repository.Deadline{failed, alreadyConfirmed, unavailable}, nil).Once()
+
+	commandHandler.On("HandleCommand", mock.Anything, failCommand(failed)).
+		Return(nil).Once()
+	repo.On("Resolve", failed.TransactionID, failed.Version).
+		Return(nil).Once()
+
+	commandHandler.On("HandleCommand", mock.Anything, failCommand(alreadyConfirmed)).
+		Return(&aggregates.InvariantViolation{transaction.ErrCannotTimeOutConfirmIfNotStarted}).Once()
+	repo.On("Resolve", alreadyConfirmed.TransactionID, alreadyConfirmed.Version).
+		Return(nil).Once()
+
+	commandHandler.On("HandleCommand", mock.Anything, failCommand(unavailable)).
+		Return(errors.New("some error")).Once()
+
+	manager.Expire(context.Background(), now)
+
+	repo.AssertExpectations(t)
+	commandHandler.AssertExpectations(t)
+	repo.AssertNotCalled(t, "Resolve", unavailable.TransactionID, unavailable.Version)
+}
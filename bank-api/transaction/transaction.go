@@ -14,6 +14,41 @@ const (
 	Failed
 )
 
+type RefundStatus uint8
+
+const (
+	RefundRequested RefundStatus = iota + 1
+	RefundConfirmed
+	RefundCompleted
+	RefundFailed
+)
+
+// FailureCode classifies why a transaction failed. Events recorded before failure codes
+// existed decode as FailureUnspecified.
+type FailureCode uint8
+
+const (
+	FailureUnspecified FailureCode = iota
+	FailureAccountClosed
+	FailureAccountBlocked
+	FailureInsufficientFunds
+	FailureInvalidAmount
+	FailureSuspectedFraud
+	FailureTimeoutConfirm
+	FailureTimeoutComplete
+	FailureOther
+)
+
+// Timeout tells whether the code is one only the timeout manager fails transactions with.
+func (c FailureCode) Timeout() bool {
+	return c == FailureTimeoutConfirm || c == FailureTimeoutComplete
+}
+
+type Refund struct {
+	Amount Amount
+	Status RefundStatus
+}
+
 type Transaction struct {
 	Sender       uuid.UUID
 	SenderBank   uuid.UUID
@@ -21,5 +56,20 @@ type Transaction struct {
 	ReceiverBank uuid.UUID
 	Amount       Amount
 	Description  string
+	ChargeID     uuid.UUID
 	Status       Status
+	Held         bool
+	Refunds      map[uuid.UUID]Refund
+}
+
+// Refundable returns the amount that can still be refunded, reserving the amount of
+// refunds that are still in progress.
+func (tx Transaction) Refundable() Amount {
+	refundable := tx.Amount
+	for _, refund := range tx.Refunds {
+		if refund.Status != RefundFailed {
+			refundable -= refund.Amount
+		}
+	}
+	return refundable
 }
@@ -0,0 +1,28 @@
+package transactiontest
+
+import (
+	"codepix/bank-api/transaction/timeout/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDeadlineRepo struct {
+	mock.Mock
+}
+
+var _ repository.Repository = MockDeadlineRepo{}
+
+func (m MockDeadlineRepo) Save(deadline repository.Deadline) error {
+	args := m.Called(deadline)
+	return get[error](args, 0)
+}
+func (m MockDeadlineRepo) ListDue(now time.Time) ([]repository.Deadline, error) {
+	args := m.Called(now)
+	return get[[]repository.Deadline](args, 0), get[error](args, 1)
+}
+func (m MockDeadlineRepo) Resolve(transactionID uuid.UUID, version int) error {
+	args := m.Called(transactionID, version)
+	return get[error](args, 0)
+}
@@ -0,0 +1,32 @@
+package transactiontest
+
+import (
+	"codepix/bank-api/transaction/write/idempotency/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockIdempotencyRepo struct {
+	mock.Mock
+}
+
+var _ repository.Repository = MockIdempotencyRepo{}
+
+func (m MockIdempotencyRepo) Add(record repository.Record) error {
+	args := m.Called(record)
+	return get[error](args, 0)
+}
+func (m MockIdempotencyRepo) Find(bankID uuid.UUID, key string) (*repository.Record, error) {
+	args := m.Called(bankID, key)
+	return get[*repository.Record](args, 0), get[error](args, 1)
+}
+func (m MockIdempotencyRepo) Delete(bankID uuid.UUID, key string) error {
+	args := m.Called(bankID, key)
+	return get[error](args, 0)
+}
+func (m MockIdempotencyRepo) DeleteExpired(before time.Time) error {
+	args := m.Called(before)
+	return get[error](args, 0)
+}
@@ -13,6 +13,7 @@ type MockReadRepo struct {
 }
 
 var _ repository.Repository = MockReadRepo{}
+var _ repository.History = MockReadRepo{}
 
 func (m MockReadRepo) Find(ctx context.Context, ID uuid.UUID) (*repository.Transaction, error) {
 	args := m.Called(ctx, ID)
@@ -25,6 +26,11 @@ func (m MockReadRepo) List(ctx context.Context, options repository.ListOptions,
 	return get[[]repository.ListItem](args, 0), get[error](args, 1)
 }
 
+func (m MockReadRepo) History(ctx context.Context, ID uuid.UUID) (*repository.Timeline, error) {
+	args := m.Called(ctx, ID)
+	return get[*repository.Timeline](args, 0), get[error](args, 1)
+}
+
 func get[T any](args mock.Arguments, index int) T {
 	if args[index] == nil {
 		return *new(T)
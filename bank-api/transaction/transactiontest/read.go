@@ -13,6 +13,7 @@ import (
 	proto "codepix/bank-api/proto/codepix/transaction/read"
 	"codepix/bank-api/transaction"
 	"codepix/bank-api/transaction/read/repository"
+	"codepix/bank-api/transaction/read/repository/eventlog"
 	"codepix/bank-api/transaction/read/repository/projection"
 	"codepix/bank-api/transaction/read/service"
 	"codepix/bank-api/transaction/read/stream"
@@ -24,6 +25,12 @@ import (
 )
 
 func ReadRepo() (repository.Repository, eventhorizon.CommandHandler, TearDown) {
+	readRepo, _, commandHandler, tearDown := readRepos()
+	return readRepo, commandHandler, tearDown
+}
+
+func readRepos() (repository.Repository, repository.History, eventhorizon.CommandHandler,
+	TearDown) {
 	commandHandler, store, storeTearDown := CommandHandler()
 
 	projectionClient, err := projectionclient.Open(context.Background(),
@@ -43,7 +50,32 @@ func ReadRepo() (repository.Repository, eventhorizon.CommandHandler, TearDown) {
 			panic(err)
 		}
 	}
-	return projection, commandHandler, tearDown
+	return projection, eventlog.EventLog{store}, commandHandler, tearDown
+}
+
+func Rebuilder() (*projection.Projection, projectionclient.Rebuilder,
+	eventhorizon.CommandHandler, TearDown) {
+	commandHandler, store, storeTearDown := CommandHandler()
+
+	projectionClient, err := projectionclient.Open(context.Background(),
+		bankapitest.Config, bankapitest.Logger, store.Outbox)
+	if err != nil {
+		panic(err)
+	}
+	readRepo, err := projection.New(projectionClient)
+	if err != nil {
+		panic(err)
+	}
+	rebuilder := projection.NewRebuilder(projectionClient, store)
+	store.Start()
+	tearDown := func() {
+		storeTearDown()
+		err := projectionClient.Close()
+		if err != nil {
+			panic(err)
+		}
+	}
+	return readRepo, rebuilder, commandHandler, tearDown
 }
 
 func ReadService() (proto.ServiceClient, repository.Repository, Creator, TearDown) {
@@ -52,7 +84,7 @@ func ReadService() (proto.ServiceClient, repository.Repository, Creator, TearDow
 		panic(err)
 	}
 	server, client, serve := bankapitest.Server(validator)
-	readRepo, commandHandler, storeTearDown := ReadRepo()
+	readRepo, eventLog, commandHandler, storeTearDown := readRepos()
 
 	database, err := databaseclient.Open(bankapitest.Config, bankapitest.Logger)
 	if err != nil {
@@ -60,13 +92,14 @@ func ReadService() (proto.ServiceClient, repository.Repository, Creator, TearDow
 	}
 	err = database.AutoMigrate(
 		&pixkeydatabase.PixKey{},
+		&pixkeydatabase.PixKeyEvent{},
 	)
 	if err != nil {
 		panic(err)
 	}
 	pixKeyRepo := &pixkeydatabase.Database{Database: database}
 
-	err = service.Register(server, readRepo)
+	err = service.Register(server, readRepo, eventLog)
 	if err != nil {
 		panic(err)
 	}
@@ -92,7 +125,7 @@ func ReadServiceWithMocks() (proto.ServiceClient, *MockCommandHandler, *MockRead
 	projection := new(MockReadRepo)
 	pixKeyRepo := new(pixkeytest.MockRepo)
 
-	err = service.Register(server, projection)
+	err = service.Register(server, projection, projection)
 	if err != nil {
 		panic(err)
 	}
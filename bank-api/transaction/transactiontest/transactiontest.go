@@ -21,7 +21,8 @@ var Copy = func(ag *transaction.Aggregate) *transaction.Aggregate {
 	aggregate := transaction.New(ag.EntityID())
 	ctx := context.Background()
 	for _, event := range ag.UncommittedEvents() {
-		aggregate.AppendEvent(event.EventType(), event.Data(), event.Timestamp())
+		aggregate.AppendEvent(event.EventType(), event.Data(), event.Timestamp(),
+			eventhorizon.WithMetadata(event.Metadata()))
 		aggregate.ApplyEvent(ctx, event)
 	}
 	return aggregate
@@ -93,9 +94,47 @@ func ValidFailCommand(ID uuid.UUID) transaction.Fail {
 	return transaction.Fail{
 		ID:     ID,
 		BankID: uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+		Code:   transaction.FailureInsufficientFunds,
 		Reason: "not enough balance",
 	}
 }
+func ValidReleaseCommand(ID uuid.UUID) transaction.Release {
+	return transaction.Release{
+		ID:     ID,
+		BankID: uuid.MustParse("66666666-6666-6666-6666-666666666666"),
+	}
+}
+func ValidRequestRefundCommand(ID, refundID uuid.UUID) transaction.RequestRefund {
+	return transaction.RequestRefund{
+		ID:       ID,
+		RefundID: refundID,
+		BankID:   uuid.MustParse("44444444-4444-4444-4444-444444444444"),
+		Amount:   40,
+		Reason:   "wrong amount",
+	}
+}
+func ValidConfirmRefundCommand(ID, refundID uuid.UUID) transaction.ConfirmRefund {
+	return transaction.ConfirmRefund{
+		ID:       ID,
+		RefundID: refundID,
+		BankID:   uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+	}
+}
+func ValidCompleteRefundCommand(ID, refundID uuid.UUID) transaction.CompleteRefund {
+	return transaction.CompleteRefund{
+		ID:       ID,
+		RefundID: refundID,
+		BankID:   uuid.MustParse("44444444-4444-4444-4444-444444444444"),
+	}
+}
+func ValidFailRefundCommand(ID, refundID uuid.UUID) transaction.FailRefund {
+	return transaction.FailRefund{
+		ID:       ID,
+		RefundID: refundID,
+		BankID:   uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+		Reason:   "account closed",
+	}
+}
 
 func StartedTransaction(ID uuid.UUID) *transaction.Aggregate {
 	ag := transaction.New(ID)
@@ -107,6 +146,27 @@ func StartedTransaction(ID uuid.UUID) *transaction.Aggregate {
 	ag.ApplyEvent(ctx, lastEvent)
 	return ag
 }
+func HeldTransaction(ID uuid.UUID) *transaction.Aggregate {
+	ag := transaction.New(ID)
+	cmd := ValidStartCommand(ag.EntityID())
+	cmd.Held = true
+	ctx := context.Background()
+
+	ag.HandleCommand(ctx, cmd)
+	lastEvent := ag.UncommittedEvents()[len(ag.UncommittedEvents())-1]
+	ag.ApplyEvent(ctx, lastEvent)
+	return ag
+}
+func HeldConfirmedTransaction(ID uuid.UUID) *transaction.Aggregate {
+	ag := HeldTransaction(ID)
+	cmd := ValidConfirmCommand(ag.EntityID())
+	ctx := context.Background()
+
+	ag.HandleCommand(ctx, cmd)
+	lastEvent := ag.UncommittedEvents()[len(ag.UncommittedEvents())-1]
+	ag.ApplyEvent(ctx, lastEvent)
+	return ag
+}
 func ConfirmedTransaction(ID uuid.UUID) *transaction.Aggregate {
 	ag := StartedTransaction(ID)
 	cmd := ValidConfirmCommand(ag.EntityID())
@@ -137,6 +197,46 @@ func FailedTransaction(ID uuid.UUID) *transaction.Aggregate {
 	ag.ApplyEvent(ctx, lastEvent)
 	return ag
 }
+func RefundRequestedTransaction(ID, refundID uuid.UUID) *transaction.Aggregate {
+	ag := CompletedTransaction(ID)
+	cmd := ValidRequestRefundCommand(ag.EntityID(), refundID)
+	ctx := context.Background()
+
+	ag.HandleCommand(ctx, cmd)
+	lastEvent := ag.UncommittedEvents()[len(ag.UncommittedEvents())-1]
+	ag.ApplyEvent(ctx, lastEvent)
+	return ag
+}
+func RefundConfirmedTransaction(ID, refundID uuid.UUID) *transaction.Aggregate {
+	ag := RefundRequestedTransaction(ID, refundID)
+	cmd := ValidConfirmRefundCommand(ag.EntityID(), refundID)
+	ctx := context.Background()
+
+	ag.HandleCommand(ctx, cmd)
+	lastEvent := ag.UncommittedEvents()[len(ag.UncommittedEvents())-1]
+	ag.ApplyEvent(ctx, lastEvent)
+	return ag
+}
+func RefundCompletedTransaction(ID, refundID uuid.UUID) *transaction.Aggregate {
+	ag := RefundConfirmedTransaction(ID, refundID)
+	cmd := ValidCompleteRefundCommand(ag.EntityID(), refundID)
+	ctx := context.Background()
+
+	ag.HandleCommand(ctx, cmd)
+	lastEvent := ag.UncommittedEvents()[len(ag.UncommittedEvents())-1]
+	ag.ApplyEvent(ctx, lastEvent)
+	return ag
+}
+func RefundFailedTransaction(ID, refundID uuid.UUID) *transaction.Aggregate {
+	ag := RefundConfirmedTransaction(ID, refundID)
+	cmd := ValidFailRefundCommand(ag.EntityID(), refundID)
+	ctx := context.Background()
+
+	ag.HandleCommand(ctx, cmd)
+	lastEvent := ag.UncommittedEvents()[len(ag.UncommittedEvents())-1]
+	ag.ApplyEvent(ctx, lastEvent)
+	return ag
+}
 
 type TearDown = func()
 
@@ -273,3 +373,9 @@ func InvalidFailRequest() *proto.FailRequest {
 		Reason: strings.Repeat("A", 101),
 	}
 }
+
+func InvalidRequestRefundRequest() *proto.RequestRefundRequest {
+	return &proto.RequestRefundRequest{
+		Reason: strings.Repeat("A", 101),
+	}
+}
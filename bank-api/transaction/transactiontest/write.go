@@ -8,13 +8,18 @@ import (
 	"codepix/bank-api/adapters/projectionclient"
 	"codepix/bank-api/adapters/validator"
 	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/charge/chargetest"
+	chargecommandhandler "codepix/bank-api/charge/write/commandhandler"
+	chargeaggregatestore "codepix/bank-api/charge/write/repository/aggregatestore"
 	"codepix/bank-api/pixkey/pixkeytest"
 	pixkeyrepository "codepix/bank-api/pixkey/repository"
 	pixkeydatabase "codepix/bank-api/pixkey/repository/database"
 	proto "codepix/bank-api/proto/codepix/transaction/write"
+	"codepix/bank-api/risk"
 	readrepository "codepix/bank-api/transaction/read/repository"
 	"codepix/bank-api/transaction/read/repository/projection"
 	"codepix/bank-api/transaction/write/commandhandler"
+	idempotencydatabase "codepix/bank-api/transaction/write/idempotency/repository/database"
 	"codepix/bank-api/transaction/write/service"
 	"codepix/bank-api/transaction/write/stream"
 	"context"
@@ -38,7 +43,11 @@ func CommandHandler() (eventhorizon.CommandHandler, *eventstore.EventStore, Tear
 	commandBus := eventhorizon.UseCommandHandlerMiddleware(commandBusHandler,
 		commandbus.Logger(bankapitest.Logger),
 	)
-	err = commandhandler.Setup(eventStore, commandBusHandler)
+	err = commandhandler.Setup(bankapitest.Config, eventStore, commandBusHandler)
+	if err != nil {
+		panic(err)
+	}
+	_, err = chargecommandhandler.Setup(eventStore, commandBusHandler)
 	if err != nil {
 		panic(err)
 	}
@@ -71,13 +80,17 @@ func WriteStream() (proto.StreamClient, readrepository.Repository,
 	}
 	err = database.AutoMigrate(
 		&pixkeydatabase.PixKey{},
+		&pixkeydatabase.PixKeyEvent{},
+		&idempotencydatabase.IdempotencyKey{},
 	)
 	if err != nil {
 		panic(err)
 	}
 	pixKeyRepo := &pixkeydatabase.Database{Database: database}
+	idempotencyRepo := &idempotencydatabase.Database{Database: database}
 
-	err = stream.Register(bankapitest.Logger, server, validator, commandHandler, pixKeyRepo)
+	err = stream.Register(bankapitest.Logger, server, bankapitest.Config, validator,
+		commandHandler, pixKeyRepo, idempotencyRepo, risk.Rules{})
 	if err != nil {
 		panic(err)
 	}
@@ -120,7 +133,8 @@ func WriteStream() (proto.StreamClient, readrepository.Repository,
 	return proto.NewStreamClient(client), readRepository, pixKeyRepo, creator, tearDown
 }
 
-func WriteStreamWithMocks() (proto.StreamClient, *MockCommandHandler, *pixkeytest.MockRepo) {
+func WriteStreamWithMocks() (proto.StreamClient, *MockCommandHandler, *pixkeytest.MockRepo,
+	*MockIdempotencyRepo) {
 	validator, err := validator.New()
 	if err != nil {
 		panic(err)
@@ -128,13 +142,15 @@ func WriteStreamWithMocks() (proto.StreamClient, *MockCommandHandler, *pixkeytes
 	server, client, serve := bankapitest.Server(validator)
 	commandHandler := new(MockCommandHandler)
 	pixKeyRepo := new(pixkeytest.MockRepo)
+	idempotencyRepo := new(MockIdempotencyRepo)
 
-	err = stream.Register(bankapitest.Logger, server, validator, commandHandler, pixKeyRepo)
+	err = stream.Register(bankapitest.Logger, server, bankapitest.Config, validator,
+		commandHandler, pixKeyRepo, idempotencyRepo, risk.Rules{})
 	if err != nil {
 		panic(err)
 	}
 	serve()
-	return proto.NewStreamClient(client), commandHandler, pixKeyRepo
+	return proto.NewStreamClient(client), commandHandler, pixKeyRepo, idempotencyRepo
 }
 
 func WriteService() (proto.ServiceClient, readrepository.Repository,
@@ -163,13 +179,22 @@ func WriteService() (proto.ServiceClient, readrepository.Repository,
 	}
 	err = database.AutoMigrate(
 		&pixkeydatabase.PixKey{},
+		&pixkeydatabase.PixKeyEvent{},
+		&idempotencydatabase.IdempotencyKey{},
 	)
 	if err != nil {
 		panic(err)
 	}
 	pixKeyRepo := &pixkeydatabase.Database{Database: database}
+	idempotencyRepo := &idempotencydatabase.Database{Database: database}
+	chargeStore, err := store.NewAggregateStore(0)
+	if err != nil {
+		panic(err)
+	}
+	chargeRepo := chargeaggregatestore.AggregateStore{Store: chargeStore}
 
-	err = service.Register(server, validator, commandHandler, pixKeyRepo)
+	err = service.Register(bankapitest.Logger, server, bankapitest.Config, validator,
+		commandHandler, pixKeyRepo, chargeRepo, idempotencyRepo, risk.Rules{})
 	if err != nil {
 		panic(err)
 	}
@@ -193,7 +218,8 @@ func WriteService() (proto.ServiceClient, readrepository.Repository,
 	return proto.NewServiceClient(client), readRepository, pixKeyRepo, creator, tearDown
 }
 
-func WriteServiceWithMocks() (proto.ServiceClient, *MockCommandHandler, *pixkeytest.MockRepo) {
+func WriteServiceWithMocks() (proto.ServiceClient, *MockCommandHandler, *pixkeytest.MockRepo,
+	*chargetest.MockRepo, *MockIdempotencyRepo) {
 	validator, err := validator.New()
 	if err != nil {
 		panic(err)
@@ -201,11 +227,14 @@ func WriteServiceWithMocks() (proto.ServiceClient, *MockCommandHandler, *pixkeyt
 	server, client, serve := bankapitest.Server(validator)
 	commandHandler := new(MockCommandHandler)
 	pixKeyRepo := new(pixkeytest.MockRepo)
+	chargeRepo := new(chargetest.MockRepo)
+	idempotencyRepo := new(MockIdempotencyRepo)
 
-	err = service.Register(server, validator, commandHandler, pixKeyRepo)
+	err = service.Register(bankapitest.Logger, server, bankapitest.Config, validator,
+		commandHandler, pixKeyRepo, chargeRepo, idempotencyRepo, risk.Rules{})
 	if err != nil {
 		panic(err)
 	}
 	serve()
-	return proto.NewServiceClient(client), commandHandler, pixKeyRepo
+	return proto.NewServiceClient(client), commandHandler, pixKeyRepo, chargeRepo, idempotencyRepo
 }
@@ -2,28 +2,38 @@ package commandhandler
 
 import (
 	"codepix/bank-api/adapters/eventstore"
+	"codepix/bank-api/config"
 	"codepix/bank-api/transaction"
 
 	"github.com/looplab/eventhorizon"
-	"github.com/looplab/eventhorizon/aggregatestore/events"
 	"github.com/looplab/eventhorizon/commandhandler/aggregate"
 	"github.com/looplab/eventhorizon/commandhandler/bus"
 )
 
-func Setup(eventStore *eventstore.EventStore, commandBus *bus.CommandHandler) error {
-	aggregateStore, err := events.NewAggregateStore(eventStore.Store)
+// Setup handles the transaction commands of the bus, through the middlewares if any.
+func Setup(config config.Config, eventStore *eventstore.EventStore,
+	commandBus *bus.CommandHandler, middlewares ...eventhorizon.CommandHandlerMiddleware,
+) error {
+	aggregateStore, err := eventStore.NewAggregateStore(config.Transaction.SnapshotInterval)
 	if err != nil {
 		return err
 	}
-	commandHandler, err := aggregate.NewCommandHandler(transaction.AggregateType, aggregateStore)
+	aggregateHandler, err := aggregate.NewCommandHandler(transaction.AggregateType, aggregateStore)
 	if err != nil {
 		return err
 	}
+	commandHandler := eventhorizon.UseCommandHandlerMiddleware(aggregateHandler, middlewares...)
 	commands := []eventhorizon.CommandType{
 		transaction.StartCommand,
 		transaction.ConfirmCommand,
 		transaction.CompleteCommand,
 		transaction.FailCommand,
+		transaction.TimeOutCommand,
+		transaction.ReleaseCommand,
+		transaction.RequestRefundCommand,
+		transaction.ConfirmRefundCommand,
+		transaction.CompleteRefundCommand,
+		transaction.FailRefundCommand,
 	}
 	for _, cmdType := range commands {
 		if err := commandBus.SetHandler(commandHandler, cmdType); err != nil {
@@ -0,0 +1,130 @@
+package idempotency
+
+import (
+	"codepix/bank-api/lib/repositories"
+	"codepix/bank-api/transaction/write/idempotency/repository"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+var ErrRequestMismatch = &repositories.ConflictError{
+	What:   "idempotency key",
+	Reason: "already used with a different request",
+}
+
+type Guard struct {
+	Logger         logr.Logger
+	Repository     repository.Repository
+	CommandHandler eventhorizon.CommandHandler
+	Retention      time.Duration
+}
+
+// Handle creates the command for a new aggregate ID and handles it, unless the key was
+// already used by the bank within the retention window. In that case, the original ID is
+// returned without handling anything again.
+func (g Guard) Handle(ctx context.Context, bankID uuid.UUID, key, requestHash string,
+	command func(ID uuid.UUID) (eventhorizon.Command, error),
+) (uuid.UUID, error) {
+	if key == "" {
+		ID := uuid.New()
+		cmd, err := command(ID)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return ID, g.CommandHandler.HandleCommand(ctx, cmd)
+	}
+	now := time.Now()
+
+	record, err := g.Repository.Find(bankID, key)
+	notFound := &repositories.NotFoundError{}
+	switch {
+	case err == nil && record.ExpiresAt.After(now):
+		return replay(record, requestHash)
+	case err == nil:
+		err := g.Repository.Delete(bankID, key)
+		if err != nil {
+			return uuid.Nil, err
+		}
+	case !errors.As(err, &notFound):
+		return uuid.Nil, err
+	}
+
+	ID := uuid.New()
+	cmd, err := command(ID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	err = g.Repository.Add(repository.Record{
+		BankID:      bankID,
+		Key:         key,
+		RequestHash: requestHash,
+		AggregateID: ID,
+		ExpiresAt:   now.Add(g.Retention),
+	})
+	alreadyExists := &repositories.AlreadyExistsError{}
+	if errors.As(err, &alreadyExists) {
+		record, err := g.Repository.Find(bankID, key)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return replay(record, requestHash)
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+	err = g.CommandHandler.HandleCommand(ctx, cmd)
+	if err != nil {
+		// a key left behind would replay an aggregate which was never created
+		deleteErr := g.Repository.Delete(bankID, key)
+		if deleteErr != nil {
+			g.Logger.Error(deleteErr, "fail: delete idempotency key", "key", key)
+		}
+		return uuid.Nil, err
+	}
+	return ID, nil
+}
+
+func replay(record *repository.Record, requestHash string) (uuid.UUID, error) {
+	if record.RequestHash != requestHash {
+		return uuid.Nil, ErrRequestMismatch
+	}
+	return record.AggregateID, nil
+}
+
+// Hash returns a digest of the fields that identify a request, so retries can be told
+// apart from a different request reusing the same key.
+func Hash(fields ...[]byte) string {
+	hash := sha256.New()
+	for _, field := range fields {
+		binary.Write(hash, binary.BigEndian, uint64(len(field)))
+		hash.Write(field)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// PurgeExpired deletes expired keys every interval until the context is done.
+func PurgeExpired(ctx context.Context, logger logr.Logger, repo repository.Repository,
+	interval time.Duration,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			err := repo.DeleteExpired(now)
+			if err != nil {
+				logger.Error(err, "fail: purge expired idempotency keys")
+			}
+		}
+	}
+}
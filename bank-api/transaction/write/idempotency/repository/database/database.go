@@ -0,0 +1,70 @@
+package database
+
+import (
+	"codepix/bank-api/adapters/databaseclient"
+	"codepix/bank-api/transaction/write/idempotency/repository"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Database struct {
+	*databaseclient.Database
+}
+
+var _ repository.Repository = Database{}
+
+func (db Database) Add(record repository.Record) error {
+	new := NewIdempotencyKey(record)
+	tx := db.Create(new)
+	return databaseclient.MapError(tx)
+}
+
+func (db Database) Find(bankID uuid.UUID, key string) (*repository.Record, error) {
+	var idempotencyKey IdempotencyKey
+	tx := db.First(&idempotencyKey, "bank_id = ? and key = ?", bankID, key)
+	return IdempotencyKeyFromDB(idempotencyKey), databaseclient.MapError(tx)
+}
+
+func (db Database) Delete(bankID uuid.UUID, key string) error {
+	tx := db.DB.Delete(&IdempotencyKey{}, "bank_id = ? and key = ?", bankID, key)
+	return databaseclient.MapError(tx)
+}
+
+func (db Database) DeleteExpired(before time.Time) error {
+	tx := db.DB.Delete(&IdempotencyKey{}, "expires_at < ?", before)
+	return databaseclient.MapError(tx)
+}
+
+type IdempotencyKey struct {
+	databaseclient.BaseModel
+	BankID      uuid.UUID `gorm:"<-:create;uniqueIndex:idx_idempotency_keys_bank_id_key"`
+	Key         string    `gorm:"<-:create;uniqueIndex:idx_idempotency_keys_bank_id_key"`
+	RequestHash string    `gorm:"<-:create;"`
+	AggregateID uuid.UUID `gorm:"<-:create;"`
+	ExpiresAt   time.Time `gorm:"<-:create;index"`
+}
+
+func NewIdempotencyKey(record repository.Record) *IdempotencyKey {
+	return &IdempotencyKey{
+		BaseModel:   databaseclient.NewBaseModel(),
+		BankID:      record.BankID,
+		Key:         record.Key,
+		RequestHash: record.RequestHash,
+		AggregateID: record.AggregateID,
+		ExpiresAt:   record.ExpiresAt,
+	}
+}
+
+func IdempotencyKeyFromDB(dbIdempotencyKey IdempotencyKey) *repository.Record {
+	if dbIdempotencyKey == (IdempotencyKey{}) {
+		return nil
+	}
+	return &repository.Record{
+		BankID:      dbIdempotencyKey.BankID,
+		Key:         dbIdempotencyKey.Key,
+		RequestHash: dbIdempotencyKey.RequestHash,
+		AggregateID: dbIdempotencyKey.AggregateID,
+		ExpiresAt:   dbIdempotencyKey.ExpiresAt,
+	}
+}
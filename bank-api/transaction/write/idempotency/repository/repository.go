@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Add(record Record) error
+	Find(bankID uuid.UUID, key string) (*Record, error)
+	Delete(bankID uuid.UUID, key string) error
+	DeleteExpired(before time.Time) error
+}
+
+type Record struct {
+	BankID      uuid.UUID
+	Key         string
+	RequestHash string
+	AggregateID uuid.UUID
+	ExpiresAt   time.Time
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/pixkey"
+	pixkeyrepository "codepix/bank-api/pixkey/repository"
+	proto "codepix/bank-api/proto/codepix/transaction/write"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"google.golang.org/grpc/status"
+)
+
+// StartBatch starts a transaction for every item, such as a payroll. Items fail on their
+// own, so the reply has the ID or the error of each item, in the same order as requested.
+func (s Service) StartBatch(ctx context.Context, req *proto.StartBatchRequest,
+) (*proto.BatchStarted, error) {
+	bankID := auth.GetBankID(ctx)
+	batchID := uuid.New()
+	receivers := s.resolveKeys(req.Items)
+
+	items := []*proto.StartReply{}
+	for _, item := range req.Items {
+		ID, err := s.startItem(ctx, bankID, batchID, item, receivers[item.ReceiverKey])
+		if err != nil {
+			items = append(items, &proto.StartReply{
+				Message: &proto.StartReply_Error{
+					Error: status.Convert(rpc.MapError(ctx, err)).Proto(),
+				},
+			})
+			continue
+		}
+		items = append(items, &proto.StartReply{
+			Message: &proto.StartReply_Started{
+				Started: &proto.Started{Id: ID[:]},
+			},
+		})
+	}
+	return &proto.BatchStarted{
+		BatchId: batchID[:],
+		Items:   items,
+	}, nil
+}
+
+type receiver struct {
+	IDs *pixkeyrepository.IDs
+	err error
+}
+
+// resolveKeys finds every distinct receiver key once, before any transaction is started.
+func (s Service) resolveKeys(items []*proto.StartRequest) map[pixkey.Key]receiver {
+	receivers := map[pixkey.Key]receiver{}
+	for _, item := range items {
+		if _, resolved := receivers[item.ReceiverKey]; resolved {
+			continue
+		}
+		_, IDs, err := s.PixKeyRepository.FindByKey(item.ReceiverKey)
+		receivers[item.ReceiverKey] = receiver{IDs, err}
+	}
+	return receivers
+}
+
+func (s Service) startItem(ctx context.Context, bankID, batchID uuid.UUID,
+	item *proto.StartRequest, receiver receiver,
+) (uuid.UUID, error) {
+	senderID, _ := uuid.FromBytes(item.SenderId)
+	return s.Idempotency.Handle(ctx, bankID, item.IdempotencyKey, startHash(item),
+		func(ID uuid.UUID) (eventhorizon.Command, error) {
+			if receiver.err != nil {
+				return nil, receiver.err
+			}
+			start := startCommand(item, ID, bankID, senderID, *receiver.IDs)
+			start.BatchID = batchID
+			return s.Risk.Assess(ctx, start, item.ReceiverKey)
+		},
+	)
+}
@@ -0,0 +1,94 @@
+package service_test
+
+import (
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/lib/repositories"
+	pixkeyrepository "codepix/bank-api/pixkey/repository"
+	proto "codepix/bank-api/proto/codepix/transaction/write"
+	"codepix/bank-api/transaction"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestStartBatch(t *testing.T) {
+	type command = transaction.Start
+
+	client, commandHandler, pixKeyRepo, _, _ := ServiceWithMocks()
+
+	pixKey := ValidPixKey()
+	receiverIDs := &pixkeyrepository.IDs{
+		PixKeyID:  uuid.New(),
+		AccountID: uuid.New(),
+		BankID:    uuid.New(),
+	}
+	bankID := uuid.New()
+	ctx := AuthenticatedContext(context.Background(), bankID)
+
+	paid := ValidStartRequest()
+	rejected := ValidStartRequest()
+	rejected.ReceiverKey = paid.ReceiverKey
+	rejected.Amount = paid.Amount + 1
+	missing := ValidStartRequest()
+	missing.ReceiverKey = "missing@example.com"
+
+	t.Run("0_per item results", func(t *testing.T) {
+		// the receiver key shared by two items is only looked up once
+		pixKeyRepo.On("FindByKey", paid.ReceiverKey).
+			Return(&pixKey, receiverIDs, nil).Once()
+		pixKeyRepo.On("FindByKey", missing.ReceiverKey).
+			Return(nil, nil, &repositories.NotFoundError{}).Once()
+
+		var batchIDs []uuid.UUID
+		started := func(amount transaction.Amount) any {
+			return mock.MatchedBy(func(cmd command) bool {
+				return cmd.Amount == amount
+			})
+		}
+		commandHandler.On("HandleCommand", mock.Anything, started(paid.Amount)).
+			Run(func(args mock.Arguments) {
+				batchIDs = append(batchIDs, args.Get(1).(command).BatchID)
+			}).
+			Return(nil).Once()
+		commandHandler.On("HandleCommand", mock.Anything, started(rejected.Amount)).
+			Run(func(args mock.Arguments) {
+				batchIDs = append(batchIDs, args.Get(1).(command).BatchID)
+			}).
+			Return(&aggregates.InvariantViolation{&aggregates.PermissionError{}}).Once()
+
+		reply, err := client.StartBatch(ctx, &proto.StartBatchRequest{
+			Items: []*proto.StartRequest{paid, missing, rejected},
+		})
+		require.NoError(t, err)
+		require.Len(t, reply.Items, 3)
+
+		assert.NotEmpty(t, reply.Items[0].GetStarted().GetId())
+		assert.Equal(t, int32(codes.NotFound), reply.Items[1].GetError().GetCode())
+		assert.Equal(t, int32(codes.PermissionDenied), reply.Items[2].GetError().GetCode())
+
+		batchID, _ := uuid.FromBytes(reply.BatchId)
+		assert.Equal(t, []uuid.UUID{batchID, batchID}, batchIDs)
+	})
+	t.Run("1_invalid item", func(t *testing.T) {
+		ctxWithLocale := metadata.AppendToOutgoingContext(ctx, "locale", validator.EN_US)
+
+		_, err := client.StartBatch(ctxWithLocale, &proto.StartBatchRequest{
+			Items: []*proto.StartRequest{paid, InvalidStartRequest()},
+		})
+		status, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument.String(), status.Code().String())
+	})
+	t.Run("2_empty batch", func(t *testing.T) {
+		_, err := client.StartBatch(ctx, &proto.StartBatchRequest{})
+		status, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument.String(), status.Code().String())
+	})
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"codepix/bank-api/brcode"
+	proto "codepix/bank-api/proto/codepix/transaction/write"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StartBRCode decodes the payload and pays it with a Start to its key, or with a
+// StartCharge to its charge if it is dynamic.
+func (s Service) StartBRCode(ctx context.Context, req *proto.StartBRCodeRequest,
+) (*proto.Started, error) {
+	payload, err := brcode.Decode(req.Payload)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	amount := payload.Amount
+	if amount == 0 {
+		amount = req.Amount
+	}
+	if amount == 0 || (req.Amount != 0 && req.Amount != amount) {
+		return nil, status.Error(codes.InvalidArgument, "amount does not match the payload")
+	}
+	description := req.Description
+	if description == "" {
+		description = payload.Description
+	}
+
+	if !payload.Dynamic() {
+		return s.Start(ctx, &proto.StartRequest{
+			SenderId:       req.SenderId,
+			ReceiverKey:    payload.Key,
+			Amount:         amount,
+			Description:    description,
+			IdempotencyKey: req.IdempotencyKey,
+		})
+	}
+	chargeID, ok := brcode.ChargeID(s.ChargeLocation, payload.Location)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "charge located elsewhere")
+	}
+	return s.StartCharge(ctx, &proto.StartChargeRequest{
+		SenderId:       req.SenderId,
+		ChargeId:       chargeID[:],
+		Amount:         amount,
+		Description:    description,
+		IdempotencyKey: req.IdempotencyKey,
+	})
+}
@@ -0,0 +1,114 @@
+package service_test
+
+import (
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/brcode"
+	"codepix/bank-api/charge"
+	pixkeyrepository "codepix/bank-api/pixkey/repository"
+	proto "codepix/bank-api/proto/codepix/transaction/write"
+	"codepix/bank-api/transaction"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStartBRCode(t *testing.T) {
+	client, commandHandler, pixKeyRepo, chargeRepo, _ := ServiceWithMocks()
+
+	senderID := uuid.New()
+	bankID := uuid.New()
+	ctx := AuthenticatedContext(context.Background(), bankID)
+
+	pixKey := ValidPixKey()
+	receiverIDs := &pixkeyrepository.IDs{
+		PixKeyID:  uuid.New(),
+		AccountID: uuid.New(),
+		BankID:    uuid.New(),
+	}
+	chargeID := uuid.New()
+	active := &charge.Charge{
+		Receiver:     uuid.New(),
+		ReceiverBank: uuid.New(),
+		Amount:       2500,
+		ExpiresAt:    time.Now().Add(time.Hour),
+		Status:       charge.Active,
+	}
+	encode := func(payload brcode.Payload) string {
+		text, err := payload.Encode()
+		require.NoError(t, err)
+		return text
+	}
+	location := brcode.ChargeLocation(bankapitest.Config.BRCode.ChargeLocation, chargeID)
+	withAmount := encode(brcode.Static(pixKey, 1050, "Lunch", "Maria Souza", "SAO PAULO"))
+	anyAmount := encode(brcode.Static(pixKey, 0, "", "Maria Souza", "SAO PAULO"))
+	ofCharge := encode(brcode.Dynamic(location, active.Amount, "Loja Exemplo", "RIO"))
+	elsewhere := encode(brcode.Dynamic("pix.elsewhere.com/qr/"+chargeID.String(),
+		active.Amount, "Loja Exemplo", "RIO"))
+
+	type started struct {
+		amount      transaction.Amount
+		description string
+		charge      bool
+	}
+	testCases := []struct {
+		description string
+		payload     string
+		amount      uint64
+		started     *started
+		code        codes.Code
+	}{
+		{"static with amount", withAmount, 0, &started{1050, "Lunch", false}, codes.OK},
+		{"static with the same amount", withAmount, 1050, &started{1050, "Lunch", false},
+			codes.OK},
+		{"static with any amount", anyAmount, 300, &started{300, "", false}, codes.OK},
+		{"static without amount", anyAmount, 0, nil, codes.InvalidArgument},
+		{"static with another amount", withAmount, 1000, nil, codes.InvalidArgument},
+		{"dynamic", ofCharge, 0, &started{active.Amount, "", true}, codes.OK},
+		{"dynamic located elsewhere", elsewhere, 0, nil, codes.InvalidArgument},
+		{"malformed", withAmount[:len(withAmount)-1], 0, nil, codes.InvalidArgument},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			if tc.started != nil && tc.started.charge {
+				chargeRepo.On("Find", mock.Anything, chargeID).Return(active, nil).Once()
+				commandHandler.On("HandleCommand", mock.Anything,
+					mock.AnythingOfType("charge.Reserve")).Return(nil).Once()
+			} else if tc.started != nil {
+				pixKeyRepo.On("FindByKey", pixKey.Key).Return(&pixKey, receiverIDs, nil).Once()
+			}
+			if tc.started != nil {
+				commandHandler.On("HandleCommand", mock.Anything,
+					mock.MatchedBy(func(cmd transaction.Start) bool {
+						return cmd.Sender == senderID &&
+							cmd.Amount == tc.started.amount &&
+							cmd.Description == tc.started.description &&
+							(cmd.ChargeID == chargeID) == tc.started.charge
+					})).Return(nil).Once()
+			}
+
+			reply, err := client.StartBRCode(ctx, &proto.StartBRCodeRequest{
+				SenderId: senderID[:],
+				Payload:  tc.payload,
+				Amount:   tc.amount,
+			})
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.code.String(), status.Code().String())
+			if tc.code == codes.OK {
+				require.NotNil(t, reply)
+				assert.NotNil(t, reply.Id)
+			}
+			commandHandler.AssertExpectations(t)
+			pixKeyRepo.AssertExpectations(t)
+			chargeRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/bank/auth"
+	"codepix/bank-api/charge"
+	proto "codepix/bank-api/proto/codepix/transaction/write"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/write/idempotency"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+)
+
+// StartCharge pays a charge by reference. The charge is reserved for the transaction
+// before it starts, which checks the amount, the expiry and the payer against it, and
+// makes sure it is paid once. The charge is released again if the transaction does not
+// start.
+func (s Service) StartCharge(ctx context.Context, req *proto.StartChargeRequest,
+) (*proto.Started, error) {
+	bankID := auth.GetBankID(ctx)
+	senderID, _ := uuid.FromBytes(req.SenderId)
+	chargeID, _ := uuid.FromBytes(req.ChargeId)
+
+	reserved := uuid.Nil
+	ID, err := s.Idempotency.Handle(ctx, bankID, req.IdempotencyKey, startChargeHash(req),
+		func(ID uuid.UUID) (eventhorizon.Command, error) {
+			c, err := s.ChargeRepository.Find(ctx, chargeID)
+			if err != nil {
+				return nil, err
+			}
+			start, err := s.Risk.Assess(ctx,
+				startChargeCommand(req, ID, bankID, senderID, chargeID, *c), "")
+			if err != nil {
+				return nil, err
+			}
+			err = s.CommandHandler.HandleCommand(ctx, charge.Reserve{
+				ID:            chargeID,
+				BankID:        bankID,
+				TransactionID: ID,
+				Payer:         senderID,
+				Amount:        req.Amount,
+			})
+			if err != nil {
+				return nil, err
+			}
+			reserved = ID
+			return start, nil
+		},
+	)
+	if reserved != uuid.Nil && (err != nil || ID != reserved) {
+		err = s.release(ctx, bankID, chargeID, reserved, err)
+	}
+	if err != nil {
+		return nil, rpc.MapError(ctx, err)
+	}
+	return startReply(ID), nil
+}
+
+// release frees a charge reserved for a transaction that did not start, keeping the
+// error of the request if any.
+func (s Service) release(ctx context.Context, bankID, chargeID, transactionID uuid.UUID,
+	err error,
+) error {
+	releaseErr := s.CommandHandler.HandleCommand(ctx, charge.Release{
+		ID:            chargeID,
+		BankID:        bankID,
+		TransactionID: transactionID,
+	})
+	switch {
+	case releaseErr == nil:
+		return err
+	case err == nil:
+		return fmt.Errorf("release charge: %w", releaseErr)
+	default:
+		return fmt.Errorf("%w (release charge: %v)", err, releaseErr)
+	}
+}
+
+func startChargeCommand(req *proto.StartChargeRequest, ID, bankID, senderID, chargeID uuid.UUID,
+	c charge.Charge) transaction.Start {
+	description := req.Description
+	if description == "" {
+		description = c.Description
+	}
+	return transaction.Start{
+		ID:           ID,
+		BankID:       bankID,
+		Sender:       senderID,
+		SenderBank:   bankID,
+		Receiver:     c.Receiver,
+		ReceiverBank: c.ReceiverBank,
+		Amount:       req.Amount,
+		Description:  description,
+		ChargeID:     chargeID,
+	}
+}
+func startChargeHash(req *proto.StartChargeRequest) string {
+	return idempotency.Hash(
+		req.SenderId,
+		req.ChargeId,
+		binary.BigEndian.AppendUint64(nil, req.Amount),
+		[]byte(req.Description),
+	)
+}
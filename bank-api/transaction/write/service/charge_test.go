@@ -0,0 +1,110 @@
+package service_test
+
+import (
+	"codepix/bank-api/charge"
+	"codepix/bank-api/lib/aggregates"
+	"codepix/bank-api/lib/repositories"
+	proto "codepix/bank-api/proto/codepix/transaction/write"
+	"codepix/bank-api/transaction"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStartCharge(t *testing.T) {
+	client, commandHandler, _, chargeRepo, _ := ServiceWithMocks()
+
+	senderID := uuid.New()
+	bankID := uuid.New()
+	ctx := AuthenticatedContext(context.Background(), bankID)
+
+	chargeID := uuid.New()
+	active := &charge.Charge{
+		Receiver:     uuid.New(),
+		ReceiverBank: uuid.New(),
+		Amount:       100,
+		ExpiresAt:    time.Now().Add(time.Hour),
+		TxID:         "abcdefghijklmnopqrstuvwxyz",
+		Description:  "invoice",
+		Status:       charge.Active,
+	}
+	request := &proto.StartChargeRequest{
+		SenderId: senderID[:],
+		ChargeId: chargeID[:],
+		Amount:   100,
+	}
+
+	var reservedBy uuid.UUID
+	reserve := mock.MatchedBy(func(cmd charge.Reserve) bool {
+		reservedBy = cmd.TransactionID
+		return cmd.ID == chargeID && cmd.BankID == bankID && cmd.Payer == senderID &&
+			cmd.Amount == request.Amount
+	})
+	start := mock.MatchedBy(func(cmd transaction.Start) bool {
+		return cmd.ID == reservedBy && cmd.ChargeID == chargeID &&
+			cmd.Receiver == active.Receiver && cmd.ReceiverBank == active.ReceiverBank &&
+			cmd.Description == active.Description
+	})
+	release := mock.MatchedBy(func(cmd charge.Release) bool {
+		return cmd.ID == chargeID && cmd.BankID == bankID && cmd.TransactionID == reservedBy
+	})
+
+	testCases := []struct {
+		description string
+		findErr     error
+		reserveErr  error
+		startErr    error
+		released    bool
+		code        codes.Code
+	}{
+		{"valid", nil, nil, nil, false, codes.OK},
+		{"charge not found", &repositories.NotFoundError{}, nil, nil, false, codes.NotFound},
+		{"amount mismatch", nil,
+			&aggregates.InvariantViolation{charge.ErrAmountMismatch}, nil,
+			false, codes.FailedPrecondition},
+		{"already reserved", nil,
+			&aggregates.InvariantViolation{charge.ErrCannotReserveIfNotActive}, nil,
+			false, codes.Aborted},
+		{"start fails", nil, nil,
+			&aggregates.InvariantViolation{transaction.ErrCannotStartIfNotTheSender},
+			true, codes.PermissionDenied},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+			if tc.findErr != nil {
+				chargeRepo.On("Find", mock.Anything, chargeID).Return(nil, tc.findErr).Once()
+			} else {
+				chargeRepo.On("Find", mock.Anything, chargeID).Return(active, nil).Once()
+				commandHandler.On("HandleCommand", mock.Anything, reserve).
+					Return(tc.reserveErr).Once()
+			}
+			if tc.findErr == nil && tc.reserveErr == nil {
+				commandHandler.On("HandleCommand", mock.Anything, start).
+					Return(tc.startErr).Once()
+			}
+			if tc.released {
+				commandHandler.On("HandleCommand", mock.Anything, release).
+					Return(nil).Once()
+			}
+
+			reply, err := client.StartCharge(ctx, request)
+
+			status, _ := status.FromError(err)
+			assert.Equal(t, tc.code.String(), status.Code().String())
+			if tc.code == codes.OK {
+				require.NotNil(t, reply)
+				assert.Equal(t, reservedBy[:], reply.Id)
+			}
+			commandHandler.AssertExpectations(t)
+			chargeRepo.AssertExpectations(t)
+		})
+	}
+}
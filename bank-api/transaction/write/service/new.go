@@ -3,21 +3,34 @@ package service
 import (
 	"bytes"
 	"codepix/bank-api/adapters/validator"
+	chargerepository "codepix/bank-api/charge/write/repository"
+	"codepix/bank-api/config"
 	"codepix/bank-api/lib/validation"
 	pixkeyrepository "codepix/bank-api/pixkey/repository"
 	proto "codepix/bank-api/proto/codepix/transaction/write"
+	"codepix/bank-api/risk"
 	"codepix/bank-api/transaction/write"
+	"codepix/bank-api/transaction/write/idempotency"
+	idempotencyrepository "codepix/bank-api/transaction/write/idempotency/repository"
 
+	"github.com/go-logr/logr"
 	"github.com/looplab/eventhorizon"
 	"google.golang.org/grpc"
 )
 
-func Register(server *grpc.Server, val *validation.Validator,
+func Register(logger logr.Logger, server *grpc.Server, config config.Config, val *validation.Validator,
 	commandHandler eventhorizon.CommandHandler, pixKeyRepository pixkeyrepository.Repository,
+	chargeRepository chargerepository.Repository,
+	idempotencyRepository idempotencyrepository.Repository, assessor risk.Assessor,
 ) error {
 	err := validator.LoadTranslationFile(val, bytes.NewReader(write.Translations),
 		proto.StartRequest{},
+		proto.StartBatchRequest{},
+		proto.StartChargeRequest{},
+		proto.StartBRCodeRequest{},
 		proto.FailRequest{},
+		proto.RequestRefundRequest{},
+		proto.FailRefundRequest{},
 	)
 	if err != nil {
 		return err
@@ -25,6 +38,15 @@ func Register(server *grpc.Server, val *validation.Validator,
 	service := &Service{
 		CommandHandler:   commandHandler,
 		PixKeyRepository: pixKeyRepository,
+		ChargeRepository: chargeRepository,
+		ChargeLocation:   config.BRCode.ChargeLocation,
+		Idempotency: idempotency.Guard{
+			Logger:         logger.WithName("idempotency"),
+			Repository:     idempotencyRepository,
+			CommandHandler: commandHandler,
+			Retention:      config.Transaction.IdempotencyRetention,
+		},
+		Risk: assessor,
 	}
 	proto.RegisterServiceServer(server, service)
 	return nil
@@ -3,10 +3,14 @@ package service
 import (
 	"codepix/bank-api/adapters/rpc"
 	"codepix/bank-api/bank/auth"
+	chargerepository "codepix/bank-api/charge/write/repository"
 	pixkeyrepository "codepix/bank-api/pixkey/repository"
 	proto "codepix/bank-api/proto/codepix/transaction/write"
+	"codepix/bank-api/risk"
 	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/write/idempotency"
 	"context"
+	"encoding/binary"
 
 	"github.com/google/uuid"
 	"github.com/looplab/eventhorizon"
@@ -15,22 +19,33 @@ import (
 type Service struct {
 	CommandHandler   eventhorizon.CommandHandler
 	PixKeyRepository pixkeyrepository.Repository
+	ChargeRepository chargerepository.Repository
+	ChargeLocation   string
+	Idempotency      idempotency.Guard
+	Risk             risk.Assessor
 	proto.UnimplementedServiceServer
 }
 
 var _ proto.ServiceServer = Service{}
 
 func (s Service) Start(ctx context.Context, req *proto.StartRequest) (*proto.Started, error) {
-	ID := uuid.New()
 	bankID := auth.GetBankID(ctx)
 	senderID, _ := uuid.FromBytes(req.SenderId)
-	_, receiverIDs, err := s.PixKeyRepository.FindByKey(req.ReceiverKey)
+
+	ID, err := s.Idempotency.Handle(ctx, bankID, req.IdempotencyKey, startHash(req),
+		func(ID uuid.UUID) (eventhorizon.Command, error) {
+			_, receiverIDs, err := s.PixKeyRepository.FindByKey(req.ReceiverKey)
+			if err != nil {
+				return nil, err
+			}
+			start := startCommand(req, ID, bankID, senderID, *receiverIDs)
+			return s.Risk.Assess(ctx, start, req.ReceiverKey)
+		},
+	)
 	if err != nil {
 		return nil, rpc.MapError(ctx, err)
 	}
-	command := startCommand(req, ID, bankID, senderID, *receiverIDs)
-	err = s.CommandHandler.HandleCommand(ctx, command)
-	return startReply(ID), rpc.MapError(ctx, err)
+	return startReply(ID), nil
 }
 
 func startCommand(req *proto.StartRequest, ID, bankID, senderID uuid.UUID,
@@ -46,6 +61,14 @@ func startCommand(req *proto.StartRequest, ID, bankID, senderID uuid.UUID,
 		Description:  req.Description,
 	}
 }
+func startHash(req *proto.StartRequest) string {
+	return idempotency.Hash(
+		req.SenderId,
+		[]byte(req.ReceiverKey),
+		binary.BigEndian.AppendUint64(nil, req.Amount),
+		[]byte(req.Description),
+	)
+}
 func startReply(ID uuid.UUID) *proto.Started {
 	return &proto.Started{
 		Id: ID[:],
@@ -6,12 +6,15 @@ import (
 	"codepix/bank-api/bankapitest"
 	"codepix/bank-api/lib/aggregates"
 	"codepix/bank-api/lib/repositories"
+	"codepix/bank-api/limit"
 	"codepix/bank-api/pixkey/pixkeytest"
 	pixkeyrepository "codepix/bank-api/pixkey/repository"
 	proto "codepix/bank-api/proto/codepix/transaction/write"
+	"codepix/bank-api/reserve"
 	"codepix/bank-api/transaction"
 	"codepix/bank-api/transaction/read/repository"
 	"codepix/bank-api/transaction/transactiontest"
+	idempotencyrepository "codepix/bank-api/transaction/write/idempotency/repository"
 	"context"
 	"errors"
 	"fmt"
@@ -23,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -66,7 +70,7 @@ func TestStart(t *testing.T) {
 		out         out
 	}
 
-	client, commandHandler, pixKeyRepo := ServiceWithMocks()
+	client, commandHandler, pixKeyRepo, _, _ := ServiceWithMocks()
 
 	pixKey := ValidPixKey()
 	receiver := &pixKey
@@ -172,6 +176,45 @@ func TestStart(t *testing.T) {
 				status.New(codes.PermissionDenied, ""),
 			},
 		},
+		{
+			"insufficient reserve",
+			in{
+				ctx,
+				validRequest,
+				validCommand,
+			},
+			out{
+				&findReceiver{receiver, receiverIDs, nil},
+				&aggregates.InvariantViolation{reserve.ErrInsufficientReserve},
+				status.New(codes.FailedPrecondition, "amount error: insufficient reserve"),
+			},
+		},
+		{
+			"limit exceeded",
+			in{
+				ctx,
+				validRequest,
+				validCommand,
+			},
+			out{
+				&findReceiver{receiver, receiverIDs, nil},
+				&aggregates.InvariantViolation{&aggregates.LimitError{
+					Limit: limit.DailyLimit, Maximum: 1000, Used: 900, Amount: 200,
+				}},
+				withDetails(status.New(codes.ResourceExhausted,
+					"limit error: daily limit of 1000 exceeded"),
+					&errdetails.ErrorInfo{
+						Reason: "LIMIT_EXCEEDED",
+						Domain: "codepix",
+						Metadata: map[string]string{
+							"limit":   "daily",
+							"maximum": "1000",
+							"used":    "900",
+							"amount":  "200",
+						},
+					}),
+			},
+		},
 		{
 			"unauthenticated",
 			in{
@@ -240,6 +283,118 @@ func TestStart(t *testing.T) {
 	}
 }
 
+func TestStartIdempotency(t *testing.T) {
+	type command = transaction.Start
+
+	client, commandHandler, pixKeyRepo, _, idempotencyRepo := ServiceWithMocks()
+
+	pixKey := ValidPixKey()
+	receiverIDs := &pixkeyrepository.IDs{
+		PixKeyID:  uuid.New(),
+		AccountID: uuid.New(),
+		BankID:    uuid.New(),
+	}
+	bankID := uuid.New()
+	ctx := AuthenticatedContext(context.Background(), bankID)
+
+	request := ValidStartRequest()
+	request.IdempotencyKey = uuid.NewString()
+
+	var record idempotencyrepository.Record
+
+	t.Run("0_first request", func(t *testing.T) {
+		idempotencyRepo.On("Find", bankID, request.IdempotencyKey).
+			Return(nil, &repositories.NotFoundError{}).Once()
+		pixKeyRepo.On("FindByKey", request.ReceiverKey).
+			Return(&pixKey, receiverIDs, nil).Once()
+		idempotencyRepo.On("Add", mock.IsType(record)).
+			Run(func(args mock.Arguments) { record = args.Get(0).(idempotencyrepository.Record) }).
+			Return(nil).Once()
+		commandHandler.On("HandleCommand", mock.Anything, mock.IsType(command{})).
+			Return(nil).Once()
+
+		reply, err := client.Start(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, record.AggregateID[:], reply.Id)
+		assert.Equal(t, bankID, record.BankID)
+		assert.Equal(t, request.IdempotencyKey, record.Key)
+		assert.True(t, record.ExpiresAt.After(time.Now()))
+	})
+	t.Run("1_retried request", func(t *testing.T) {
+		idempotencyRepo.On("Find", bankID, request.IdempotencyKey).
+			Return(&record, nil).Once()
+
+		reply, err := client.Start(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, record.AggregateID[:], reply.Id)
+	})
+	t.Run("2_different request with the same key", func(t *testing.T) {
+		idempotencyRepo.On("Find", bankID, request.IdempotencyKey).
+			Return(&record, nil).Once()
+
+		different := ValidStartRequest()
+		different.IdempotencyKey = request.IdempotencyKey
+		different.Amount = request.Amount + 1
+
+		_, err := client.Start(ctx, different)
+		status, _ := status.FromError(err)
+		assert.Equal(t, codes.AlreadyExists.String(), status.Code().String())
+	})
+	t.Run("3_expired key", func(t *testing.T) {
+		expired := record
+		expired.ExpiresAt = time.Now().Add(-time.Second)
+
+		idempotencyRepo.On("Find", bankID, request.IdempotencyKey).
+			Return(&expired, nil).Once()
+		idempotencyRepo.On("Delete", bankID, request.IdempotencyKey).
+			Return(nil).Once()
+		pixKeyRepo.On("FindByKey", request.ReceiverKey).
+			Return(&pixKey, receiverIDs, nil).Once()
+		idempotencyRepo.On("Add", mock.IsType(record)).
+			Return(nil).Once()
+		commandHandler.On("HandleCommand", mock.Anything, mock.IsType(command{})).
+			Return(nil).Once()
+
+		reply, err := client.Start(ctx, request)
+		require.NoError(t, err)
+		assert.NotEqual(t, record.AggregateID[:], reply.Id)
+	})
+	t.Run("4_failed command releases the key", func(t *testing.T) {
+		idempotencyRepo.On("Find", bankID, request.IdempotencyKey).
+			Return(nil, &repositories.NotFoundError{}).Once()
+		pixKeyRepo.On("FindByKey", request.ReceiverKey).
+			Return(&pixKey, receiverIDs, nil).Once()
+		idempotencyRepo.On("Add", mock.IsType(record)).
+			Return(nil).Once()
+		commandHandler.On("HandleCommand", mock.Anything, mock.IsType(command{})).
+			Return(errors.New("some error")).Once()
+		idempotencyRepo.On("Delete", bankID, request.IdempotencyKey).
+			Return(nil).Once()
+
+		_, err := client.Start(ctx, request)
+		status, _ := status.FromError(err)
+		assert.Equal(t, codes.Unknown.String(), status.Code().String())
+	})
+	t.Run("5_failed key release keeps the command status", func(t *testing.T) {
+		idempotencyRepo.On("Find", bankID, request.IdempotencyKey).
+			Return(nil, &repositories.NotFoundError{}).Once()
+		pixKeyRepo.On("FindByKey", request.ReceiverKey).
+			Return(&pixKey, receiverIDs, nil).Once()
+		idempotencyRepo.On("Add", mock.IsType(record)).
+			Return(nil).Once()
+		commandHandler.On("HandleCommand", mock.Anything, mock.IsType(command{})).
+			Return(&repositories.NotFoundError{What: "account"}).Once()
+		idempotencyRepo.On("Delete", bankID, request.IdempotencyKey).
+			Return(errors.New("some error")).Once()
+
+		_, err := client.Start(ctx, request)
+		status, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound.String(), status.Code().String())
+	})
+	idempotencyRepo.AssertExpectations(t)
+	commandHandler.AssertExpectations(t)
+}
+
 func TestStartIntegration(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -411,3 +566,11 @@ func TestStartIntegration(t *testing.T) {
 		})
 	}
 }
+
+func withDetails(s *status.Status, info *errdetails.ErrorInfo) *status.Status {
+	s, err := s.WithDetails(info)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
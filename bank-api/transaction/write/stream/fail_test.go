@@ -52,11 +52,13 @@ func Fail(client proto.StreamClient, commandHandler *transactiontest.MockCommand
 		ID := uuid.New()
 		bankID := uuid.New()
 		validRequest := &request{
-			Id: ID[:],
+			Id:   ID[:],
+			Code: proto.FailureCode_InsufficientFunds,
 		}
 		validCommand := &command{
 			ID:     ID,
 			BankID: bankID,
+			Code:   transaction.FailureInsufficientFunds,
 		}
 
 		ctx := AuthenticatedContext(context.Background(), bankID)
@@ -90,6 +92,7 @@ func Fail(client proto.StreamClient, commandHandler *transactiontest.MockCommand
 							WithDetails(rpc.ValidationErrorMessage(map[string]string{
 								"id":     "id is a required field",
 								"reason": "Reason must be a maximum of 100 characters in length",
+								"code":   "Failure code is a required field",
 							}))
 						return status
 					}(),
@@ -220,7 +223,8 @@ func FailIntegration(client proto.StreamClient, repo repository.Repository,
 		validRequest := func() (context.Context, *request) {
 			ID, senderIDs, _ := creator.ConfirmedIDs()
 			request := &request{
-				Id: ID[:],
+				Id:   ID[:],
+				Code: proto.FailureCode_InsufficientFunds,
 			}
 			ctx := AuthenticatedContext(context.Background(), senderIDs.BankID)
 			return ctx, request
@@ -234,14 +238,16 @@ func FailIntegration(client proto.StreamClient, repo repository.Repository,
 		unauthenticatedRequest := func() (context.Context, *request) {
 			ID := uuid.New()
 			request := &request{
-				Id: ID[:],
+				Id:   ID[:],
+				Code: proto.FailureCode_InsufficientFunds,
 			}
 			return context.Background(), request
 		}
 		permissionDeniedRequest := func() (context.Context, *request) {
 			ID, _, _ := creator.ConfirmedIDs()
 			request := &request{
-				Id: ID[:],
+				Id:   ID[:],
+				Code: proto.FailureCode_InsufficientFunds,
 			}
 			ctx := AuthenticatedContext(context.Background(), uuid.New())
 			return ctx, request
@@ -263,6 +269,7 @@ func FailIntegration(client proto.StreamClient, repo repository.Repository,
 						WithDetails(rpc.ValidationErrorMessage(map[string]string{
 							"id":     "id is a required field",
 							"reason": "Reason must be a maximum of 100 characters in length",
+							"code":   "Failure code is a required field",
 						}))
 					return status
 				}(),
@@ -4,23 +4,34 @@ import (
 	"bytes"
 	"codepix/bank-api/adapters/eventbus"
 	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/config"
 	"codepix/bank-api/lib/validation"
 	pixkeyrepository "codepix/bank-api/pixkey/repository"
 	proto "codepix/bank-api/proto/codepix/transaction/write"
+	"codepix/bank-api/risk"
 	"codepix/bank-api/transaction"
 	"codepix/bank-api/transaction/write"
+	"codepix/bank-api/transaction/write/idempotency"
+	idempotencyrepository "codepix/bank-api/transaction/write/idempotency/repository"
 
 	"github.com/go-logr/logr"
 	"github.com/looplab/eventhorizon"
 	"google.golang.org/grpc"
 )
 
-func Register(logger logr.Logger, server *grpc.Server, val *validation.Validator,
-	commandHandler eventhorizon.CommandHandler, pixKeyRepository pixkeyrepository.Repository,
+func Register(logger logr.Logger, server *grpc.Server, config config.Config,
+	val *validation.Validator, commandHandler eventhorizon.CommandHandler,
+	pixKeyRepository pixkeyrepository.Repository, idempotencyRepository idempotencyrepository.Repository,
+	assessor risk.Assessor,
 ) error {
 	err := validator.LoadTranslationFile(val, bytes.NewReader(write.Translations),
 		proto.StartRequest{},
+		proto.StartBatchRequest{},
+		proto.StartChargeRequest{},
+		proto.StartBRCodeRequest{},
 		proto.FailRequest{},
+		proto.RequestRefundRequest{},
+		proto.FailRefundRequest{},
 	)
 	if err != nil {
 		return err
@@ -29,6 +40,14 @@ func Register(logger logr.Logger, server *grpc.Server, val *validation.Validator
 		Logger:           logger.WithName("commandstream"),
 		CommandHandler:   commandHandler,
 		PixKeyRepository: pixKeyRepository,
+		Idempotency: idempotency.Guard{
+			Logger:         logger.WithName("idempotency"),
+			Repository:     idempotencyRepository,
+			CommandHandler: commandHandler,
+			Retention:      config.Transaction.IdempotencyRetention,
+		},
+		Risk:      assessor,
+		Reviewers: config.Risk.Reviewers,
 	}
 	proto.RegisterStreamServer(server, stream)
 	return nil
@@ -72,5 +91,52 @@ func SetupWriters(eventBus *eventbus.EventBus) error {
 	if err != nil {
 		return err
 	}
+	err = eventBus.SetupWriter(transaction.ReleasedEvent, func(event eventhorizon.Event) []string {
+		released := event.Data().(*transaction.TransactionReleased)
+		return []string{
+			transaction.ReleasedStream(released.SenderBank),
+			transaction.ReleasedStream(released.ReceiverBank),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = eventBus.SetupWriter(transaction.RefundRequestedEvent, func(event eventhorizon.Event) []string {
+		requested := event.Data().(*transaction.TransactionRefundRequested)
+		return []string{
+			transaction.RefundRequestedStream(requested.SenderBank),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = eventBus.SetupWriter(transaction.RefundConfirmedEvent, func(event eventhorizon.Event) []string {
+		confirmed := event.Data().(*transaction.TransactionRefundConfirmed)
+		return []string{
+			transaction.RefundConfirmedStream(confirmed.ReceiverBank),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = eventBus.SetupWriter(transaction.RefundCompletedEvent, func(event eventhorizon.Event) []string {
+		completed := event.Data().(*transaction.TransactionRefundCompleted)
+		return []string{
+			transaction.RefundCompletedStream(completed.SenderBank),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = eventBus.SetupWriter(transaction.RefundFailedEvent, func(event eventhorizon.Event) []string {
+		failed := event.Data().(*transaction.TransactionRefundFailed)
+		return []string{
+			transaction.RefundFailedStream(failed.SenderBank),
+			transaction.RefundFailedStream(failed.ReceiverBank),
+		}
+	})
+	if err != nil {
+		return err
+	}
 	return nil
 }
@@ -0,0 +1,288 @@
+package stream_test
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/lib/aggregates"
+	proto "codepix/bank-api/proto/codepix/transaction/write"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/transactiontest"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/looplab/eventhorizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+var InvalidRequestRefundRequest = transactiontest.InvalidRequestRefundRequest
+
+var ExceptRefundID = cmp.FilterPath(func(p cmp.Path) bool {
+	return p.String() == "RefundID"
+}, cmp.Ignore())
+
+func RequestRefund(client proto.StreamClient, commandHandler *transactiontest.MockCommandHandler,
+) func(t *testing.T) {
+	return func(t *testing.T) {
+		type request = proto.RequestRefundRequest
+		type command = transaction.RequestRefund
+
+		type in struct {
+			ctx     context.Context
+			request *request
+			command *command
+		}
+		type out struct {
+			err    error
+			status *status.Status
+		}
+		type testCase struct {
+			description string
+			in          in
+			out         out
+		}
+
+		ID := uuid.New()
+		bankID := uuid.New()
+		validRequest := &request{
+			Id:     ID[:],
+			Amount: 40,
+			Reason: "wrong amount",
+		}
+		validCommand := &command{
+			ID:     ID,
+			BankID: bankID,
+			Amount: validRequest.Amount,
+			Reason: validRequest.Reason,
+		}
+
+		ctx := AuthenticatedContext(context.Background(), bankID)
+		ctxWithLocale := metadata.AppendToOutgoingContext(ctx, "locale", validator.EN_US)
+
+		testCases := []testCase{
+			{
+				"valid",
+				in{
+					ctx,
+					validRequest,
+					validCommand,
+				},
+				out{
+					nil,
+					status.New(codes.OK, ""),
+				},
+			},
+			{
+				"invalid",
+				in{
+					ctxWithLocale,
+					InvalidRequestRefundRequest(),
+					nil,
+				},
+				out{
+					nil,
+					func() *status.Status {
+						status, _ := status.New(codes.InvalidArgument,
+							"validation failed on id (required)").
+							WithDetails(rpc.ValidationErrorMessage(map[string]string{
+								"id":     "id is a required field",
+								"amount": "Amount is a required field",
+								"reason": "Reason must be a maximum of 100 characters in length",
+							}))
+						return status
+					}(),
+				},
+			},
+			{
+				"aggregate invariant violation: status mismatch",
+				in{
+					ctx,
+					validRequest,
+					validCommand,
+				},
+				out{
+					&aggregates.InvariantViolation{&aggregates.StatusMismatchError{}},
+					status.New(codes.Aborted, ""),
+				},
+			},
+			{
+				"aggregate invariant violation: permission error",
+				in{
+					ctx,
+					validRequest,
+					validCommand,
+				},
+				out{
+					&aggregates.InvariantViolation{&aggregates.PermissionError{}},
+					status.New(codes.PermissionDenied, ""),
+				},
+			},
+			{
+				"aggregate invariant violation: amount error",
+				in{
+					ctx,
+					validRequest,
+					validCommand,
+				},
+				out{
+					&aggregates.InvariantViolation{&aggregates.AmountError{}},
+					status.New(codes.FailedPrecondition, ""),
+				},
+			},
+			{
+				"unauthenticated",
+				in{
+					context.Background(),
+					validRequest,
+					nil,
+				},
+				out{
+					nil,
+					status.New(codes.Unauthenticated, ""),
+				},
+			},
+			{
+				"handle command internal error",
+				in{
+					ctx,
+					validRequest,
+					validCommand,
+				},
+				out{
+					errors.New("some error"),
+					status.New(codes.Unknown, ""),
+				},
+			},
+		}
+		for i, tc := range testCases {
+			t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+				var handled command
+				if tc.in.command != nil {
+					commandHandler.On("HandleCommand", mock.IsType(tc.in.ctx),
+						mock.MatchedBy(func(cmd command) bool {
+							return cmp.Equal(*tc.in.command, cmd, ExceptRefundID)
+						})).
+						Run(func(args mock.Arguments) { handled = args.Get(1).(command) }).
+						Return(tc.out.err).Once()
+				}
+
+				stream, err := client.RequestRefund(tc.in.ctx)
+				require.NoError(t, err)
+				err = stream.Send(tc.in.request)
+				require.NoError(t, err)
+
+				reply, err := stream.Recv()
+				if err != nil {
+					status, _ := status.FromError(err)
+					assert.Equal(t, tc.out.status.Code().String(), status.Code().String())
+				} else {
+					if tc.out.status.Code() == codes.OK {
+						require.NotNil(t, reply)
+						require.NotNil(t, reply.GetRefundRequested())
+						assert.Equal(t, handled.RefundID[:], reply.GetRefundRequested().RefundId)
+					} else {
+						status := reply.GetError()
+						assert.Equal(t, tc.out.status.Code().String(), codes.Code(status.Code).String())
+					}
+				}
+				if tc.out.status.Message() != "" {
+					status := reply.GetError()
+					assert.Empty(t, cmp.Diff(tc.out.status.Proto(), status, protocmp.Transform()))
+				}
+			})
+		}
+	}
+}
+
+func RefundTransitions(client proto.StreamClient, commandHandler *transactiontest.MockCommandHandler,
+) func(t *testing.T) {
+	return func(t *testing.T) {
+		type send = func(ctx context.Context, ID, refundID uuid.UUID) (*status.Status, error)
+
+		ID, refundID := uuid.New(), uuid.New()
+		bankID := uuid.New()
+		ctx := AuthenticatedContext(context.Background(), bankID)
+
+		confirm := func(ctx context.Context, ID, refundID uuid.UUID) (*status.Status, error) {
+			stream, err := client.ConfirmRefund(ctx)
+			require.NoError(t, err)
+			err = stream.Send(&proto.ConfirmRefundRequest{Id: ID[:], RefundId: refundID[:]})
+			require.NoError(t, err)
+			reply, err := stream.Recv()
+			if err != nil || reply.GetRefundConfirmed() != nil {
+				return nil, err
+			}
+			return status.FromProto(reply.GetError()), nil
+		}
+		complete := func(ctx context.Context, ID, refundID uuid.UUID) (*status.Status, error) {
+			stream, err := client.CompleteRefund(ctx)
+			require.NoError(t, err)
+			err = stream.Send(&proto.CompleteRefundRequest{Id: ID[:], RefundId: refundID[:]})
+			require.NoError(t, err)
+			reply, err := stream.Recv()
+			if err != nil || reply.GetRefundCompleted() != nil {
+				return nil, err
+			}
+			return status.FromProto(reply.GetError()), nil
+		}
+		fail := func(ctx context.Context, ID, refundID uuid.UUID) (*status.Status, error) {
+			stream, err := client.FailRefund(ctx)
+			require.NoError(t, err)
+			err = stream.Send(&proto.FailRefundRequest{Id: ID[:], RefundId: refundID[:], Reason: "account closed"})
+			require.NoError(t, err)
+			reply, err := stream.Recv()
+			if err != nil || reply.GetRefundFailed() != nil {
+				return nil, err
+			}
+			return status.FromProto(reply.GetError()), nil
+		}
+
+		testCases := []struct {
+			description string
+			send        send
+			command     eventhorizon.Command
+			err         error
+			code        codes.Code
+		}{
+			{"confirm", confirm,
+				transaction.ConfirmRefund{ID, refundID, bankID}, nil, codes.OK},
+			{"confirm: status mismatch", confirm,
+				transaction.ConfirmRefund{ID, refundID, bankID},
+				&aggregates.InvariantViolation{transaction.ErrCannotConfirmRefundIfNotRequested}, codes.Aborted},
+			{"complete", complete,
+				transaction.CompleteRefund{ID, refundID, bankID}, nil, codes.OK},
+			{"complete: permission error", complete,
+				transaction.CompleteRefund{ID, refundID, bankID},
+				&aggregates.InvariantViolation{transaction.ErrCannotCompleteRefundIfNotTheReceiver}, codes.PermissionDenied},
+			{"fail", fail,
+				transaction.FailRefund{ID, refundID, bankID, "account closed"}, nil, codes.OK},
+			{"fail: status mismatch", fail,
+				transaction.FailRefund{ID, refundID, bankID, "account closed"},
+				&aggregates.InvariantViolation{transaction.ErrCannotFailRefundIfNotRequestedOrConfirmed}, codes.Aborted},
+		}
+		for i, tc := range testCases {
+			t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+				commandHandler.On("HandleCommand", mock.IsType(ctx), tc.command).
+					Return(tc.err).Once()
+
+				status, err := tc.send(ctx, ID, refundID)
+				require.NoError(t, err)
+				if tc.code == codes.OK {
+					assert.Nil(t, status)
+				} else {
+					require.NotNil(t, status)
+					assert.Equal(t, tc.code.String(), status.Code().String())
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package stream_test
+
+import (
+	"codepix/bank-api/adapters/rpc"
+	"codepix/bank-api/adapters/validator"
+	"codepix/bank-api/bankapitest"
+	"codepix/bank-api/lib/aggregates"
+	proto "codepix/bank-api/proto/codepix/transaction/write"
+	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/transactiontest"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func Release(client proto.StreamClient, commandHandler *transactiontest.MockCommandHandler,
+) func(t *testing.T) {
+	return func(t *testing.T) {
+		type request = proto.ReleaseRequest
+		type command = transaction.Release
+
+		type in struct {
+			ctx     context.Context
+			request *request
+			command *command
+		}
+		type out struct {
+			err    error
+			status *status.Status
+		}
+		type testCase struct {
+			description string
+			in          in
+			out         out
+		}
+
+		ID := uuid.New()
+		bankID := bankapitest.Config.Risk.Reviewers[0]
+		validRequest := &request{
+			Id: ID[:],
+		}
+		validCommand := &command{
+			ID:     ID,
+			BankID: bankID,
+		}
+
+		ctx := AuthenticatedContext(context.Background(), bankID)
+		ctxWithLocale := metadata.AppendToOutgoingContext(ctx, "locale", validator.EN_US)
+
+		testCases := []testCase{
+			{
+				"valid",
+				in{
+					ctx,
+					validRequest,
+					validCommand,
+				},
+				out{
+					nil,
+					status.New(codes.OK, ""),
+				},
+			},
+			{
+				"invalid",
+				in{
+					ctxWithLocale,
+					&request{},
+					nil,
+				},
+				out{
+					nil,
+					func() *status.Status {
+						status, _ := status.New(codes.InvalidArgument,
+							"validation failed on id (required)").
+							WithDetails(rpc.ValidationErrorMessage(map[string]string{
+								"id": "id is a required field",
+							}))
+						return status
+					}(),
+				},
+			},
+			{
+				"not held",
+				in{
+					ctx,
+					validRequest,
+					validCommand,
+				},
+				out{
+					&aggregates.InvariantViolation{transaction.ErrCannotReleaseIfNotHeld},
+					status.New(codes.Aborted, transaction.ErrCannotReleaseIfNotHeld.Error()),
+				},
+			},
+			{
+				"sender or receiver",
+				in{
+					ctx,
+					validRequest,
+					validCommand,
+				},
+				out{
+					&aggregates.InvariantViolation{transaction.ErrCannotReleaseIfSenderOrReceiver},
+					status.New(codes.PermissionDenied, ""),
+				},
+			},
+			{
+				"not a reviewer",
+				in{
+					AuthenticatedContext(context.Background(), uuid.New()),
+					validRequest,
+					nil,
+				},
+				out{
+					nil,
+					status.New(codes.PermissionDenied, ""),
+				},
+			},
+			{
+				"unauthenticated",
+				in{
+					context.Background(),
+					validRequest,
+					nil,
+				},
+				out{
+					nil,
+					status.New(codes.Unauthenticated, ""),
+				},
+			},
+		}
+		for i, tc := range testCases {
+			t.Run(fmt.Sprint(i, "_", tc.description), func(t *testing.T) {
+				if tc.in.command != nil {
+					commandHandler.On("HandleCommand", mock.IsType(tc.in.ctx),
+						mock.MatchedBy(func(cmd command) bool { return cmp.Equal(*tc.in.command, cmd, ExceptID) })).
+						Return(tc.out.err).Once()
+				}
+
+				stream, err := client.Release(tc.in.ctx)
+				require.NoError(t, err)
+				err = stream.Send(tc.in.request)
+				require.NoError(t, err)
+
+				reply, err := stream.Recv()
+				if err != nil {
+					status, _ := status.FromError(err)
+					assert.Equal(t, tc.out.status.Code().String(), status.Code().String())
+				} else {
+					if tc.out.status.Code() == codes.OK {
+						require.NotNil(t, reply)
+						require.NotNil(t, reply.GetReleased())
+					} else {
+						status := reply.GetError()
+						assert.Equal(t, tc.out.status.Code().String(), codes.Code(status.Code).String())
+					}
+				}
+				if tc.out.status.Message() != "" {
+					status := reply.GetError()
+					assert.Empty(t, cmp.Diff(tc.out.status.Proto(), status, protocmp.Transform()))
+				}
+			})
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"codepix/bank-api/transaction"
 	"codepix/bank-api/transaction/read/repository"
 	"codepix/bank-api/transaction/transactiontest"
+	idempotencyrepository "codepix/bank-api/transaction/write/idempotency/repository"
 	"context"
 	"errors"
 	"fmt"
@@ -250,6 +251,78 @@ func Start(client proto.StreamClient, commandHandler *transactiontest.MockComman
 	}
 }
 
+func StartIdempotency(client proto.StreamClient, commandHandler *transactiontest.MockCommandHandler,
+	pixKeyRepo *pixkeytest.MockRepo, idempotencyRepo *transactiontest.MockIdempotencyRepo,
+) func(t *testing.T) {
+	return func(t *testing.T) {
+		type command = transaction.Start
+
+		pixKey := ValidPixKey()
+		receiverIDs := &pixkeyrepository.IDs{
+			PixKeyID:  uuid.New(),
+			AccountID: uuid.New(),
+			BankID:    uuid.New(),
+		}
+		bankID := uuid.New()
+		ctx := AuthenticatedContext(context.Background(), bankID)
+
+		request := ValidStartRequest()
+		request.IdempotencyKey = uuid.NewString()
+
+		var record idempotencyrepository.Record
+
+		stream, err := client.Start(ctx)
+		require.NoError(t, err)
+
+		t.Run("0_first request", func(t *testing.T) {
+			idempotencyRepo.On("Find", bankID, request.IdempotencyKey).
+				Return(nil, &repositories.NotFoundError{}).Once()
+			pixKeyRepo.On("FindByKey", request.ReceiverKey).
+				Return(&pixKey, receiverIDs, nil).Once()
+			idempotencyRepo.On("Add", mock.IsType(record)).
+				Run(func(args mock.Arguments) { record = args.Get(0).(idempotencyrepository.Record) }).
+				Return(nil).Once()
+			commandHandler.On("HandleCommand", mock.Anything, mock.IsType(command{})).
+				Return(nil).Once()
+
+			err := stream.Send(request)
+			require.NoError(t, err)
+			reply, err := stream.Recv()
+			require.NoError(t, err)
+			require.NotNil(t, reply.GetStarted())
+			assert.Equal(t, record.AggregateID[:], reply.GetStarted().Id)
+		})
+		t.Run("1_retried request", func(t *testing.T) {
+			idempotencyRepo.On("Find", bankID, request.IdempotencyKey).
+				Return(&record, nil).Once()
+
+			err := stream.Send(request)
+			require.NoError(t, err)
+			reply, err := stream.Recv()
+			require.NoError(t, err)
+			require.NotNil(t, reply.GetStarted())
+			assert.Equal(t, record.AggregateID[:], reply.GetStarted().Id)
+		})
+		t.Run("2_different request with the same key", func(t *testing.T) {
+			idempotencyRepo.On("Find", bankID, request.IdempotencyKey).
+				Return(&record, nil).Once()
+
+			different := ValidStartRequest()
+			different.IdempotencyKey = request.IdempotencyKey
+			different.Amount = request.Amount + 1
+
+			err := stream.Send(different)
+			require.NoError(t, err)
+			reply, err := stream.Recv()
+			require.NoError(t, err)
+			require.NotNil(t, reply.GetError())
+			assert.Equal(t, codes.AlreadyExists.String(), codes.Code(reply.GetError().Code).String())
+		})
+		idempotencyRepo.AssertExpectations(t)
+		commandHandler.AssertExpectations(t)
+	}
+}
+
 func StartIntegration(client proto.StreamClient, repo repository.Repository,
 	pixKeyRepo pixkeyrepository.Repository, creator transactiontest.Creator,
 ) func(t *testing.T) {
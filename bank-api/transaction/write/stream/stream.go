@@ -5,12 +5,16 @@ import (
 	"codepix/bank-api/bank/auth"
 	pixkeyrepository "codepix/bank-api/pixkey/repository"
 	proto "codepix/bank-api/proto/codepix/transaction/write"
+	"codepix/bank-api/risk"
 	"codepix/bank-api/transaction"
+	"codepix/bank-api/transaction/write/idempotency"
 	"context"
+	"encoding/binary"
 
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
 	"github.com/looplab/eventhorizon"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	protobuf "google.golang.org/protobuf/proto"
 )
@@ -19,6 +23,9 @@ type Stream struct {
 	Logger           logr.Logger
 	CommandHandler   eventhorizon.CommandHandler
 	PixKeyRepository pixkeyrepository.Repository
+	Idempotency      idempotency.Guard
+	Risk             risk.Assessor
+	Reviewers        []uuid.UUID
 	proto.UnimplementedStreamServer
 }
 
@@ -61,13 +68,17 @@ func (s Stream) Write(ctx context.Context,
 			s.Logger.Error(err, "fail: send error", baseKvs...)
 			continue
 		}
-		kvs := append(baseKvs, "tx", command.AggregateID())
-		err = s.CommandHandler.HandleCommand(ctx, command)
-		if err != nil {
-			s.Logger.Error(err, "fail: handle command", kvs...)
-			err := send(wrapError(rpc.MapError(ctx, err)))
-			s.Logger.Error(err, "fail: send error", kvs...)
-			continue
+		kvs := baseKvs
+		// a nil command means it was already handled while creating the reply
+		if command != nil {
+			kvs = append(kvs, "tx", command.AggregateID())
+			err = s.CommandHandler.HandleCommand(ctx, command)
+			if err != nil {
+				s.Logger.Error(err, "fail: handle command", kvs...)
+				err := send(wrapError(rpc.MapError(ctx, err)))
+				s.Logger.Error(err, "fail: send error", kvs...)
+				continue
+			}
 		}
 		err = send(reply)
 		if err != nil {
@@ -101,14 +112,21 @@ func (s Stream) Start(stream proto.Stream_StartServer) error {
 		func(m protobuf.Message) (eventhorizon.Command, protobuf.Message, error) {
 			req := m.(*proto.StartRequest)
 			senderID, _ := uuid.FromBytes(req.SenderId)
-			_, receiverIDs, err := s.PixKeyRepository.FindByKey(req.ReceiverKey)
+
+			ID, err := s.Idempotency.Handle(ctx, bankID, req.IdempotencyKey, startHash(req),
+				func(ID uuid.UUID) (eventhorizon.Command, error) {
+					_, receiverIDs, err := s.PixKeyRepository.FindByKey(req.ReceiverKey)
+					if err != nil {
+						return nil, err
+					}
+					start := startCommand(req, ID, bankID, senderID, *receiverIDs)
+					return s.Risk.Assess(ctx, start, req.ReceiverKey)
+				},
+			)
 			if err != nil {
 				return nil, nil, err
 			}
-			ID := uuid.New()
-			command := startCommand(req, ID, bankID, senderID, *receiverIDs)
-			reply := startReply(ID)
-			return command, reply, nil
+			return nil, startReply(ID), nil
 		},
 	)
 }
@@ -126,6 +144,14 @@ func startCommand(req *proto.StartRequest, ID, bankID, senderID uuid.UUID,
 		Description:  req.Description,
 	}
 }
+func startHash(req *proto.StartRequest) string {
+	return idempotency.Hash(
+		req.SenderId,
+		[]byte(req.ReceiverKey),
+		binary.BigEndian.AppendUint64(nil, req.Amount),
+		[]byte(req.Description),
+	)
+}
 func startReply(ID uuid.UUID) *proto.StartReply {
 	return &proto.StartReply{
 		Message: &proto.StartReply_Started{
@@ -258,6 +284,7 @@ func failCommand(req *proto.FailRequest, bankID uuid.UUID) transaction.Fail {
 	return transaction.Fail{
 		ID:     ID,
 		BankID: bankID,
+		Code:   transaction.FailureCode(req.Code),
 		Reason: req.Reason,
 	}
 }
@@ -268,3 +295,247 @@ func failReply() *proto.FailReply {
 		},
 	}
 }
+
+func (s Stream) Release(stream proto.Stream_ReleaseServer) error {
+	ctx := stream.Context()
+	bankID := auth.GetBankID(ctx)
+
+	return s.Write(ctx,
+		bankID.String(),
+		transaction.ReleaseCommand,
+		func() (protobuf.Message, error) {
+			return stream.Recv()
+		},
+		func(m protobuf.Message) error {
+			return stream.SendMsg(m)
+		},
+		func(err error) protobuf.Message {
+			return &proto.ReleaseReply{
+				Message: &proto.ReleaseReply_Error{
+					Error: status.Convert(err).Proto(),
+				},
+			}
+		},
+		func(m protobuf.Message) (eventhorizon.Command, protobuf.Message, error) {
+			if !s.isReviewer(bankID) {
+				return nil, nil, status.Error(codes.PermissionDenied, "")
+			}
+			req := m.(*proto.ReleaseRequest)
+			command := releaseCommand(req, bankID)
+			reply := releaseReply()
+			return command, reply, nil
+		},
+	)
+}
+
+func (s Stream) isReviewer(bankID uuid.UUID) bool {
+	for _, reviewer := range s.Reviewers {
+		if reviewer == bankID {
+			return true
+		}
+	}
+	return false
+}
+
+func releaseCommand(req *proto.ReleaseRequest, bankID uuid.UUID) transaction.Release {
+	ID, _ := uuid.FromBytes(req.Id)
+	return transaction.Release{
+		ID:     ID,
+		BankID: bankID,
+	}
+}
+func releaseReply() *proto.ReleaseReply {
+	return &proto.ReleaseReply{
+		Message: &proto.ReleaseReply_Released{
+			Released: &proto.Released{},
+		},
+	}
+}
+
+func (s Stream) RequestRefund(stream proto.Stream_RequestRefundServer) error {
+	ctx := stream.Context()
+	bankID := auth.GetBankID(ctx)
+
+	return s.Write(ctx,
+		bankID.String(),
+		transaction.RequestRefundCommand,
+		func() (protobuf.Message, error) {
+			return stream.Recv()
+		},
+		func(m protobuf.Message) error {
+			return stream.SendMsg(m)
+		},
+		func(err error) protobuf.Message {
+			return &proto.RequestRefundReply{
+				Message: &proto.RequestRefundReply_Error{
+					Error: status.Convert(err).Proto(),
+				},
+			}
+		},
+		func(m protobuf.Message) (eventhorizon.Command, protobuf.Message, error) {
+			req := m.(*proto.RequestRefundRequest)
+			command := requestRefundCommand(req, bankID)
+			reply := requestRefundReply(command.RefundID)
+			return command, reply, nil
+		},
+	)
+}
+
+func requestRefundCommand(req *proto.RequestRefundRequest, bankID uuid.UUID) transaction.RequestRefund {
+	ID, _ := uuid.FromBytes(req.Id)
+	return transaction.RequestRefund{
+		ID:       ID,
+		RefundID: uuid.New(),
+		BankID:   bankID,
+		Amount:   req.Amount,
+		Reason:   req.Reason,
+	}
+}
+func requestRefundReply(refundID uuid.UUID) *proto.RequestRefundReply {
+	return &proto.RequestRefundReply{
+		Message: &proto.RequestRefundReply_RefundRequested{
+			RefundRequested: &proto.RefundRequested{
+				RefundId: refundID[:],
+			},
+		},
+	}
+}
+
+func (s Stream) ConfirmRefund(stream proto.Stream_ConfirmRefundServer) error {
+	ctx := stream.Context()
+	bankID := auth.GetBankID(ctx)
+
+	return s.Write(ctx,
+		bankID.String(),
+		transaction.ConfirmRefundCommand,
+		func() (protobuf.Message, error) {
+			return stream.Recv()
+		},
+		func(m protobuf.Message) error {
+			return stream.SendMsg(m)
+		},
+		func(err error) protobuf.Message {
+			return &proto.ConfirmRefundReply{
+				Message: &proto.ConfirmRefundReply_Error{
+					Error: status.Convert(err).Proto(),
+				},
+			}
+		},
+		func(m protobuf.Message) (eventhorizon.Command, protobuf.Message, error) {
+			req := m.(*proto.ConfirmRefundRequest)
+			command := confirmRefundCommand(req, bankID)
+			reply := confirmRefundReply()
+			return command, reply, nil
+		},
+	)
+}
+
+func confirmRefundCommand(req *proto.ConfirmRefundRequest, bankID uuid.UUID) transaction.ConfirmRefund {
+	ID, _ := uuid.FromBytes(req.Id)
+	refundID, _ := uuid.FromBytes(req.RefundId)
+	return transaction.ConfirmRefund{
+		ID:       ID,
+		RefundID: refundID,
+		BankID:   bankID,
+	}
+}
+func confirmRefundReply() *proto.ConfirmRefundReply {
+	return &proto.ConfirmRefundReply{
+		Message: &proto.ConfirmRefundReply_RefundConfirmed{
+			RefundConfirmed: &proto.RefundConfirmed{},
+		},
+	}
+}
+
+func (s Stream) CompleteRefund(stream proto.Stream_CompleteRefundServer) error {
+	ctx := stream.Context()
+	bankID := auth.GetBankID(ctx)
+
+	return s.Write(ctx,
+		bankID.String(),
+		transaction.CompleteRefundCommand,
+		func() (protobuf.Message, error) {
+			return stream.Recv()
+		},
+		func(m protobuf.Message) error {
+			return stream.SendMsg(m)
+		},
+		func(err error) protobuf.Message {
+			return &proto.CompleteRefundReply{
+				Message: &proto.CompleteRefundReply_Error{
+					Error: status.Convert(err).Proto(),
+				},
+			}
+		},
+		func(m protobuf.Message) (eventhorizon.Command, protobuf.Message, error) {
+			req := m.(*proto.CompleteRefundRequest)
+			command := completeRefundCommand(req, bankID)
+			reply := completeRefundReply()
+			return command, reply, nil
+		},
+	)
+}
+
+func completeRefundCommand(req *proto.CompleteRefundRequest, bankID uuid.UUID) transaction.CompleteRefund {
+	ID, _ := uuid.FromBytes(req.Id)
+	refundID, _ := uuid.FromBytes(req.RefundId)
+	return transaction.CompleteRefund{
+		ID:       ID,
+		RefundID: refundID,
+		BankID:   bankID,
+	}
+}
+func completeRefundReply() *proto.CompleteRefundReply {
+	return &proto.CompleteRefundReply{
+		Message: &proto.CompleteRefundReply_RefundCompleted{
+			RefundCompleted: &proto.RefundCompleted{},
+		},
+	}
+}
+
+func (s Stream) FailRefund(stream proto.Stream_FailRefundServer) error {
+	ctx := stream.Context()
+	bankID := auth.GetBankID(ctx)
+
+	return s.Write(ctx,
+		bankID.String(),
+		transaction.FailRefundCommand,
+		func() (protobuf.Message, error) {
+			return stream.Recv()
+		},
+		func(m protobuf.Message) error {
+			return stream.SendMsg(m)
+		},
+		func(err error) protobuf.Message {
+			return &proto.FailRefundReply{
+				Message: &proto.FailRefundReply_Error{
+					Error: status.Convert(err).Proto(),
+				},
+			}
+		},
+		func(m protobuf.Message) (eventhorizon.Command, protobuf.Message, error) {
+			req := m.(*proto.FailRefundRequest)
+			command := failRefundCommand(req, bankID)
+			reply := failRefundReply()
+			return command, reply, nil
+		},
+	)
+}
+
+func failRefundCommand(req *proto.FailRefundRequest, bankID uuid.UUID) transaction.FailRefund {
+	ID, _ := uuid.FromBytes(req.Id)
+	refundID, _ := uuid.FromBytes(req.RefundId)
+	return transaction.FailRefund{
+		ID:       ID,
+		RefundID: refundID,
+		BankID:   bankID,
+		Reason:   req.Reason,
+	}
+}
+func failRefundReply() *proto.FailRefundReply {
+	return &proto.FailRefundReply{
+		Message: &proto.FailRefundReply_RefundFailed{
+			RefundFailed: &proto.RefundFailed{},
+		},
+	}
+}
@@ -6,7 +6,7 @@ import (
 )
 
 func TestStream(t *testing.T) {
-	client, commandHandler, pixKeyRepo := StreamWithMocks()
+	client, commandHandler, pixKeyRepo, idempotencyRepo := StreamWithMocks()
 
 	type test struct {
 		description string
@@ -14,9 +14,13 @@ func TestStream(t *testing.T) {
 	}
 	tests := []test{
 		{"start", Start(client, commandHandler, pixKeyRepo)},
+		{"start idempotency", StartIdempotency(client, commandHandler, pixKeyRepo, idempotencyRepo)},
 		{"confirm", Confirm(client, commandHandler)},
 		{"complete", Complete(client, commandHandler)},
 		{"fail", Fail(client, commandHandler)},
+		{"release", Release(client, commandHandler)},
+		{"request refund", RequestRefund(client, commandHandler)},
+		{"refund transitions", RefundTransitions(client, commandHandler)},
 	}
 	for i, test := range tests {
 		t.Run(fmt.Sprint(i, "_", test.description), test.fn)
@@ -8,6 +8,9 @@ type Account struct {
 
 const NumberLength = 8
 
+// Branch is the only branch of the bank, which holds every account.
+const Branch = "0001"
+
 func GenerateNumber() string {
 	randomID := uuid.NewString()
 	return randomID[:NumberLength]
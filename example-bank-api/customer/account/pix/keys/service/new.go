@@ -6,6 +6,7 @@ import (
 	"codepix/example-bank-api/config"
 	"codepix/example-bank-api/customer/account/repository"
 	customerauth "codepix/example-bank-api/customer/auth"
+	customerrepository "codepix/example-bank-api/customer/repository"
 	"codepix/example-bank-api/lib/validation"
 	pixkeyproto "codepix/example-bank-api/proto/codepix/pixkey"
 	userauth "codepix/example-bank-api/user/auth"
@@ -18,13 +19,16 @@ func Register(
 	chain alice.Chain,
 	handle httputils.RouterHandler,
 	validator *validation.Validator,
+	customerRepository customerrepository.Repository,
 	accountRepository repository.Repository,
 	pixAPIClient *pix.Client,
 ) error {
 	pixKeyClient := pixkeyproto.NewServiceClient(pixAPIClient.Conn)
 
 	service := &Service{
-		PixKeyClient: pixKeyClient,
+		PixKeyClient:       pixKeyClient,
+		CustomerRepository: customerRepository,
+		AccountRepository:  accountRepository,
 	}
 	anyUser := chain.Append(userauth.ValidateToken(config))
 	anyCustomer := anyUser.Append(customerauth.ValidateClaims)
@@ -36,6 +40,11 @@ func Register(
 		httputils.WithBody(validator, RegisterReq{}),
 	).ThenFunc(service.Register))
 
+	handle("POST", "/account/:account-id/pix/keys/:pix-key-id/verify", accountOwner.Append(
+		httputils.WithParams(validator, VerifyParams{}),
+		httputils.WithBody(validator, VerifyReq{}),
+	).ThenFunc(service.Verify))
+
 	handle("GET", "/account/:account-id/pix/keys/:pix-key-id", accountOwner.Append(
 		httputils.WithParams(validator, Find{}),
 	).ThenFunc(service.Find))
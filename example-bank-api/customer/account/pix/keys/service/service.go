@@ -3,6 +3,10 @@ package service
 import (
 	"codepix/example-bank-api/adapters/httputils"
 	"codepix/example-bank-api/adapters/rpc"
+	"codepix/example-bank-api/customer/account"
+	accountrepository "codepix/example-bank-api/customer/account/repository"
+	customerauth "codepix/example-bank-api/customer/auth"
+	customerrepository "codepix/example-bank-api/customer/repository"
 	proto "codepix/example-bank-api/proto/codepix/pixkey"
 	"net/http"
 
@@ -10,28 +14,51 @@ import (
 )
 
 type Service struct {
-	PixKeyClient proto.ServiceClient
+	PixKeyClient       proto.ServiceClient
+	CustomerRepository customerrepository.Repository
+	AccountRepository  accountrepository.Repository
 }
 
 type RegisterParams struct {
 	AccountID uuid.UUID `param:"account-id"`
 }
+
+// OwnerDocument is the CPF or CNPJ of the customer, which is stored with the key.
 type RegisterReq struct {
-	Type proto.Type `json:"type"`
-	Key  string     `json:"key"`
+	Type          proto.Type `json:"type"`
+	Key           string     `json:"key"`
+	OwnerDocument string     `json:"owner_document"`
 }
+
+// Phone and e-mail keys are registered pending, until the code sent to them is verified.
 type Registered struct {
-	ID uuid.UUID `json:"id"`
+	ID     uuid.UUID    `json:"id"`
+	Status proto.Status `json:"status"`
 }
 
 func (s Service) Register(w http.ResponseWriter, r *http.Request) {
 	params := httputils.Params(r, RegisterParams{})
 	body := httputils.Body(r, RegisterReq{})
 
+	customer, err := s.CustomerRepository.Find(customerauth.GetCustomerID(r.Context()))
+	if err != nil {
+		httputils.Error(w, r, err)
+		return
+	}
+	customerAccount, err := s.AccountRepository.Find(params.AccountID)
+	if err != nil {
+		httputils.Error(w, r, err)
+		return
+	}
 	request := &proto.RegisterRequest{
-		Type:      body.Type,
-		Key:       body.Key,
-		AccountId: params.AccountID[:],
+		Type:          body.Type,
+		Key:           body.Key,
+		AccountId:     params.AccountID[:],
+		OwnerName:     customer.Name,
+		OwnerDocument: body.OwnerDocument,
+		Branch:        account.Branch,
+		AccountNumber: customerAccount.Number,
+		AccountType:   proto.AccountType_Checking,
 	}
 	pbReply, err := s.PixKeyClient.Register(r.Context(), request)
 	if err != nil {
@@ -41,10 +68,54 @@ func (s Service) Register(w http.ResponseWriter, r *http.Request) {
 	ID, _ := uuid.FromBytes(pbReply.Id)
 	reply := Registered{
 		ID,
+		pbReply.Status,
 	}
 	httputils.Json(w, reply, http.StatusCreated)
 }
 
+type VerifyParams struct {
+	AccountID uuid.UUID `param:"account-id"`
+	ID        uuid.UUID `param:"pix-key-id"`
+}
+type VerifyReq struct {
+	Code string `json:"code"`
+}
+type Verified struct {
+	ID     uuid.UUID    `json:"id"`
+	Status proto.Status `json:"status"`
+}
+
+func (s Service) Verify(w http.ResponseWriter, r *http.Request) {
+	params := httputils.Params(r, VerifyParams{})
+	body := httputils.Body(r, VerifyReq{})
+
+	found, err := s.PixKeyClient.Find(r.Context(), &proto.FindRequest{Id: params.ID[:]})
+	if err != nil {
+		rpc.ErrorToHTTP(w, r, err)
+		return
+	}
+	accountID, _ := uuid.FromBytes(found.AccountId)
+	if accountID != params.AccountID {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	request := &proto.VerifyRequest{
+		Id:   params.ID[:],
+		Code: body.Code,
+	}
+	pbReply, err := s.PixKeyClient.Verify(r.Context(), request)
+	if err != nil {
+		rpc.ErrorToHTTP(w, r, err)
+		return
+	}
+	ID, _ := uuid.FromBytes(pbReply.Id)
+	reply := Verified{
+		ID,
+		pbReply.Status,
+	}
+	httputils.Json(w, reply, http.StatusOK)
+}
+
 type Find struct {
 	AccountID uuid.UUID `param:"account-id"`
 	ID        uuid.UUID `param:"pix-key-id"`
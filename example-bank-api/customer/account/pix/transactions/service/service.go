@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -39,7 +40,9 @@ func (s *Service) Send(w http.ResponseWriter, r *http.Request) {
 		Amount:      body.Amount,
 		Description: body.Description,
 	}
-	pbReply, err := s.WriteServiceClient.Start(r.Context(), request)
+	// the account holder is the end user, so codepix rate limits key lookups per account
+	ctx := metadata.AppendToOutgoingContext(r.Context(), "end_user_id", params.AccountID.String())
+	pbReply, err := s.WriteServiceClient.Start(ctx, request)
 	if err != nil {
 		rpc.ErrorToHTTP(w, r, err)
 		return
@@ -56,17 +59,18 @@ type Find struct {
 	ID        uuid.UUID `param:"pix-transaction-id"`
 }
 type FindResult struct {
-	ID               uuid.UUID        `json:"id"`
-	Sender           uuid.UUID        `json:"sender"`
-	SenderBank       uuid.UUID        `json:"sender_bank"`
-	Receiver         uuid.UUID        `json:"receiver"`
-	ReceiverBank     uuid.UUID        `json:"receiver_bank"`
-	CreatedAt        time.Time        `json:"created_at"`
-	UpdatedAt        time.Time        `json:"updated_at"`
-	Amount           uint64           `json:"amount"`
-	Description      string           `json:"description"`
-	Status           readproto.Status `json:"status"`
-	ReasonForFailing string           `json:"reason_for_failing"`
+	ID               uuid.UUID             `json:"id"`
+	Sender           uuid.UUID             `json:"sender"`
+	SenderBank       uuid.UUID             `json:"sender_bank"`
+	Receiver         uuid.UUID             `json:"receiver"`
+	ReceiverBank     uuid.UUID             `json:"receiver_bank"`
+	CreatedAt        time.Time             `json:"created_at"`
+	UpdatedAt        time.Time             `json:"updated_at"`
+	Amount           uint64                `json:"amount"`
+	Description      string                `json:"description"`
+	Status           readproto.Status      `json:"status"`
+	FailureCode      readproto.FailureCode `json:"failure_code"`
+	ReasonForFailing string                `json:"reason_for_failing"`
 }
 
 func (s Service) Find(w http.ResponseWriter, r *http.Request) {
@@ -105,6 +109,7 @@ func FindReply(pb *readproto.FindReply) FindResult {
 		Amount:           pb.Amount,
 		Description:      pb.Description,
 		Status:           pb.Status,
+		FailureCode:      pb.FailureCode,
 		ReasonForFailing: pb.ReasonForFailing,
 	}
 }
@@ -168,6 +173,7 @@ func ListItemReply(pb *readproto.ListItem) ListItem {
 		Amount:           pb.Amount,
 		Description:      pb.Description,
 		Status:           pb.Status,
+		FailureCode:      pb.FailureCode,
 		ReasonForFailing: pb.ReasonForFailing,
 	}
 }
@@ -129,7 +129,8 @@ func New(ctx context.Context, loggerImpl *zap.Logger, config config.Config) (*Ex
 	if err != nil {
 		return nil, err
 	}
-	err = pixkeyservice.Register(config, chain, handle, validator, accountRepository, pixAPIClient)
+	err = pixkeyservice.Register(config, chain, handle, validator, customerRepository,
+		accountRepository, pixAPIClient)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,341 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.1
+// source: proto/codepix/infraction/read/stream.proto
+
+package read
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StreamClient is the client API for Stream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StreamClient interface {
+	Opened(ctx context.Context, opts ...grpc.CallOption) (Stream_OpenedClient, error)
+	Acknowledged(ctx context.Context, opts ...grpc.CallOption) (Stream_AcknowledgedClient, error)
+	Accepted(ctx context.Context, opts ...grpc.CallOption) (Stream_AcceptedClient, error)
+	Rejected(ctx context.Context, opts ...grpc.CallOption) (Stream_RejectedClient, error)
+}
+
+type streamClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStreamClient(cc grpc.ClientConnInterface) StreamClient {
+	return &streamClient{cc}
+}
+
+func (c *streamClient) Opened(ctx context.Context, opts ...grpc.CallOption) (Stream_OpenedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[0], "/codepix.infraction.read.Stream/Opened", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamOpenedClient{stream}
+	return x, nil
+}
+
+type Stream_OpenedClient interface {
+	Send(*Ack) error
+	Recv() (*OpenedReports, error)
+	grpc.ClientStream
+}
+
+type streamOpenedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamOpenedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamOpenedClient) Recv() (*OpenedReports, error) {
+	m := new(OpenedReports)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) Acknowledged(ctx context.Context, opts ...grpc.CallOption) (Stream_AcknowledgedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[1], "/codepix.infraction.read.Stream/Acknowledged", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamAcknowledgedClient{stream}
+	return x, nil
+}
+
+type Stream_AcknowledgedClient interface {
+	Send(*Ack) error
+	Recv() (*AcknowledgedReports, error)
+	grpc.ClientStream
+}
+
+type streamAcknowledgedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamAcknowledgedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamAcknowledgedClient) Recv() (*AcknowledgedReports, error) {
+	m := new(AcknowledgedReports)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) Accepted(ctx context.Context, opts ...grpc.CallOption) (Stream_AcceptedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[2], "/codepix.infraction.read.Stream/Accepted", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamAcceptedClient{stream}
+	return x, nil
+}
+
+type Stream_AcceptedClient interface {
+	Send(*Ack) error
+	Recv() (*AcceptedReports, error)
+	grpc.ClientStream
+}
+
+type streamAcceptedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamAcceptedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamAcceptedClient) Recv() (*AcceptedReports, error) {
+	m := new(AcceptedReports)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamClient) Rejected(ctx context.Context, opts ...grpc.CallOption) (Stream_RejectedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[3], "/codepix.infraction.read.Stream/Rejected", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamRejectedClient{stream}
+	return x, nil
+}
+
+type Stream_RejectedClient interface {
+	Send(*Ack) error
+	Recv() (*RejectedReports, error)
+	grpc.ClientStream
+}
+
+type streamRejectedClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamRejectedClient) Send(m *Ack) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamRejectedClient) Recv() (*RejectedReports, error) {
+	m := new(RejectedReports)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamServer is the server API for Stream service.
+// All implementations must embed UnimplementedStreamServer
+// for forward compatibility
+type StreamServer interface {
+	Opened(Stream_OpenedServer) error
+	Acknowledged(Stream_AcknowledgedServer) error
+	Accepted(Stream_AcceptedServer) error
+	Rejected(Stream_RejectedServer) error
+	mustEmbedUnimplementedStreamServer()
+}
+
+// UnimplementedStreamServer must be embedded to have forward compatible implementations.
+type UnimplementedStreamServer struct {
+}
+
+func (UnimplementedStreamServer) Opened(Stream_OpenedServer) error {
+	return status.Errorf(codes.Unimplemented, "method Opened not implemented")
+}
+func (UnimplementedStreamServer) Acknowledged(Stream_AcknowledgedServer) error {
+	return status.Errorf(codes.Unimplemented, "method Acknowledged not implemented")
+}
+func (UnimplementedStreamServer) Accepted(Stream_AcceptedServer) error {
+	return status.Errorf(codes.Unimplemented, "method Accepted not implemented")
+}
+func (UnimplementedStreamServer) Rejected(Stream_RejectedServer) error {
+	return status.Errorf(codes.Unimplemented, "method Rejected not implemented")
+}
+func (UnimplementedStreamServer) mustEmbedUnimplementedStreamServer() {}
+
+// UnsafeStreamServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StreamServer will
+// result in compilation errors.
+type UnsafeStreamServer interface {
+	mustEmbedUnimplementedStreamServer()
+}
+
+func RegisterStreamServer(s grpc.ServiceRegistrar, srv StreamServer) {
+	s.RegisterService(&Stream_ServiceDesc, srv)
+}
+
+func _Stream_Opened_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Opened(&streamOpenedServer{stream})
+}
+
+type Stream_OpenedServer interface {
+	Send(*OpenedReports) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamOpenedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamOpenedServer) Send(m *OpenedReports) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamOpenedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_Acknowledged_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Acknowledged(&streamAcknowledgedServer{stream})
+}
+
+type Stream_AcknowledgedServer interface {
+	Send(*AcknowledgedReports) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamAcknowledgedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamAcknowledgedServer) Send(m *AcknowledgedReports) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamAcknowledgedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_Accepted_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Accepted(&streamAcceptedServer{stream})
+}
+
+type Stream_AcceptedServer interface {
+	Send(*AcceptedReports) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamAcceptedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamAcceptedServer) Send(m *AcceptedReports) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamAcceptedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stream_Rejected_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamServer).Rejected(&streamRejectedServer{stream})
+}
+
+type Stream_RejectedServer interface {
+	Send(*RejectedReports) error
+	Recv() (*Ack, error)
+	grpc.ServerStream
+}
+
+type streamRejectedServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamRejectedServer) Send(m *RejectedReports) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamRejectedServer) Recv() (*Ack, error) {
+	m := new(Ack)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Stream_ServiceDesc is the grpc.ServiceDesc for Stream service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Stream_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "codepix.infraction.read.Stream",
+	HandlerType: (*StreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Opened",
+			Handler:       _Stream_Opened_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Acknowledged",
+			Handler:       _Stream_Acknowledged_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Accepted",
+			Handler:       _Stream_Accepted_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Rejected",
+			Handler:       _Stream_Rejected_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/codepix/infraction/read/stream.proto",
+}
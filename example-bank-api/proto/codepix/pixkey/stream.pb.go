@@ -0,0 +1,992 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.20.1
+// source: proto/codepix/pixkey/stream.proto
+
+package pixkey
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Ack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nacks []bool `protobuf:"varint,1,rep,packed,name=nacks,proto3" json:"nacks,omitempty"`
+}
+
+func (x *Ack) Reset() {
+	*x = Ack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ack) ProtoMessage() {}
+
+func (x *Ack) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ack.ProtoReflect.Descriptor instead.
+func (*Ack) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_stream_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Ack) GetNacks() []bool {
+	if x != nil {
+		return x.Nacks
+	}
+	return nil
+}
+
+type RegisteredKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type      Type                   `protobuf:"varint,3,opt,name=type,proto3,enum=codepix.pixkey.Type" json:"type,omitempty"`
+	Key       string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	AccountId []byte                 `protobuf:"bytes,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *RegisteredKey) Reset() {
+	*x = RegisteredKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisteredKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisteredKey) ProtoMessage() {}
+
+func (x *RegisteredKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisteredKey.ProtoReflect.Descriptor instead.
+func (*RegisteredKey) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_stream_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisteredKey) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *RegisteredKey) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *RegisteredKey) GetType() Type {
+	if x != nil {
+		return x.Type
+	}
+	return Type__
+}
+
+func (x *RegisteredKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RegisteredKey) GetAccountId() []byte {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+type RegisteredKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*RegisteredKey `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *RegisteredKeys) Reset() {
+	*x = RegisteredKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisteredKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisteredKeys) ProtoMessage() {}
+
+func (x *RegisteredKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisteredKeys.ProtoReflect.Descriptor instead.
+func (*RegisteredKeys) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_stream_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RegisteredKeys) GetEvents() []*RegisteredKey {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type DeletedKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Key       string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Reason    string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *DeletedKey) Reset() {
+	*x = DeletedKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletedKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedKey) ProtoMessage() {}
+
+func (x *DeletedKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedKey.ProtoReflect.Descriptor instead.
+func (*DeletedKey) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_stream_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeletedKey) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *DeletedKey) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *DeletedKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DeletedKey) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeletedKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*DeletedKey `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *DeletedKeys) Reset() {
+	*x = DeletedKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletedKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedKeys) ProtoMessage() {}
+
+func (x *DeletedKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedKeys.ProtoReflect.Descriptor instead.
+func (*DeletedKeys) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_stream_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeletedKeys) GetEvents() []*DeletedKey {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type BlockedKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Key       string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *BlockedKey) Reset() {
+	*x = BlockedKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockedKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedKey) ProtoMessage() {}
+
+func (x *BlockedKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedKey.ProtoReflect.Descriptor instead.
+func (*BlockedKey) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_stream_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BlockedKey) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *BlockedKey) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *BlockedKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type BlockedKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*BlockedKey `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *BlockedKeys) Reset() {
+	*x = BlockedKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockedKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedKeys) ProtoMessage() {}
+
+func (x *BlockedKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedKeys.ProtoReflect.Descriptor instead.
+func (*BlockedKeys) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_stream_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BlockedKeys) GetEvents() []*BlockedKey {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type UnblockedKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Key       string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *UnblockedKey) Reset() {
+	*x = UnblockedKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockedKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockedKey) ProtoMessage() {}
+
+func (x *UnblockedKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockedKey.ProtoReflect.Descriptor instead.
+func (*UnblockedKey) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_stream_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UnblockedKey) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UnblockedKey) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *UnblockedKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type UnblockedKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*UnblockedKey `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *UnblockedKeys) Reset() {
+	*x = UnblockedKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockedKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockedKeys) ProtoMessage() {}
+
+func (x *UnblockedKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockedKeys.ProtoReflect.Descriptor instead.
+func (*UnblockedKeys) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_stream_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnblockedKeys) GetEvents() []*UnblockedKey {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type TransferredKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Key       string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	DonorBank []byte                 `protobuf:"bytes,4,opt,name=donor_bank,json=donorBank,proto3" json:"donor_bank,omitempty"`
+	AccountId []byte                 `protobuf:"bytes,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Bank      []byte                 `protobuf:"bytes,6,opt,name=bank,proto3" json:"bank,omitempty"`
+}
+
+func (x *TransferredKey) Reset() {
+	*x = TransferredKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferredKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferredKey) ProtoMessage() {}
+
+func (x *TransferredKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferredKey.ProtoReflect.Descriptor instead.
+func (*TransferredKey) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_stream_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TransferredKey) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *TransferredKey) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *TransferredKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TransferredKey) GetDonorBank() []byte {
+	if x != nil {
+		return x.DonorBank
+	}
+	return nil
+}
+
+func (x *TransferredKey) GetAccountId() []byte {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *TransferredKey) GetBank() []byte {
+	if x != nil {
+		return x.Bank
+	}
+	return nil
+}
+
+type TransferredKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*TransferredKey `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *TransferredKeys) Reset() {
+	*x = TransferredKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferredKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferredKeys) ProtoMessage() {}
+
+func (x *TransferredKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_pixkey_stream_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferredKeys.ProtoReflect.Descriptor instead.
+func (*TransferredKeys) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_pixkey_stream_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TransferredKeys) GetEvents() []*TransferredKey {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_proto_codepix_pixkey_stream_proto protoreflect.FileDescriptor
+
+var file_proto_codepix_pixkey_stream_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f,
+	0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78,
+	0x6b, 0x65, 0x79, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65,
+	0x70, 0x69, 0x78, 0x2f, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2f, 0x70, 0x69, 0x78, 0x6b, 0x65,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1b, 0x0a, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x6e,
+	0x61, 0x63, 0x6b, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x0e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x35, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x41, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78,
+	0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x68, 0x0a, 0x0a, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x22, 0x41, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69,
+	0x78, 0x6b, 0x65, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6a, 0x0a, 0x0c, 0x55, 0x6e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x22, 0x45, 0x0a, 0x0d, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x4b, 0x65, 0x79, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70,
+	0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x0e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x6f, 0x6e,
+	0x6f, 0x72, 0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64,
+	0x6f, 0x6e, 0x6f, 0x72, 0x42, 0x61, 0x6e, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x6e, 0x6b, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x61, 0x6e, 0x6b, 0x22, 0x49, 0x0a, 0x0f, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x36,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x32, 0xe9, 0x02, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x47, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12,
+	0x13, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79,
+	0x2e, 0x41, 0x63, 0x6b, 0x1a, 0x1e, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70,
+	0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x4b, 0x65, 0x79, 0x73, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x07, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e,
+	0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x41, 0x63, 0x6b, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x64,
+	0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x41, 0x0a,
+	0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70,
+	0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x41, 0x63, 0x6b, 0x1a, 0x1b, 0x2e,
+	0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01,
+	0x12, 0x45, 0x0a, 0x09, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x13, 0x2e,
+	0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x41,
+	0x63, 0x6b, 0x1a, 0x1d, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78,
+	0x6b, 0x65, 0x79, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4b, 0x65, 0x79,
+	0x73, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78,
+	0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x41, 0x63, 0x6b, 0x1a, 0x1f, 0x2e, 0x63, 0x6f,
+	0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x00, 0x28, 0x01,
+	0x30, 0x01, 0x42, 0x27, 0x5a, 0x25, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f, 0x62, 0x61,
+	0x6e, 0x6b, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64,
+	0x65, 0x70, 0x69, 0x78, 0x2f, 0x70, 0x69, 0x78, 0x6b, 0x65, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_codepix_pixkey_stream_proto_rawDescOnce sync.Once
+	file_proto_codepix_pixkey_stream_proto_rawDescData = file_proto_codepix_pixkey_stream_proto_rawDesc
+)
+
+func file_proto_codepix_pixkey_stream_proto_rawDescGZIP() []byte {
+	file_proto_codepix_pixkey_stream_proto_rawDescOnce.Do(func() {
+		file_proto_codepix_pixkey_stream_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_codepix_pixkey_stream_proto_rawDescData)
+	})
+	return file_proto_codepix_pixkey_stream_proto_rawDescData
+}
+
+var file_proto_codepix_pixkey_stream_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_codepix_pixkey_stream_proto_goTypes = []interface{}{
+	(*Ack)(nil),                   // 0: codepix.pixkey.Ack
+	(*RegisteredKey)(nil),         // 1: codepix.pixkey.RegisteredKey
+	(*RegisteredKeys)(nil),        // 2: codepix.pixkey.RegisteredKeys
+	(*DeletedKey)(nil),            // 3: codepix.pixkey.DeletedKey
+	(*DeletedKeys)(nil),           // 4: codepix.pixkey.DeletedKeys
+	(*BlockedKey)(nil),            // 5: codepix.pixkey.BlockedKey
+	(*BlockedKeys)(nil),           // 6: codepix.pixkey.BlockedKeys
+	(*UnblockedKey)(nil),          // 7: codepix.pixkey.UnblockedKey
+	(*UnblockedKeys)(nil),         // 8: codepix.pixkey.UnblockedKeys
+	(*TransferredKey)(nil),        // 9: codepix.pixkey.TransferredKey
+	(*TransferredKeys)(nil),       // 10: codepix.pixkey.TransferredKeys
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(Type)(0),                     // 12: codepix.pixkey.Type
+}
+var file_proto_codepix_pixkey_stream_proto_depIdxs = []int32{
+	11, // 0: codepix.pixkey.RegisteredKey.timestamp:type_name -> google.protobuf.Timestamp
+	12, // 1: codepix.pixkey.RegisteredKey.type:type_name -> codepix.pixkey.Type
+	1,  // 2: codepix.pixkey.RegisteredKeys.events:type_name -> codepix.pixkey.RegisteredKey
+	11, // 3: codepix.pixkey.DeletedKey.timestamp:type_name -> google.protobuf.Timestamp
+	3,  // 4: codepix.pixkey.DeletedKeys.events:type_name -> codepix.pixkey.DeletedKey
+	11, // 5: codepix.pixkey.BlockedKey.timestamp:type_name -> google.protobuf.Timestamp
+	5,  // 6: codepix.pixkey.BlockedKeys.events:type_name -> codepix.pixkey.BlockedKey
+	11, // 7: codepix.pixkey.UnblockedKey.timestamp:type_name -> google.protobuf.Timestamp
+	7,  // 8: codepix.pixkey.UnblockedKeys.events:type_name -> codepix.pixkey.UnblockedKey
+	11, // 9: codepix.pixkey.TransferredKey.timestamp:type_name -> google.protobuf.Timestamp
+	9,  // 10: codepix.pixkey.TransferredKeys.events:type_name -> codepix.pixkey.TransferredKey
+	0,  // 11: codepix.pixkey.Stream.Registered:input_type -> codepix.pixkey.Ack
+	0,  // 12: codepix.pixkey.Stream.Deleted:input_type -> codepix.pixkey.Ack
+	0,  // 13: codepix.pixkey.Stream.Blocked:input_type -> codepix.pixkey.Ack
+	0,  // 14: codepix.pixkey.Stream.Unblocked:input_type -> codepix.pixkey.Ack
+	0,  // 15: codepix.pixkey.Stream.Transferred:input_type -> codepix.pixkey.Ack
+	2,  // 16: codepix.pixkey.Stream.Registered:output_type -> codepix.pixkey.RegisteredKeys
+	4,  // 17: codepix.pixkey.Stream.Deleted:output_type -> codepix.pixkey.DeletedKeys
+	6,  // 18: codepix.pixkey.Stream.Blocked:output_type -> codepix.pixkey.BlockedKeys
+	8,  // 19: codepix.pixkey.Stream.Unblocked:output_type -> codepix.pixkey.UnblockedKeys
+	10, // 20: codepix.pixkey.Stream.Transferred:output_type -> codepix.pixkey.TransferredKeys
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_proto_codepix_pixkey_stream_proto_init() }
+func file_proto_codepix_pixkey_stream_proto_init() {
+	if File_proto_codepix_pixkey_stream_proto != nil {
+		return
+	}
+	file_proto_codepix_pixkey_pixkey_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_proto_codepix_pixkey_stream_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_stream_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisteredKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_stream_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisteredKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_stream_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletedKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_stream_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletedKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_stream_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockedKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_stream_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockedKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_stream_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockedKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_stream_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockedKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_stream_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferredKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_pixkey_stream_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferredKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_codepix_pixkey_stream_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_codepix_pixkey_stream_proto_goTypes,
+		DependencyIndexes: file_proto_codepix_pixkey_stream_proto_depIdxs,
+		MessageInfos:      file_proto_codepix_pixkey_stream_proto_msgTypes,
+	}.Build()
+	File_proto_codepix_pixkey_stream_proto = out.File
+	file_proto_codepix_pixkey_stream_proto_rawDesc = nil
+	file_proto_codepix_pixkey_stream_proto_goTypes = nil
+	file_proto_codepix_pixkey_stream_proto_depIdxs = nil
+}
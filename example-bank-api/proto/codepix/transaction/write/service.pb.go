@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
+	sync "sync"
 )
 
 const (
@@ -19,6 +20,272 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type StartBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*StartRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty" validate:"required,max=1000,dive" mod:"dive"` // @gotags: validate:"required,max=1000,dive" mod:"dive"
+}
+
+func (x *StartBatchRequest) Reset() {
+	*x = StartBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_transaction_write_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBatchRequest) ProtoMessage() {}
+
+func (x *StartBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_transaction_write_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBatchRequest.ProtoReflect.Descriptor instead.
+func (*StartBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_transaction_write_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StartBatchRequest) GetItems() []*StartRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// BatchStarted has one reply per requested item, in the same order.
+type BatchStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchId []byte        `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Items   []*StartReply `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *BatchStarted) Reset() {
+	*x = BatchStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_transaction_write_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStarted) ProtoMessage() {}
+
+func (x *BatchStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_transaction_write_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStarted.ProtoReflect.Descriptor instead.
+func (*BatchStarted) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_transaction_write_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BatchStarted) GetBatchId() []byte {
+	if x != nil {
+		return x.BatchId
+	}
+	return nil
+}
+
+func (x *BatchStarted) GetItems() []*StartReply {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// StartChargeRequest pays a charge. The receiver comes from the charge, and the amount
+// must match it.
+type StartChargeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderId       []byte `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty" validate:"required,len=16"`                   // @gotags: validate:"required,len=16"
+	ChargeId       []byte `protobuf:"bytes,2,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty" validate:"required,len=16"`                   // @gotags: validate:"required,len=16"
+	Amount         uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty" validate:"required"`                                      // @gotags: validate:"required"
+	Description    string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty" validate:"max=100" mod:"trim"`                             // @gotags: validate:"max=100" mod:"trim"
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty" validate:"max=64" mod:"trim"` // @gotags: validate:"max=64" mod:"trim"
+}
+
+func (x *StartChargeRequest) Reset() {
+	*x = StartChargeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_transaction_write_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartChargeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartChargeRequest) ProtoMessage() {}
+
+func (x *StartChargeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_transaction_write_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartChargeRequest.ProtoReflect.Descriptor instead.
+func (*StartChargeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_transaction_write_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StartChargeRequest) GetSenderId() []byte {
+	if x != nil {
+		return x.SenderId
+	}
+	return nil
+}
+
+func (x *StartChargeRequest) GetChargeId() []byte {
+	if x != nil {
+		return x.ChargeId
+	}
+	return nil
+}
+
+func (x *StartChargeRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *StartChargeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StartChargeRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// StartBRCodeRequest pays the payload of a BR Code, as a Start to its key or as a
+// StartCharge to its charge. The amount is only required when the payload has none, and
+// must match it otherwise.
+type StartBRCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderId       []byte `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty" validate:"required,len=16"` // @gotags: validate:"required,len=16"
+	Payload        string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty" validate:"required,max=512" mod:"trim"`                   // @gotags: validate:"required,max=512" mod:"trim"
+	Amount         uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description    string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty" validate:"max=100" mod:"trim"`                             // @gotags: validate:"max=100" mod:"trim"
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty" validate:"max=64" mod:"trim"` // @gotags: validate:"max=64" mod:"trim"
+}
+
+func (x *StartBRCodeRequest) Reset() {
+	*x = StartBRCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_codepix_transaction_write_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartBRCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBRCodeRequest) ProtoMessage() {}
+
+func (x *StartBRCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_codepix_transaction_write_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBRCodeRequest.ProtoReflect.Descriptor instead.
+func (*StartBRCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_codepix_transaction_write_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StartBRCodeRequest) GetSenderId() []byte {
+	if x != nil {
+		return x.SenderId
+	}
+	return nil
+}
+
+func (x *StartBRCodeRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *StartBRCodeRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *StartBRCodeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StartBRCodeRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 var File_proto_codepix_transaction_write_service_proto protoreflect.FileDescriptor
 
 var file_proto_codepix_transaction_write_service_proto_rawDesc = []byte{
@@ -29,31 +296,110 @@ var file_proto_codepix_transaction_write_service_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x1a, 0x2c, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
 	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2f, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x61, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x27, 0x2e, 0x63,
+	0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x52, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
 	0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x77, 0x72, 0x69, 0x74,
-	0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x22, 0x00, 0x42, 0x32, 0x5a, 0x30, 0x63,
-	0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2d, 0x61, 0x70, 0x69, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x66, 0x0a, 0x0c,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78,
+	0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0xae, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x42, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x32, 0x90, 0x03, 0x0a, 0x07, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x27,
+	0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69,
+	0x78, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x22, 0x00, 0x12, 0x65, 0x0a,
+	0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x2e, 0x63, 0x6f,
+	0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x64, 0x65,
+	0x70, 0x69, 0x78, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x42, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69,
+	0x78, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78,
+	0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x22, 0x00, 0x42, 0x32, 0x5a, 0x30,
+	0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2d, 0x61, 0x70, 0x69,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x69, 0x78, 0x2f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_codepix_transaction_write_service_proto_rawDescOnce sync.Once
+	file_proto_codepix_transaction_write_service_proto_rawDescData = file_proto_codepix_transaction_write_service_proto_rawDesc
+)
+
+func file_proto_codepix_transaction_write_service_proto_rawDescGZIP() []byte {
+	file_proto_codepix_transaction_write_service_proto_rawDescOnce.Do(func() {
+		file_proto_codepix_transaction_write_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_codepix_transaction_write_service_proto_rawDescData)
+	})
+	return file_proto_codepix_transaction_write_service_proto_rawDescData
 }
 
+var file_proto_codepix_transaction_write_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_proto_codepix_transaction_write_service_proto_goTypes = []interface{}{
-	(*StartRequest)(nil), // 0: codepix.transaction.write.StartRequest
-	(*Started)(nil),      // 1: codepix.transaction.write.Started
+	(*StartBatchRequest)(nil),  // 0: codepix.transaction.write.StartBatchRequest
+	(*BatchStarted)(nil),       // 1: codepix.transaction.write.BatchStarted
+	(*StartChargeRequest)(nil), // 2: codepix.transaction.write.StartChargeRequest
+	(*StartBRCodeRequest)(nil), // 3: codepix.transaction.write.StartBRCodeRequest
+	(*StartRequest)(nil),       // 4: codepix.transaction.write.StartRequest
+	(*StartReply)(nil),         // 5: codepix.transaction.write.StartReply
+	(*Started)(nil),            // 6: codepix.transaction.write.Started
 }
 var file_proto_codepix_transaction_write_service_proto_depIdxs = []int32{
-	0, // 0: codepix.transaction.write.Service.Start:input_type -> codepix.transaction.write.StartRequest
-	1, // 1: codepix.transaction.write.Service.Start:output_type -> codepix.transaction.write.Started
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: codepix.transaction.write.StartBatchRequest.items:type_name -> codepix.transaction.write.StartRequest
+	5, // 1: codepix.transaction.write.BatchStarted.items:type_name -> codepix.transaction.write.StartReply
+	4, // 2: codepix.transaction.write.Service.Start:input_type -> codepix.transaction.write.StartRequest
+	0, // 3: codepix.transaction.write.Service.StartBatch:input_type -> codepix.transaction.write.StartBatchRequest
+	2, // 4: codepix.transaction.write.Service.StartCharge:input_type -> codepix.transaction.write.StartChargeRequest
+	3, // 5: codepix.transaction.write.Service.StartBRCode:input_type -> codepix.transaction.write.StartBRCodeRequest
+	6, // 6: codepix.transaction.write.Service.Start:output_type -> codepix.transaction.write.Started
+	1, // 7: codepix.transaction.write.Service.StartBatch:output_type -> codepix.transaction.write.BatchStarted
+	6, // 8: codepix.transaction.write.Service.StartCharge:output_type -> codepix.transaction.write.Started
+	6, // 9: codepix.transaction.write.Service.StartBRCode:output_type -> codepix.transaction.write.Started
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_proto_codepix_transaction_write_service_proto_init() }
@@ -62,18 +408,69 @@ func file_proto_codepix_transaction_write_service_proto_init() {
 		return
 	}
 	file_proto_codepix_transaction_write_stream_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_proto_codepix_transaction_write_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_transaction_write_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchStarted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_transaction_write_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartChargeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_codepix_transaction_write_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartBRCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_codepix_transaction_write_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   0,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_codepix_transaction_write_service_proto_goTypes,
 		DependencyIndexes: file_proto_codepix_transaction_write_service_proto_depIdxs,
+		MessageInfos:      file_proto_codepix_transaction_write_service_proto_msgTypes,
 	}.Build()
 	File_proto_codepix_transaction_write_service_proto = out.File
 	file_proto_codepix_transaction_write_service_proto_rawDesc = nil
@@ -23,6 +23,9 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ServiceClient interface {
 	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*Started, error)
+	StartBatch(ctx context.Context, in *StartBatchRequest, opts ...grpc.CallOption) (*BatchStarted, error)
+	StartCharge(ctx context.Context, in *StartChargeRequest, opts ...grpc.CallOption) (*Started, error)
+	StartBRCode(ctx context.Context, in *StartBRCodeRequest, opts ...grpc.CallOption) (*Started, error)
 }
 
 type serviceClient struct {
@@ -42,11 +45,41 @@ func (c *serviceClient) Start(ctx context.Context, in *StartRequest, opts ...grp
 	return out, nil
 }
 
+func (c *serviceClient) StartBatch(ctx context.Context, in *StartBatchRequest, opts ...grpc.CallOption) (*BatchStarted, error) {
+	out := new(BatchStarted)
+	err := c.cc.Invoke(ctx, "/codepix.transaction.write.Service/StartBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) StartCharge(ctx context.Context, in *StartChargeRequest, opts ...grpc.CallOption) (*Started, error) {
+	out := new(Started)
+	err := c.cc.Invoke(ctx, "/codepix.transaction.write.Service/StartCharge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) StartBRCode(ctx context.Context, in *StartBRCodeRequest, opts ...grpc.CallOption) (*Started, error) {
+	out := new(Started)
+	err := c.cc.Invoke(ctx, "/codepix.transaction.write.Service/StartBRCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
 type ServiceServer interface {
 	Start(context.Context, *StartRequest) (*Started, error)
+	StartBatch(context.Context, *StartBatchRequest) (*BatchStarted, error)
+	StartCharge(context.Context, *StartChargeRequest) (*Started, error)
+	StartBRCode(context.Context, *StartBRCodeRequest) (*Started, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -57,6 +90,15 @@ type UnimplementedServiceServer struct {
 func (UnimplementedServiceServer) Start(context.Context, *StartRequest) (*Started, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
 }
+func (UnimplementedServiceServer) StartBatch(context.Context, *StartBatchRequest) (*BatchStarted, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBatch not implemented")
+}
+func (UnimplementedServiceServer) StartCharge(context.Context, *StartChargeRequest) (*Started, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartCharge not implemented")
+}
+func (UnimplementedServiceServer) StartBRCode(context.Context, *StartBRCodeRequest) (*Started, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBRCode not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -88,6 +130,60 @@ func _Service_Start_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_StartBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).StartBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/codepix.transaction.write.Service/StartBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).StartBatch(ctx, req.(*StartBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_StartCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartChargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).StartCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/codepix.transaction.write.Service/StartCharge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).StartCharge(ctx, req.(*StartChargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_StartBRCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartBRCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).StartBRCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/codepix.transaction.write.Service/StartBRCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).StartBRCode(ctx, req.(*StartBRCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,6 +195,18 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Start",
 			Handler:    _Service_Start_Handler,
 		},
+		{
+			MethodName: "StartBatch",
+			Handler:    _Service_StartBatch_Handler,
+		},
+		{
+			MethodName: "StartCharge",
+			Handler:    _Service_StartCharge_Handler,
+		},
+		{
+			MethodName: "StartBRCode",
+			Handler:    _Service_StartBRCode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/codepix/transaction/write/service.proto",